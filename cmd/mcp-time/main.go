@@ -1,35 +1,83 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"time"
 
 	_ "time/tzdata"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/r0mdau/mcp-time/internal/handlers"
+	"github.com/r0mdau/mcp-time/internal/timescale"
 	"github.com/r0mdau/mcp-time/internal/timezone"
 )
 
+const (
+	transportHTTP  = "http"
+	transportStdio = "stdio"
+)
+
 func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run parses the command line and serves the MCP server over the selected transport.
+// Logging always goes to stderr so the stdio transport keeps stdout free for JSON-RPC.
+func run(ctx context.Context, args []string, stdin io.ReadCloser, stdout io.WriteCloser, stderr io.Writer) error {
+	// "serve" is accepted as an explicit subcommand and behaves like no subcommand
+	if len(args) > 0 && args[0] == "serve" {
+		args = args[1:]
+	}
+
 	// Define command-line flags matching Python's arguments
-	localTimezone := flag.String("local-timezone", "", "Override local timezone (e.g., 'America/New_York')")
-	port := flag.Int("port", 8080, "Port to listen on")
-	flag.Parse()
+	flags := flag.NewFlagSet("mcp-time", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	localTimezone := flags.String("local-timezone", "", "Override local timezone (e.g., 'America/New_York')")
+	port := flags.Int("port", 8080, "Port to listen on (http transport only)")
+	transport := flags.String("transport", transportHTTP, "Transport to serve on: 'http' or 'stdio'")
+	leapSeconds := flags.String("leap-seconds", "", "Path to a leap-seconds.list file replacing the embedded leap second table")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *transport != transportHTTP && *transport != transportStdio {
+		return fmt.Errorf("invalid transport %q: expected %q or %q", *transport, transportHTTP, transportStdio)
+	}
 
+	logger := log.New(stderr, "", log.LstdFlags)
+	if *leapSeconds != "" {
+		table, err := timescale.LoadFile(*leapSeconds)
+		if err != nil {
+			return fmt.Errorf("invalid leap second table: %w", err)
+		}
+		timescale.SetDefault(table)
+		logger.Printf("Using leap second table %s, expiring %s", *leapSeconds, table.Expires.Format(time.DateOnly))
+	}
 	localTZ := timezone.GetLocalTimezone(*localTimezone)
-	log.Printf("Using local timezone: %s", localTZ)
+	logger.Printf("Using local timezone: %s", localTZ)
 
 	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-time", Version: "v1.0.0"}, nil)
 	// Register tools with the determined local timezone
 	handlers.RegisterTools(server, localTZ)
 
+	if *transport == transportStdio {
+		logger.Printf("MCP Time Server - serving on stdio")
+		return server.Run(ctx, &mcp.IOTransport{Reader: stdin, Writer: stdout})
+	}
+
 	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return server }, nil)
 	addr := fmt.Sprintf(":%d", *port)
-	log.Printf("MCP Time Server - listening on %s", addr)
-	if err := http.ListenAndServe(addr, handler); err != nil {
-		log.Fatal(err)
-	}
+	logger.Printf("MCP Time Server - listening on %s", addr)
+	return http.ListenAndServe(addr, handler)
 }
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// connectStdio starts run in stdio mode over in-memory pipes and returns a connected client session.
+func connectStdio(t *testing.T, args []string) (*mcp.ClientSession, <-chan error, *bytes.Buffer) {
+	t.Helper()
+	serverIn, clientOut := io.Pipe()
+	clientIn, serverOut := io.Pipe()
+	stderr := &bytes.Buffer{}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- run(context.Background(), args, serverIn, serverOut, stderr)
+	}()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "mcp-time-test", Version: "vtest"}, nil)
+	session, err := client.Connect(context.Background(), &mcp.IOTransport{Reader: clientIn, Writer: clientOut}, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	return session, done, stderr
+}
+
+func TestRunStdioCallTool(t *testing.T) {
+	session, done, stderr := connectStdio(t, []string{"--transport=stdio", "--local-timezone=Europe/Paris"})
+
+	res, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "get_current_time",
+		Arguments: map[string]any{"timezone": "Asia/Tokyo"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.IsError {
+		t.Fatalf("tool returned error: %+v", res.Content)
+	}
+	out, ok := res.StructuredContent.(map[string]any)
+	if !ok {
+		t.Fatalf("unexpected structured content: %#v", res.StructuredContent)
+	}
+	if out["timezone"] != "Asia/Tokyo" {
+		t.Errorf("expected timezone Asia/Tokyo, got %v", out["timezone"])
+	}
+
+	if err := session.Close(); err != nil {
+		t.Fatalf("failed to close session: %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not stop after client disconnected")
+	}
+	if !strings.Contains(stderr.String(), "Using local timezone: Europe/Paris") {
+		t.Errorf("expected startup log on stderr, got %q", stderr.String())
+	}
+}
+
+func TestRunServeSubcommand(t *testing.T) {
+	session, done, _ := connectStdio(t, []string{"serve", "--transport", "stdio"})
+	defer func() {
+		session.Close()
+		<-done
+	}()
+
+	res, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name: "convert_time",
+		Arguments: types.ConvertTimeInput{
+			SourceTimezone: "UTC",
+			Time:           "12:00",
+			TargetTimezone: "Asia/Tokyo",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.IsError {
+		t.Fatalf("tool returned error: %+v", res.Content)
+	}
+}
+
+func TestRunInvalidTransport(t *testing.T) {
+	err := run(context.Background(), []string{"--transport=carrier-pigeon"}, nil, nil, io.Discard)
+	if err == nil {
+		t.Fatal("expected error for invalid transport")
+	}
+}
+
+func TestRunInvalidFlag(t *testing.T) {
+	err := run(context.Background(), []string{"--no-such-flag"}, nil, nil, io.Discard)
+	if err == nil {
+		t.Fatal("expected error for unknown flag")
+	}
+}
+
+func TestRunInvalidLeapSecondsFile(t *testing.T) {
+	err := run(context.Background(), []string{"--leap-seconds=/no/such/leap-seconds.list"}, nil, nil, io.Discard)
+	if err == nil {
+		t.Fatal("expected error for a missing leap second table")
+	}
+}
@@ -0,0 +1,286 @@
+// Package businessday counts and adds business days on a calendar of weekend days,
+// public holidays and custom days off, and checks working hours on it.
+package businessday
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/holidays"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/zones"
+)
+
+// fridaySaturday lists countries whose weekend is Friday and Saturday.
+var fridaySaturday = []string{"BH", "DZ", "EG", "IL", "IQ", "JO", "KW", "LY", "OM", "QA", "SA", "SD", "SY", "YE"}
+
+// DefaultWeekend returns the usual weekend of a country: Friday and Saturday in much of
+// the Middle East and North Africa, Thursday and Friday in Afghanistan, Friday in Iran,
+// Saturday in Nepal, and Saturday and Sunday elsewhere.
+func DefaultWeekend(country string) []time.Weekday {
+	// Subdivision codes such as "DE-BY" keep their country's weekend
+	country, _, _ = strings.Cut(strings.ToUpper(country), "-")
+	switch {
+	case slices.Contains(fridaySaturday, country):
+		return []time.Weekday{time.Friday, time.Saturday}
+	case country == "IR":
+		return []time.Weekday{time.Friday}
+	case country == "AF":
+		return []time.Weekday{time.Thursday, time.Friday}
+	case country == "NP":
+		return []time.Weekday{time.Saturday}
+	}
+	return []time.Weekday{time.Saturday, time.Sunday}
+}
+
+// Calendar marks the days that are not business days: weekend days, the public holidays
+// of a country and custom holidays.
+type Calendar struct {
+	Weekend [7]bool
+	// Country is the ISO 3166 country or subdivision code whose public holidays are days
+	// off, or "".
+	Country string
+	// PublicHolidays reports whether the country's public holidays are built in. Without
+	// them, only the weekend and custom holidays are days off.
+	PublicHolidays bool
+	// Custom lists extra days off, at midnight UTC like holidays.Holiday dates.
+	Custom []holidays.Holiday
+	years  map[int][]holidays.Holiday
+}
+
+// NewCalendar returns the calendar of a country or of a subdivision such as "DE-BY", which
+// may be "" for weekends and custom holidays only. Any ISO 3166 country is accepted, with
+// its public holidays when they are built in. A nil weekend uses the country's default
+// weekend.
+func NewCalendar(country string, weekend []time.Weekday, custom []holidays.Holiday) (*Calendar, error) {
+	c := &Calendar{Country: strings.ToUpper(strings.TrimSpace(country)), years: make(map[int][]holidays.Holiday)}
+	if code, _, _ := strings.Cut(c.Country, "-"); holidays.CountryName(code) != "" {
+		// Check the subdivision now rather than on each lookup
+		if _, _, err := holidays.Years(c.Country); err != nil {
+			return nil, err
+		}
+		c.PublicHolidays = true
+	} else if _, ok := zones.CountryName(code); c.Country != "" && !ok {
+		return nil, fmt.Errorf("unknown ISO 3166 country code %q", country)
+	}
+	if weekend == nil {
+		weekend = DefaultWeekend(c.Country)
+	}
+	for _, d := range weekend {
+		c.Weekend[d] = true
+	}
+	if !slices.Contains(c.Weekend[:], false) {
+		return nil, fmt.Errorf("weekend must leave at least one working day")
+	}
+	for _, h := range custom {
+		h.Date = holidays.Day(h.Date)
+		c.Custom = append(c.Custom, h)
+	}
+	return c, nil
+}
+
+// Covers returns an error when the dates of from and to, and so the days between, are in
+// years whose public holidays the calendar's country does not know.
+func (c *Calendar) Covers(from, to time.Time) error {
+	if !c.PublicHolidays {
+		return nil
+	}
+	for _, d := range []time.Time{from, to} {
+		if _, err := holidays.For(c.Country, d.Year()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Holiday returns the holiday on d's date, custom holidays first.
+func (c *Calendar) Holiday(d time.Time) (holidays.Holiday, bool) {
+	day := holidays.Day(d)
+	for _, h := range c.Custom {
+		if h.Date.Equal(day) {
+			return h, true
+		}
+	}
+	if !c.PublicHolidays {
+		return holidays.Holiday{}, false
+	}
+	list, ok := c.years[day.Year()]
+	if !ok {
+		list, _ = holidays.For(c.Country, day.Year())
+		c.years[day.Year()] = list
+	}
+	for _, h := range list {
+		if h.Date.Equal(day) {
+			return h, true
+		}
+	}
+	return holidays.Holiday{}, false
+}
+
+// IsBusinessDay reports whether d's date is neither a weekend day nor a holiday.
+func (c *Calendar) IsBusinessDay(d time.Time) bool {
+	if c.Weekend[d.Weekday()] {
+		return false
+	}
+	_, holiday := c.Holiday(d)
+	return !holiday
+}
+
+// AddDays returns the date n business days after d's date, or before it when n is
+// negative. d itself is not counted, so adding 1 to a Friday gives the next Monday on a
+// Saturday and Sunday weekend. Adding 0 returns d's date. The result is midnight UTC.
+func (c *Calendar) AddDays(d time.Time, n int) time.Time {
+	day := holidays.Day(d)
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		day = day.AddDate(0, 0, step)
+		if c.IsBusinessDay(day) {
+			n--
+		}
+	}
+	return day
+}
+
+// Count returns the number of business days after from's date up to and including to's
+// date, or minus the number from to's date up to but excluding from's date when to is
+// before from. Adding the count to from gives to when to is a business day.
+func (c *Calendar) Count(from, to time.Time) int {
+	lo, hi, sign := span(from, to)
+	n := 0
+	for day := lo.AddDate(0, 0, 1); !day.After(hi); day = day.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(day) {
+			n++
+		}
+	}
+	return sign * n
+}
+
+// Holidays returns the holidays on working weekdays in the days Count covers, in date
+// order: the days off that Count and AddDays skip besides weekends.
+func (c *Calendar) Holidays(from, to time.Time) []holidays.Holiday {
+	lo, hi, _ := span(from, to)
+	var out []holidays.Holiday
+	for day := lo.AddDate(0, 0, 1); !day.After(hi); day = day.AddDate(0, 0, 1) {
+		if h, ok := c.Holiday(day); ok && !c.Weekend[day.Weekday()] {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// span returns the days between from and to walking from from, as the range (lo, hi],
+// with -1 as sign when walking back.
+func span(from, to time.Time) (time.Time, time.Time, int) {
+	start, end := holidays.Day(from), holidays.Day(to)
+	if end.Before(start) {
+		return end.AddDate(0, 0, -1), start.AddDate(0, 0, -1), -1
+	}
+	return start, end, 1
+}
+
+// Schedule is a calendar with daily working hours in a timezone.
+type Schedule struct {
+	Calendar *Calendar
+	Location *time.Location
+	// Start and End are wall-clock offsets from midnight; End is after Start, and 24 hours
+	// at most for the midnight ending the day.
+	Start time.Duration
+	End   time.Duration
+}
+
+// Status describes a moment against a schedule.
+type Status struct {
+	Open bool
+	// Reason is "open", "weekend", "holiday", "before_hours" or "after_hours".
+	Reason  string
+	Holiday *holidays.Holiday
+	// Opens is the start of the next working window when closed; Closes is the end of the
+	// current window when open.
+	Opens  time.Time
+	Closes time.Time
+}
+
+// Window returns the working hours on day's date in the schedule's timezone. Edges falling
+// in a DST gap move forward.
+func (s Schedule) Window(day time.Time) (time.Time, time.Time) {
+	y, m, d := day.Date()
+	return s.wallClock(y, m, d, s.Start), s.wallClock(y, m, d, s.End)
+}
+
+func (s Schedule) wallClock(y int, m time.Month, d int, offset time.Duration) time.Time {
+	minutes := int(offset / time.Minute)
+	// 24:00 is midnight of the next day
+	if minutes >= 24*60 {
+		y, m, d = time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC).Date()
+		minutes -= 24 * 60
+	}
+	local, _ := timezone.ResolveLocalTime(y, m, d, minutes/60, minutes%60, 0, 0, s.Location, timezone.DisambiguateCompatible)
+	return local.Time
+}
+
+// Check returns the status of t on the schedule.
+func (s Schedule) Check(t time.Time) Status {
+	t = t.In(s.Location)
+	var status Status
+	start, end := s.Window(t)
+	switch h, holiday := s.Calendar.Holiday(t); {
+	case s.Calendar.Weekend[t.Weekday()]:
+		status.Reason = "weekend"
+	case holiday:
+		status.Reason, status.Holiday = "holiday", &h
+	case t.Before(start):
+		status.Reason = "before_hours"
+	case !t.Before(end):
+		status.Reason = "after_hours"
+	default:
+		return Status{Open: true, Reason: "open", Closes: end}
+	}
+	status.Opens = s.NextOpen(t)
+	return status
+}
+
+// NextOpen returns t when the schedule is open at t, and the start of the next working
+// window otherwise.
+func (s Schedule) NextOpen(t time.Time) time.Time {
+	t = t.In(s.Location)
+	day := t
+	for {
+		if s.Calendar.IsBusinessDay(day) {
+			start, end := s.Window(day)
+			if t.Before(end) {
+				if t.Before(start) {
+					return start
+				}
+				return t
+			}
+		}
+		y, m, d := day.Date()
+		day = time.Date(y, m, d+1, 12, 0, 0, 0, s.Location)
+	}
+}
+
+// Add returns the moment d of working time after t: the clock runs only within working
+// windows, starting at the next window when t is outside one. A deadline falling exactly
+// at the end of a window stays there rather than moving to the next window, and adding
+// no time gives the next opening.
+func (s Schedule) Add(t time.Time, d time.Duration) time.Time {
+	t = t.In(s.Location)
+	if d <= 0 {
+		return s.NextOpen(t)
+	}
+	for {
+		t = s.NextOpen(t)
+		_, end := s.Window(t)
+		left := end.Sub(t)
+		if d <= left {
+			return t.Add(d)
+		}
+		d -= left
+		t = end
+	}
+}
@@ -0,0 +1,228 @@
+package businessday
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/holidays"
+
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func mustCalendar(t *testing.T, country string, weekend []time.Weekday, custom ...holidays.Holiday) *Calendar {
+	t.Helper()
+	c, err := NewCalendar(country, weekend, custom)
+	if err != nil {
+		t.Fatalf("NewCalendar() error = %v", err)
+	}
+	return c
+}
+
+func day(s string) time.Time {
+	d, _ := time.Parse(time.DateOnly, s)
+	return d
+}
+
+func TestAddDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar *Calendar
+		from     string
+		n        int
+		want     string
+	}{
+		{"over a weekend", mustCalendar(t, "", nil), "2026-03-27", 5, "2026-04-03"},
+		// Good Friday and Easter Monday are holidays in Germany
+		{"over Easter in Germany", mustCalendar(t, "DE", nil), "2026-03-27", 5, "2026-04-07"},
+		{"backwards over Easter in Germany", mustCalendar(t, "DE", nil), "2026-04-07", -5, "2026-03-27"},
+		{"zero days", mustCalendar(t, "DE", nil), "2026-04-04", 0, "2026-04-04"},
+		{"from a weekend day", mustCalendar(t, "", nil), "2026-03-28", 1, "2026-03-30"},
+		{"Friday and Saturday weekend", mustCalendar(t, "", DefaultWeekend("SA")), "2026-03-26", 1, "2026-03-29"},
+		// Saudi Arabia has no built-in holidays but keeps its weekend
+		{"country without holidays", mustCalendar(t, "sa", nil), "2026-03-26", 1, "2026-03-29"},
+		{"custom weekend", mustCalendar(t, "", []time.Weekday{time.Sunday}), "2026-03-27", 1, "2026-03-28"},
+		{"custom holiday", mustCalendar(t, "", nil, holidays.Holiday{Date: day("2026-03-30"), Name: "Office closed"}), "2026-03-27", 1, "2026-03-31"},
+		{"observed holiday", mustCalendar(t, "US", nil), "2026-07-02", 1, "2026-07-06"},
+		// Corpus Christi is a holiday in Bavaria but not in Berlin
+		{"Bavaria", mustCalendar(t, "DE-BY", nil), "2026-06-03", 1, "2026-06-05"},
+		{"Berlin", mustCalendar(t, "DE-BE", nil), "2026-06-03", 1, "2026-06-04"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.calendar.AddDays(day(tt.from), tt.n)
+			if got.Format(time.DateOnly) != tt.want {
+				t.Errorf("AddDays(%s, %d) = %s, want %s", tt.from, tt.n, got.Format(time.DateOnly), tt.want)
+			}
+			if tt.n != 0 {
+				if back := tt.calendar.Count(day(tt.from), got); back != tt.n {
+					t.Errorf("Count(%s, %s) = %d, want %d", tt.from, tt.want, back, tt.n)
+				}
+			}
+		})
+	}
+}
+
+func TestCountAndHolidays(t *testing.T) {
+	c := mustCalendar(t, "GB", nil)
+	from, to := day("2026-12-18"), day("2027-01-04")
+	if got := c.Count(from, to); got != 8 {
+		t.Errorf("Count() = %d, want 8", got)
+	}
+	if got := c.Count(to, from); got != -8 {
+		t.Errorf("reversed Count() = %d, want -8", got)
+	}
+	var names []string
+	for _, h := range c.Holidays(from, to) {
+		names = append(names, h.Date.Format(time.DateOnly)+" "+h.Name)
+	}
+	want := "2026-12-25 Christmas Day, 2026-12-28 Boxing Day (observed), 2027-01-01 New Year's Day"
+	if strings.Join(names, ", ") != want {
+		t.Errorf("Holidays() = %s, want %s", strings.Join(names, ", "), want)
+	}
+}
+
+func TestNewCalendarErrors(t *testing.T) {
+	if _, err := NewCalendar("ZZ", nil, nil); err == nil || !strings.Contains(err.Error(), `unknown ISO 3166 country code "ZZ"`) {
+		t.Errorf("expected an unknown country error, got %v", err)
+	}
+	if _, err := NewCalendar("DE-XX", nil, nil); err == nil || !strings.Contains(err.Error(), "unknown subdivision") {
+		t.Errorf("expected an unknown subdivision error, got %v", err)
+	}
+	if mustCalendar(t, "SG", nil).PublicHolidays || !mustCalendar(t, "DE-BY", nil).PublicHolidays {
+		t.Errorf("expected built-in holidays for DE-BY only")
+	}
+	all := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	if _, err := NewCalendar("", all, nil); err == nil || !strings.Contains(err.Error(), "at least one working day") {
+		t.Errorf("expected a weekend error, got %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	s := Schedule{Calendar: mustCalendar(t, "DE", nil), Location: berlin, Start: 9 * time.Hour, End: 17*time.Hour + 30*time.Minute}
+	at := func(v string) time.Time {
+		t, _ := time.ParseInLocation("2006-01-02T15:04", v, berlin)
+		return t
+	}
+
+	tests := []struct {
+		name   string
+		t      time.Time
+		reason string
+		opens  time.Time
+		closes time.Time
+	}{
+		{"open", at("2026-03-27T10:00"), "open", time.Time{}, at("2026-03-27T17:30")},
+		{"before hours", at("2026-03-27T08:59"), "before_hours", at("2026-03-27T09:00"), time.Time{}},
+		{"after hours on Thursday before Easter", at("2026-04-02T17:30"), "after_hours", at("2026-04-07T09:00"), time.Time{}},
+		{"holiday", at("2026-04-06T11:00"), "holiday", at("2026-04-07T09:00"), time.Time{}},
+		{"weekend", at("2026-03-28T11:00"), "weekend", at("2026-03-30T09:00"), time.Time{}},
+		{"instant in another zone", time.Date(2026, time.March, 27, 8, 30, 0, 0, time.UTC), "open", time.Time{}, at("2026-03-27T17:30")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Check(tt.t)
+			if got.Reason != tt.reason || got.Open != (tt.reason == "open") || !got.Opens.Equal(tt.opens) || !got.Closes.Equal(tt.closes) {
+				t.Errorf("Check() = %+v, want %s opening %v closing %v", got, tt.reason, tt.opens, tt.closes)
+			}
+			if tt.reason == "holiday" && (got.Holiday == nil || got.Holiday.Name != "Easter Monday") {
+				t.Errorf("Check() holiday = %+v, want Easter Monday", got.Holiday)
+			}
+		})
+	}
+}
+
+func TestDefaultWeekend(t *testing.T) {
+	tests := map[string][]time.Weekday{
+		"de":    {time.Saturday, time.Sunday},
+		"DE-BY": {time.Saturday, time.Sunday},
+		"SA":    {time.Friday, time.Saturday},
+		"IR":    {time.Friday},
+		"AF":    {time.Thursday, time.Friday},
+		"NP":    {time.Saturday},
+		"":      {time.Saturday, time.Sunday},
+	}
+	for country, want := range tests {
+		got := DefaultWeekend(country)
+		if len(got) != len(want) || got[0] != want[0] {
+			t.Errorf("DefaultWeekend(%q) = %v, want %v", country, got, want)
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	singapore := mustLoadLocation(t, "Asia/Singapore")
+	london := mustLoadLocation(t, "Europe/London")
+	at := func(loc *time.Location, v string) time.Time {
+		t, _ := time.ParseInLocation("2006-01-02T15:04", v, loc)
+		return t
+	}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		start    time.Time
+		d        time.Duration
+		want     time.Time
+	}{
+		{
+			name:     "carries over to the next day",
+			schedule: Schedule{Calendar: mustCalendar(t, "", nil), Location: singapore, Start: 9 * time.Hour, End: 18 * time.Hour},
+			start:    at(singapore, "2026-03-26T16:45"),
+			d:        8 * time.Hour,
+			want:     at(singapore, "2026-03-27T15:45"),
+		},
+		{
+			name:     "skips a weekend and Easter",
+			schedule: Schedule{Calendar: mustCalendar(t, "GB", nil), Location: london, Start: 9 * time.Hour, End: 17 * time.Hour},
+			start:    at(london, "2026-04-02T15:00"),
+			d:        4 * time.Hour,
+			want:     at(london, "2026-04-07T11:00"),
+		},
+		{
+			name:     "starts at the next window",
+			schedule: Schedule{Calendar: mustCalendar(t, "", nil), Location: london, Start: 9 * time.Hour, End: 17 * time.Hour},
+			start:    at(london, "2026-03-28T10:00"),
+			d:        90 * time.Minute,
+			want:     at(london, "2026-03-30T10:30"),
+		},
+		{
+			name:     "ends at closing time",
+			schedule: Schedule{Calendar: mustCalendar(t, "", nil), Location: london, Start: 9 * time.Hour, End: 17 * time.Hour},
+			start:    at(london, "2026-03-27T09:00"),
+			d:        8 * time.Hour,
+			want:     at(london, "2026-03-27T17:00"),
+		},
+		{
+			name:     "window ending at midnight",
+			schedule: Schedule{Calendar: mustCalendar(t, "", nil), Location: london, Start: 18 * time.Hour, End: 24 * time.Hour},
+			start:    at(london, "2026-03-26T22:00"),
+			d:        3 * time.Hour,
+			want:     at(london, "2026-03-27T19:00"),
+		},
+		{
+			// No time out of hours gives the next opening, not the start
+			name:     "zero duration",
+			schedule: Schedule{Calendar: mustCalendar(t, "", nil), Location: london, Start: 9 * time.Hour, End: 17 * time.Hour},
+			start:    at(london, "2026-03-28T10:00"),
+			want:     at(london, "2026-03-30T09:00"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Add(tt.start, tt.d); !got.Equal(tt.want) {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
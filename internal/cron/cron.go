@@ -0,0 +1,360 @@
+// Package cron parses cron expressions in the standard 5-field form, with an optional
+// leading seconds field, in the Quartz form with seconds and an optional year, and
+// systemd OnCalendar specifications, describes them in English and computes their fire
+// times in a timezone.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialects accepted by Parse.
+const (
+	// DialectAuto picks DialectQuartz for 7 fields or when Quartz-only characters
+	// ('?', 'L', 'W', '#') are used, and DialectStandard otherwise.
+	DialectAuto = "auto"
+	// DialectStandard is Vixie cron: 5 fields, or 6 with leading seconds. Day of week
+	// runs from 0 to 7, with both 0 and 7 meaning Sunday.
+	DialectStandard = "standard"
+	// DialectQuartz has 6 fields starting with seconds and an optional year. Day of week
+	// runs from 1 (Sunday) to 7 (Saturday).
+	DialectQuartz = "quartz"
+	// DialectSystemd marks schedules parsed by ParseOnCalendar.
+	DialectSystemd = "systemd"
+)
+
+// Field names, as reported in FieldError.
+const (
+	FieldSecond     = "second"
+	FieldMinute     = "minute"
+	FieldHour       = "hour"
+	FieldDayOfMonth = "day_of_month"
+	FieldMonth      = "month"
+	FieldDayOfWeek  = "day_of_week"
+	FieldYear       = "year"
+	FieldTimezone   = "timezone"
+)
+
+// Year bounds of the Quartz year field.
+const (
+	minYear = 1970
+	maxYear = 2199
+)
+
+// macros are the Vixie cron shorthands and their 5-field equivalents.
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	standardDayNames = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+	quartzDayNames   = map[string]int{"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7}
+)
+
+// FieldError reports an invalid field of a cron expression or OnCalendar specification.
+type FieldError struct {
+	Field    string // one of the Field* names
+	Position int    // 1-based position of the field, or of its component in OnCalendar
+	Value    string
+	Reason   string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid %s field %q at position %d: %s", strings.ReplaceAll(e.Field, "_", " "), e.Value, e.Position, e.Reason)
+}
+
+// Field is one field of a parsed expression.
+type Field struct {
+	Name string
+	// Value is the field in cron syntax.
+	Value string
+	// Raw is the field as written, which differs from Value for OnCalendar specifications.
+	Raw string
+}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	Expression string
+	Dialect    string
+	// Fields lists the expression's fields in order, seconds first. Fields implied by a
+	// shorter form, such as the seconds of a 5-field expression, are included.
+	Fields []Field
+	// Location is the timezone named by an OnCalendar specification, or nil.
+	Location *time.Location
+
+	second, minute, hour uint64
+	dom, month, dow      uint64
+	years                map[int]bool // nil matches every year
+	// domAny and dowAny are set when the field starts with '*' or is '?'. When both day
+	// fields are restricted a day matching either fires, as in Vixie cron.
+	domAny, dowAny bool
+	// intersectDays requires both day fields to match, as in systemd OnCalendar.
+	intersectDays  bool
+	domLast        []int // days before the end of the month: 0 for "L", 3 for "L-3"
+	domLastWeekday bool  // "LW"
+	domNearest     []int // "15W": the weekday nearest the 15th
+	dowLast        uint64
+	dowNth         [7]uint8 // bit n set for "the nth weekday of the month"
+}
+
+// Parse parses a cron expression in the given dialect (DialectAuto when empty). Field
+// errors are returned as *FieldError.
+func Parse(expr, dialect string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("expression is empty")
+	}
+	text := expr
+	if strings.HasPrefix(expr, "@") {
+		macro, ok := macros[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported macro %q: expected one of @yearly, @annually, @monthly, @weekly, @daily, @midnight or @hourly", expr)
+		}
+		text, dialect = macro, DialectStandard
+	}
+
+	fields := strings.Fields(text)
+	switch dialect {
+	case "", DialectAuto:
+		dialect = DialectStandard
+		if len(fields) == 7 || (len(fields) == 6 && strings.ContainsAny(strings.ToUpper(fields[3]+fields[5]), "?LW#")) {
+			dialect = DialectQuartz
+		}
+	case DialectStandard, DialectQuartz:
+	default:
+		return nil, fmt.Errorf("unknown dialect %q: expected %q, %q or %q", dialect, DialectAuto, DialectStandard, DialectQuartz)
+	}
+
+	names := []string{FieldSecond, FieldMinute, FieldHour, FieldDayOfMonth, FieldMonth, FieldDayOfWeek, FieldYear}
+	values := fields
+	offset := 0 // position of the first field in the expression, minus one
+	switch {
+	case dialect == DialectStandard && len(fields) == 5:
+		values = append([]string{"0"}, fields...)
+		offset = -1
+	case dialect == DialectStandard && len(fields) == 6:
+	case dialect == DialectQuartz && (len(fields) == 6 || len(fields) == 7):
+	case dialect == DialectStandard:
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week) or 6 with leading seconds, got %d", len(fields))
+	default:
+		return nil, fmt.Errorf("expected 6 or 7 Quartz fields (second minute hour day-of-month month day-of-week [year]), got %d", len(fields))
+	}
+
+	s := &Schedule{Expression: expr, Dialect: dialect}
+	for i, value := range values {
+		s.Fields = append(s.Fields, Field{Name: names[i], Value: value, Raw: value})
+		err := s.parseField(names[i], value)
+		if err == nil && strings.Contains(value, "?") && names[i] != FieldDayOfMonth && names[i] != FieldDayOfWeek {
+			err = fmt.Errorf("'?' is only allowed in the day-of-month and day-of-week fields")
+		}
+		if err != nil {
+			return nil, &FieldError{Field: names[i], Position: i + offset + 1, Value: value, Reason: err.Error()}
+		}
+	}
+	if dialect == DialectQuartz && values[3] == "?" && values[5] == "?" {
+		return nil, &FieldError{Field: FieldDayOfWeek, Position: 6, Value: values[5], Reason: "'?' may only be used in one of the day-of-month and day-of-week fields"}
+	}
+	return s, nil
+}
+
+func (s *Schedule) parseField(name, value string) error {
+	var err error
+	switch name {
+	case FieldSecond:
+		s.second, err = parseBits(value, 0, 59, nil, nil)
+	case FieldMinute:
+		s.minute, err = parseBits(value, 0, 59, nil, nil)
+	case FieldHour:
+		s.hour, err = parseBits(value, 0, 23, nil, nil)
+	case FieldMonth:
+		s.month, err = parseBits(value, 1, 12, monthNames, nil)
+	case FieldDayOfMonth:
+		s.domAny = strings.HasPrefix(value, "*") || value == "?"
+		s.dom, err = parseBits(value, 1, 31, nil, s.parseDayOfMonthItem)
+	case FieldDayOfWeek:
+		s.dowAny = strings.HasPrefix(value, "*") || value == "?"
+		lo, hi, names := 0, 7, standardDayNames
+		if s.Dialect == DialectQuartz {
+			lo, hi, names = 1, 7, quartzDayNames
+		}
+		var bits uint64
+		bits, err = parseBits(value, lo, hi, names, s.parseDayOfWeekItem)
+		s.dow |= s.weekdayBits(bits)
+	case FieldYear:
+		var years []int
+		if years, err = parseItems(value, minYear, maxYear, nil, nil); err == nil && value != "*" && value != "?" {
+			s.years = make(map[int]bool, len(years))
+			for _, y := range years {
+				s.years[y] = true
+			}
+		}
+	}
+	return err
+}
+
+// parseDayOfMonthItem handles "L", "L-3", "LW" and "15W".
+func (s *Schedule) parseDayOfMonthItem(item string) (bool, error) {
+	switch item = strings.ToUpper(item); {
+	case item == "L":
+		s.domLast = append(s.domLast, 0)
+	case item == "LW":
+		s.domLastWeekday = true
+	case strings.HasPrefix(item, "L-"):
+		n, err := strconv.Atoi(item[2:])
+		if err != nil || n < 0 || n > 30 {
+			return false, fmt.Errorf("%q: expected L-n with n from 0 to 30", item)
+		}
+		s.domLast = append(s.domLast, n)
+	case strings.HasSuffix(item, "W"):
+		day, err := parseValue(item[:len(item)-1], 1, 31, nil)
+		if err != nil {
+			return false, err
+		}
+		s.domNearest = append(s.domNearest, day)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// parseDayOfWeekItem handles "5L" (the last Friday, in standard numbering) and "5#3"
+// (the third Friday). A lone "L" is the last day of the week, Saturday.
+func (s *Schedule) parseDayOfWeekItem(item string) (bool, error) {
+	lo, hi, names := 0, 7, standardDayNames
+	if s.Dialect == DialectQuartz {
+		lo, hi, names = 1, 7, quartzDayNames
+	}
+	switch item = strings.ToUpper(item); {
+	case item == "L":
+		s.dow |= 1 << 6
+	case strings.HasSuffix(item, "L"):
+		day, err := parseValue(item[:len(item)-1], lo, hi, names)
+		if err != nil {
+			return false, err
+		}
+		s.dowLast |= s.weekdayBits(1 << day)
+	case strings.Contains(item, "#"):
+		dayStr, nthStr, _ := strings.Cut(item, "#")
+		day, err := parseValue(dayStr, lo, hi, names)
+		if err != nil {
+			return false, err
+		}
+		nth, err := strconv.Atoi(nthStr)
+		if err != nil || nth < 1 || nth > 5 {
+			return false, fmt.Errorf("%q: expected day#n with n from 1 to 5", item)
+		}
+		for wd := range 7 {
+			if s.weekdayBits(1<<day)&(1<<wd) != 0 {
+				s.dowNth[wd] |= 1 << nth
+			}
+		}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// weekdayBits converts day-of-week bits in the dialect's numbering to time.Weekday bits.
+func (s *Schedule) weekdayBits(bits uint64) uint64 {
+	if s.Dialect == DialectQuartz {
+		return bits >> 1
+	}
+	// 7 is Sunday, like 0
+	if bits&(1<<7) != 0 {
+		bits = bits&^(1<<7) | 1
+	}
+	return bits
+}
+
+// parseBits is parseItems returning the values as a bit set.
+func parseBits(value string, lo, hi int, names map[string]int, special func(string) (bool, error)) (uint64, error) {
+	values, err := parseItems(value, lo, hi, names, special)
+	var bits uint64
+	for _, v := range values {
+		bits |= 1 << v
+	}
+	return bits, err
+}
+
+// parseItems expands a comma-separated list of values, ranges ("1-5") and steps ("*/15",
+// "10-30/5", "5/10") within [lo, hi]. special handles dialect-specific items first and
+// reports whether it consumed the item.
+func parseItems(value string, lo, hi int, names map[string]int, special func(string) (bool, error)) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(value, ",") {
+		if item == "" {
+			return nil, fmt.Errorf("empty list item")
+		}
+		if special != nil {
+			ok, err := special(item)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+		}
+
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step %q: expected a positive number", stepPart)
+			}
+		}
+		start, end := lo, hi
+		if rangePart != "*" && rangePart != "?" {
+			startStr, endStr, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = parseValue(startStr, lo, hi, names); err != nil {
+				return nil, err
+			}
+			switch {
+			case isRange:
+				if end, err = parseValue(endStr, lo, hi, names); err != nil {
+					return nil, err
+				}
+				if end < start {
+					return nil, fmt.Errorf("range %q starts after it ends", rangePart)
+				}
+			case !hasStep:
+				end = start
+			}
+		} else if rangePart == "?" && hasStep {
+			return nil, fmt.Errorf("'?' cannot have a step")
+		}
+		for v := start; v <= end; v += step {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+// parseValue parses a number or a name such as "MON" or "JAN" within [lo, hi].
+func parseValue(s string, lo, hi int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number or a known name", s)
+	}
+	if v < lo || v > hi {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, lo, hi)
+	}
+	return v, nil
+}
@@ -0,0 +1,103 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDialects(t *testing.T) {
+	tests := []struct {
+		expr    string
+		dialect string
+		want    string
+		fields  int
+	}{
+		{"*/15 9-17 * * MON-FRI", "", DialectStandard, 6},
+		{"30 */15 9-17 * * 1-5", "", DialectStandard, 6},
+		{"0 0 12 ? * MON-FRI", "", DialectQuartz, 6},
+		{"0 0 12 * * ? 2027", "", DialectQuartz, 7},
+		{"0 0 12 L * ?", "", DialectQuartz, 6},
+		{"0 0 12 * * 2", DialectQuartz, DialectQuartz, 6},
+		{"@daily", "", DialectStandard, 6},
+		{"@HOURLY", DialectQuartz, DialectStandard, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s, err := Parse(tt.expr, tt.dialect)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s.Dialect != tt.want {
+				t.Errorf("dialect = %s, want %s", s.Dialect, tt.want)
+			}
+			if len(s.Fields) != tt.fields {
+				t.Errorf("got %d fields, want %d", len(s.Fields), tt.fields)
+			}
+			if s.Fields[0].Name != FieldSecond {
+				t.Errorf("first field is %s, want %s", s.Fields[0].Name, FieldSecond)
+			}
+		})
+	}
+}
+
+func TestParseWeekdayNumbering(t *testing.T) {
+	standard, err := Parse("0 12 * * 7", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	quartz, err := Parse("0 0 12 ? * 1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Both mean Sunday
+	if standard.dow != 1 || quartz.dow != 1 {
+		t.Errorf("dow bits = %b and %b, want 1", standard.dow, quartz.dow)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr     string
+		dialect  string
+		field    string
+		position int
+	}{
+		{"61 * * * *", "", FieldMinute, 1},
+		{"* 24 * * *", "", FieldHour, 2},
+		{"* * 0 * *", "", FieldDayOfMonth, 3},
+		{"* * * 13 *", "", FieldMonth, 4},
+		{"* * * * 8", "", FieldDayOfWeek, 5},
+		{"* * * * FOO", "", FieldDayOfWeek, 5},
+		{"*/0 * * * *", "", FieldMinute, 1},
+		{"5-1 * * * *", "", FieldMinute, 1},
+		{"1,,2 * * * *", "", FieldMinute, 1},
+		{"0 0 12 ? * 0", "", FieldDayOfWeek, 6},
+		{"0 0 12 ? * 6#6", "", FieldDayOfWeek, 6},
+		{"0 0 12 ? * ?", "", FieldDayOfWeek, 6},
+		{"? 0 12 * * ?", "", FieldSecond, 1},
+		{"0 0 12 * * ? 1969", "", FieldYear, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr, tt.dialect)
+			var fe *FieldError
+			if !errors.As(err, &fe) {
+				t.Fatalf("expected a FieldError, got %v", err)
+			}
+			if fe.Field != tt.field || fe.Position != tt.position {
+				t.Errorf("error points at %s (position %d), want %s (position %d): %v", fe.Field, fe.Position, tt.field, tt.position, err)
+			}
+		})
+	}
+
+	for _, expr := range []string{"", "* * * *", "* * * * * * * *", "@reboot"} {
+		if _, err := Parse(expr, ""); err == nil {
+			t.Errorf("expected error for %q", expr)
+		}
+	}
+	if _, err := Parse("* * * * *", "unix"); err == nil {
+		t.Error("expected error for unknown dialect")
+	}
+}
@@ -0,0 +1,321 @@
+package cron
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxListedTimes bounds how many times of day Describe lists explicitly.
+const maxListedTimes = 8
+
+var fieldUnits = map[string][2]string{
+	FieldSecond:     {"second", "seconds"},
+	FieldMinute:     {"minute", "minutes"},
+	FieldHour:       {"hour", "hours"},
+	FieldDayOfMonth: {"day", "days"},
+	FieldMonth:      {"month", "months"},
+	FieldDayOfWeek:  {"day of the week", "days of the week"},
+	FieldYear:       {"year", "years"},
+}
+
+var ordinals = []string{"", "first", "second", "third", "fourth", "fifth"}
+
+// Describe returns the schedule in plain English, such as "Every 15 minutes, between
+// 09:00 and 17:59, on Monday through Friday".
+func (s *Schedule) Describe() string {
+	parts := []string{s.describeTime()}
+	if days := s.describeDays(); days != "" {
+		parts = append(parts, days)
+	}
+	if month := s.field(FieldMonth); month != "*" && month != "?" {
+		parts = append(parts, withPreposition("in", s.DescribeField(FieldMonth, month)))
+	}
+	if year := s.field(FieldYear); year != "" && year != "*" && year != "?" {
+		parts = append(parts, withPreposition("in", s.DescribeField(FieldYear, year)))
+	}
+	if s.Location != nil {
+		parts = append(parts, "in the "+s.Location.String()+" timezone")
+	}
+	desc := strings.Join(parts, ", ")
+	return strings.ToUpper(desc[:1]) + desc[1:]
+}
+
+// DescribeField returns one field, given in cron syntax, in plain English, such as
+// "every 15 minutes" or "Monday through Friday".
+func (s *Schedule) DescribeField(name, value string) string {
+	items := strings.Split(value, ",")
+	if len(items) > 1 && !slices.ContainsFunc(items, func(item string) bool { return strings.ContainsAny(item, "*?-/LW#") }) {
+		values := make([]string, len(items))
+		for i, item := range items {
+			values[i] = s.formatValue(name, item)
+		}
+		if isNamed(name) {
+			return joinAnd(values)
+		}
+		return fieldUnits[name][1] + " " + joinAnd(values)
+	}
+	descs := make([]string, len(items))
+	for i, item := range items {
+		descs[i] = s.describeItem(name, item)
+	}
+	return joinAnd(descs)
+}
+
+func (s *Schedule) describeItem(name, item string) string {
+	unit, units := fieldUnits[name][0], fieldUnits[name][1]
+	switch item {
+	case "*":
+		return "every " + unit
+	case "?":
+		return "any " + unit
+	}
+	upper := strings.ToUpper(item)
+	switch {
+	case name == FieldDayOfMonth && upper == "L":
+		return "the last day"
+	case name == FieldDayOfMonth && upper == "LW":
+		return "the last weekday"
+	case name == FieldDayOfMonth && strings.HasPrefix(upper, "L-"):
+		n, _ := strconv.Atoi(upper[2:])
+		if n == 0 {
+			return "the last day"
+		}
+		return "the " + ordinal(n+1) + "-to-last day"
+	case name == FieldDayOfMonth && strings.HasSuffix(upper, "W"):
+		return "the weekday nearest day " + upper[:len(upper)-1]
+	case name == FieldDayOfWeek && upper == "L":
+		return "Saturday"
+	case name == FieldDayOfWeek && strings.HasSuffix(upper, "L"):
+		return "the last " + s.formatValue(name, upper[:len(upper)-1]) + " of the month"
+	case name == FieldDayOfWeek && strings.Contains(upper, "#"):
+		day, nth, _ := strings.Cut(upper, "#")
+		n, _ := strconv.Atoi(nth)
+		return "the " + ordinal(n) + " " + s.formatValue(name, day) + " of the month"
+	}
+
+	rangePart, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		every := "every " + step + " " + units
+		if step == "1" {
+			every = "every " + unit
+		}
+		start, end, isRange := strings.Cut(rangePart, "-")
+		switch {
+		case rangePart == "*", !isRange && isLowest(name, start):
+			return every
+		case isRange:
+			return every + " from " + s.formatValue(name, start) + " through " + s.formatValue(name, end)
+		default:
+			return every + " starting at " + s.formatValue(name, start)
+		}
+	}
+	if start, end, isRange := strings.Cut(rangePart, "-"); isRange {
+		if isNamed(name) {
+			return s.formatValue(name, start) + " through " + s.formatValue(name, end)
+		}
+		return units + " " + s.formatValue(name, start) + " through " + s.formatValue(name, end)
+	}
+	if isNamed(name) {
+		return s.formatValue(name, item)
+	}
+	return unit + " " + s.formatValue(name, item)
+}
+
+// describeTime describes the second, minute and hour fields, listing the times of day
+// when there are few of them.
+func (s *Schedule) describeTime() string {
+	sec, min, hour := s.field(FieldSecond), s.field(FieldMinute), s.field(FieldHour)
+	if times := s.listTimes(sec, min, hour); times != nil {
+		return "at " + joinAnd(times)
+	}
+
+	var parts []string
+	switch {
+	case !isPlain(sec) || !isLowest(FieldSecond, sec):
+		parts = append(parts, withPreposition("at", s.DescribeField(FieldSecond, sec)))
+	}
+	minutes := s.DescribeField(FieldMinute, min)
+	minuteEvery := strings.HasPrefix(minutes, "every ")
+	if !(min == "*" && len(parts) > 0) {
+		parts = append(parts, withPreposition("at", minutes))
+	}
+
+	switch {
+	case hour == "*" && !minuteEvery:
+		parts = append(parts, "every hour")
+	case hour == "*":
+	case isPlain(hour):
+		parts = append(parts, fmt.Sprintf("between %s:00 and %s:59", twoDigits(hour), twoDigits(hour)))
+	case isPlainRange(hour):
+		start, end, _ := strings.Cut(hour, "-")
+		parts = append(parts, fmt.Sprintf("between %s:00 and %s:59", twoDigits(start), twoDigits(end)))
+	default:
+		parts = append(parts, withPreposition("during", s.DescribeField(FieldHour, hour)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// listTimes returns the times of day of a schedule whose time fields are plain values,
+// or nil when they are not or there are too many.
+func (s *Schedule) listTimes(sec, min, hour string) []string {
+	secs, mins, hours := strings.Split(sec, ","), strings.Split(min, ","), strings.Split(hour, ",")
+	for _, items := range [][]string{secs, mins, hours} {
+		if !allPlain(items) {
+			return nil
+		}
+	}
+	if len(secs)*len(mins)*len(hours) > maxListedTimes {
+		return nil
+	}
+	withSeconds := slices.ContainsFunc(secs, func(v string) bool { n, _ := strconv.Atoi(v); return n != 0 })
+	var seconds []int
+	for _, h := range hours {
+		for _, m := range mins {
+			for _, sc := range secs {
+				hn, _ := strconv.Atoi(h)
+				mn, _ := strconv.Atoi(m)
+				sn, _ := strconv.Atoi(sc)
+				seconds = append(seconds, hn*3600+mn*60+sn)
+			}
+		}
+	}
+	slices.Sort(seconds)
+	seconds = slices.Compact(seconds)
+	times := make([]string, len(seconds))
+	for i, n := range seconds {
+		times[i] = fmt.Sprintf("%02d:%02d", n/3600, n/60%60)
+		if withSeconds {
+			times[i] += fmt.Sprintf(":%02d", n%60)
+		}
+	}
+	return times
+}
+
+// describeDays describes the day-of-month and day-of-week fields. Both must match for
+// OnCalendar schedules and for cron fields starting with '*'; otherwise either does.
+func (s *Schedule) describeDays() string {
+	dom, dow := s.field(FieldDayOfMonth), s.field(FieldDayOfWeek)
+	var parts []string
+	if dom != "*" && dom != "?" {
+		parts = append(parts, withPreposition("on", s.DescribeField(FieldDayOfMonth, dom))+" of the month")
+	}
+	if dow != "*" && dow != "?" {
+		parts = append(parts, withPreposition("on", s.DescribeField(FieldDayOfWeek, dow)))
+	}
+	switch {
+	case len(parts) == 0 && s.listTimes(s.field(FieldSecond), s.field(FieldMinute), s.field(FieldHour)) != nil:
+		return "every day"
+	case len(parts) == 2 && !s.domAny && !s.dowAny && !s.intersectDays:
+		return parts[0] + " or " + parts[1]
+	}
+	return strings.Join(parts, " and ")
+}
+
+// field returns the cron-syntax value of a field, or "" when the schedule has none.
+func (s *Schedule) field(name string) string {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// formatValue renders a month or weekday number or name as a name, and other numbers
+// without leading zeros.
+func (s *Schedule) formatValue(name, value string) string {
+	switch name {
+	case FieldMonth:
+		if n, err := parseValue(value, 1, 12, monthNames); err == nil {
+			return time.Month(n).String()
+		}
+	case FieldDayOfWeek:
+		if s.Dialect == DialectQuartz {
+			if n, err := parseValue(value, 1, 7, quartzDayNames); err == nil {
+				return time.Weekday(n - 1).String()
+			}
+		} else if n, err := parseValue(value, 0, 7, standardDayNames); err == nil {
+			return time.Weekday(n % 7).String()
+		}
+	default:
+		if n, err := strconv.Atoi(value); err == nil {
+			return strconv.Itoa(n)
+		}
+	}
+	return value
+}
+
+// isLowest reports whether value is the first value of a numeric field, so that a step
+// starting there reads as "every n units".
+func isLowest(name, value string) bool {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return false
+	}
+	if name == FieldDayOfMonth || name == FieldMonth {
+		return n == 1
+	}
+	return n == 0 && name != FieldYear
+}
+
+// ordinal returns "first" to "fifth", and "6th", "21st" and so on beyond.
+func ordinal(n int) string {
+	if n < len(ordinals) {
+		return ordinals[n]
+	}
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+func isNamed(name string) bool {
+	return name == FieldMonth || name == FieldDayOfWeek
+}
+
+// isPlain reports whether a field item is a single number.
+func isPlain(item string) bool {
+	_, err := strconv.Atoi(item)
+	return err == nil
+}
+
+func allPlain(items []string) bool {
+	return !slices.ContainsFunc(items, func(item string) bool { return !isPlain(item) })
+}
+
+func isPlainRange(item string) bool {
+	start, end, ok := strings.Cut(item, "-")
+	return ok && isPlain(start) && isPlain(end)
+}
+
+func twoDigits(v string) string {
+	n, _ := strconv.Atoi(v)
+	return fmt.Sprintf("%02d", n)
+}
+
+// withPreposition prefixes a description with a preposition unless it starts with
+// "every", as in "every 2 hours".
+func withPreposition(prep, desc string) string {
+	if strings.HasPrefix(desc, "every ") {
+		return desc
+	}
+	return prep + " " + desc
+}
+
+// joinAnd joins items as an English list: "a", "a and b", "a, b and c".
+func joinAnd(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
@@ -0,0 +1,57 @@
+package cron
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"* * * * *", "Every minute"},
+		{"*/10 * * * * *", "Every 10 seconds"},
+		{"0 * * * *", "At minute 0, every hour"},
+		{"5,35 */2 * * *", "At minutes 5 and 35, every 2 hours"},
+		{"*/15 9-17 * * MON-FRI", "Every 15 minutes, between 09:00 and 17:59, on Monday through Friday"},
+		{"0 30 9,17 * * ?", "At 09:30 and 17:30, every day"},
+		{"0 0 1,15 * *", "At 00:00, on days 1 and 15 of the month"},
+		{"0 0 1 * FRI", "At 00:00, on day 1 of the month or on Friday"},
+		{"0 0 */2 * MON", "At 00:00, every 2 days of the month and on Monday"},
+		{"0 0 12 ? * 6#3 2027", "At 12:00, on the third Friday of the month, in year 2027"},
+		{"0 0 12 L-3 JAN-MAR ?", "At 12:00, on the fourth-to-last day of the month, in January through March"},
+		{"30 0 0 15W * ?", "At 00:00:30, on the weekday nearest day 15 of the month"},
+		{"@weekly", "At 00:00, on Sunday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			if got := mustParse(t, tt.expr).Describe(); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribeOnCalendar(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"Mon..Fri *-*-* 09:00:00 Europe/Paris", "At 09:00, on Monday through Friday, in the Europe/Paris timezone"},
+		{"Fri *-*-13", "At 00:00, on day 13 of the month and on Friday"},
+		{"*-*~03 12:00", "At 12:00, on the third-to-last day of the month"},
+		{"*:0/15", "Every 15 minutes"},
+		{"quarterly", "At 00:00, on day 1 of the month, in January, April, July and October"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseOnCalendar(tt.spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := s.Describe(); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
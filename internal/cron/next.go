@@ -0,0 +1,176 @@
+package cron
+
+import (
+	"slices"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// maxDSTShift bounds how far a change of UTC offset moves the wall clock; the largest in
+// tzdata is Antarctica/Troll's two hours.
+const maxDSTShift = 3 * time.Hour
+
+// searchYears bounds how far ahead Next looks for fire times.
+const searchYears = 100
+
+// Run is a fire time of a schedule.
+type Run struct {
+	Time time.Time
+	// Repeated is set when the run's wall-clock time occurs twice because clocks were
+	// set back. Cron matches the wall clock, so the job fires at both instants.
+	Repeated bool
+}
+
+// Next returns the first n fire times strictly after from, evaluated on the wall clock
+// of from's location, and the wall-clock fire times before the last of them that were
+// skipped because clocks were set forward past them. Skipped times are returned as
+// wall-clock fields in UTC.
+func (s *Schedule) Next(from time.Time, n int) ([]Run, []time.Time) {
+	loc := from.Location()
+	limit := from.Year() + searchYears
+	var runs []Run
+	var skipped []time.Time
+	// stop is the wall-clock time after which no run can precede the nth one found
+	var stop time.Time
+
+	// Runs just after a fall-back transition have an earlier wall clock than from
+	for wall := naive(from).Add(-maxDSTShift); n > 0; wall = wall.Add(time.Second) {
+		var ok bool
+		if wall, ok = s.nextWall(wall, limit); !ok || (!stop.IsZero() && wall.After(stop)) {
+			break
+		}
+		local, err := timezone.ResolveLocalTime(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc, timezone.DisambiguateCompatible)
+		if err != nil {
+			break
+		}
+		switch {
+		case local.Nonexistent:
+			if local.Time.After(from) {
+				skipped = append(skipped, wall)
+			}
+		case local.Ambiguous:
+			for _, c := range local.Candidates {
+				if c.After(from) {
+					runs = append(runs, Run{Time: c, Repeated: true})
+				}
+			}
+		case local.Time.After(from):
+			runs = append(runs, Run{Time: local.Time})
+		}
+		if stop.IsZero() && len(runs) >= n {
+			sortRuns(runs)
+			stop = naive(runs[n-1].Time).Add(maxDSTShift)
+		}
+	}
+
+	sortRuns(runs)
+	if len(runs) > n {
+		runs = runs[:n]
+	}
+	if len(runs) == n && n > 0 {
+		last := naive(runs[n-1].Time)
+		skipped = slices.DeleteFunc(skipped, func(w time.Time) bool { return w.After(last) })
+	}
+	return runs, skipped
+}
+
+func sortRuns(runs []Run) {
+	slices.SortStableFunc(runs, func(a, b Run) int { return a.Time.Compare(b.Time) })
+}
+
+// naive returns t's wall-clock fields in UTC.
+func naive(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+}
+
+// nextWall returns the first wall-clock time at or after wall matching s, giving up
+// after the year limit.
+func (s *Schedule) nextWall(wall time.Time, limit int) (time.Time, bool) {
+	for wall.Year() <= limit {
+		year, month, day := wall.Date()
+		switch {
+		case s.years != nil && !s.years[year]:
+			wall = time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		case s.month&(1<<uint(month)) == 0:
+			wall = time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(wall):
+			wall = time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<uint(wall.Hour())) == 0:
+			wall = wall.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<uint(wall.Minute())) == 0:
+			wall = wall.Truncate(time.Minute).Add(time.Minute)
+		case s.second&(1<<uint(wall.Second())) == 0:
+			wall = wall.Add(time.Second)
+		default:
+			return wall, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// dayMatches applies the day-of-month and day-of-week fields to a date. As in Vixie
+// cron, a date matching either field is accepted when both are restricted, and both
+// must match when either starts with '*'. OnCalendar schedules always need both.
+func (s *Schedule) dayMatches(date time.Time) bool {
+	if s.domAny || s.dowAny || s.intersectDays {
+		return s.domMatches(date) && s.dowMatches(date)
+	}
+	return s.domMatches(date) || s.dowMatches(date)
+}
+
+func (s *Schedule) domMatches(date time.Time) bool {
+	year, month, day := date.Date()
+	last := duration.DaysIn(year, month)
+	if s.dom&(1<<uint(day)) != 0 {
+		return true
+	}
+	for _, before := range s.domLast {
+		if day == last-before {
+			return true
+		}
+	}
+	if s.domLastWeekday && day == nearestWeekday(year, month, last) {
+		return true
+	}
+	for _, target := range s.domNearest {
+		if target <= last && day == nearestWeekday(year, month, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Schedule) dowMatches(date time.Time) bool {
+	wd := date.Weekday()
+	day := date.Day()
+	if s.dow&(1<<uint(wd)) != 0 {
+		return true
+	}
+	if s.dowLast&(1<<uint(wd)) != 0 && day+7 > duration.DaysIn(date.Year(), date.Month()) {
+		return true
+	}
+	return s.dowNth[wd]&(1<<uint((day-1)/7+1)) != 0
+}
+
+// nearestWeekday returns the weekday closest to day without leaving the month, as for
+// the Quartz "W" modifier.
+func nearestWeekday(year int, month time.Month, day int) int {
+	last := duration.DaysIn(year, month)
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
@@ -0,0 +1,137 @@
+package cron
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func mustParse(t *testing.T, expr string) *Schedule {
+	s, err := Parse(expr, "")
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", expr, err)
+	}
+	return s
+}
+
+func formatRuns(runs []Run) []string {
+	out := make([]string, len(runs))
+	for i, r := range runs {
+		out[i] = timezone.FormatISOSeconds(r.Time)
+	}
+	return out
+}
+
+func TestNext(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	from := time.Date(2026, 1, 14, 10, 20, 0, 0, ny) // Wednesday
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"*/20 * * * *", []string{"2026-01-14T10:40:00-05:00", "2026-01-14T11:00:00-05:00", "2026-01-14T11:20:00-05:00"}},
+		{"0 9 * * MON-FRI", []string{"2026-01-15T09:00:00-05:00", "2026-01-16T09:00:00-05:00", "2026-01-19T09:00:00-05:00"}},
+		{"30 10 20 * * *", []string{"2026-01-14T20:10:30-05:00", "2026-01-15T20:10:30-05:00", "2026-01-16T20:10:30-05:00"}},
+		// Vixie cron fires when either day field matches
+		{"0 0 1 * FRI", []string{"2026-01-16T00:00:00-05:00", "2026-01-23T00:00:00-05:00", "2026-01-30T00:00:00-05:00"}},
+		// ...but both must match when either starts with '*'
+		{"0 0 */2 * MON", []string{"2026-01-19T00:00:00-05:00", "2026-02-09T00:00:00-05:00", "2026-02-23T00:00:00-05:00"}},
+		{"0 0 12 L * ?", []string{"2026-01-31T12:00:00-05:00", "2026-02-28T12:00:00-05:00", "2026-03-31T12:00:00-04:00"}},
+		{"0 0 12 L-2 * ?", []string{"2026-01-29T12:00:00-05:00", "2026-02-26T12:00:00-05:00", "2026-03-29T12:00:00-04:00"}},
+		{"0 0 12 LW * ?", []string{"2026-01-30T12:00:00-05:00", "2026-02-27T12:00:00-05:00", "2026-03-31T12:00:00-04:00"}},
+		{"0 0 12 1W * ?", []string{"2026-02-02T12:00:00-05:00", "2026-03-02T12:00:00-05:00", "2026-04-01T12:00:00-04:00"}},
+		{"0 0 12 ? * 6L", []string{"2026-01-30T12:00:00-05:00", "2026-02-27T12:00:00-05:00", "2026-03-27T12:00:00-04:00"}},
+		{"0 0 12 ? * MON#2", []string{"2026-02-09T12:00:00-05:00", "2026-03-09T12:00:00-04:00", "2026-04-13T12:00:00-04:00"}},
+		{"0 0 0 29 2 ? *", []string{"2028-02-29T00:00:00-05:00", "2032-02-29T00:00:00-05:00", "2036-02-29T00:00:00-05:00"}},
+		{"0 0 0 1 1 ? 2030/5", []string{"2030-01-01T00:00:00-05:00", "2035-01-01T00:00:00-05:00", "2040-01-01T00:00:00-05:00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			runs, skipped := mustParse(t, tt.expr).Next(from, len(tt.want))
+			got := formatRuns(runs)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Next = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("run %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+			if len(skipped) != 0 {
+				t.Errorf("unexpected skipped runs: %v", skipped)
+			}
+		})
+	}
+}
+
+func TestNextSkippedBySpringForward(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	from := time.Date(2026, 3, 7, 12, 0, 0, 0, ny)
+
+	runs, skipped := mustParse(t, "30 2 * * *").Next(from, 2)
+	want := []string{"2026-03-09T02:30:00-04:00", "2026-03-10T02:30:00-04:00"}
+	got := formatRuns(runs)
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Next = %v, want %v", got, want)
+	}
+	if len(skipped) != 1 || skipped[0] != time.Date(2026, 3, 8, 2, 30, 0, 0, time.UTC) {
+		t.Errorf("skipped = %v, want 2026-03-08 02:30", skipped)
+	}
+}
+
+func TestNextRepeatedByFallBack(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	from := time.Date(2026, 10, 31, 12, 0, 0, 0, ny)
+
+	runs, skipped := mustParse(t, "30 1 * * *").Next(from, 3)
+	want := []string{"2026-11-01T01:30:00-04:00", "2026-11-01T01:30:00-05:00", "2026-11-02T01:30:00-05:00"}
+	got := formatRuns(runs)
+	for i := range want {
+		if i >= len(got) || got[i] != want[i] {
+			t.Fatalf("Next = %v, want %v", got, want)
+		}
+	}
+	if !runs[0].Repeated || !runs[1].Repeated || runs[2].Repeated {
+		t.Errorf("unexpected repeated flags: %+v", runs)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("unexpected skipped runs: %v", skipped)
+	}
+}
+
+func TestNextFromInsideOverlap(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	// 01:50 EDT, ten minutes before clocks go back to 01:00 EST
+	from := time.Date(2026, 11, 1, 5, 50, 0, 0, time.UTC).In(ny)
+
+	runs, _ := mustParse(t, "0,30 * * * *").Next(from, 3)
+	want := []string{"2026-11-01T01:00:00-05:00", "2026-11-01T01:30:00-05:00", "2026-11-01T02:00:00-05:00"}
+	got := formatRuns(runs)
+	for i := range want {
+		if i >= len(got) || got[i] != want[i] {
+			t.Fatalf("Next = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestNextNeverFires(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if runs, _ := mustParse(t, "0 0 30 2 *").Next(from, 3); len(runs) != 0 {
+		t.Errorf("expected no runs for February 30th, got %v", formatRuns(runs))
+	}
+	if runs, _ := mustParse(t, "0 0 0 1 1 ? 2020").Next(from, 3); len(runs) != 0 {
+		t.Errorf("expected no runs in a past year, got %v", formatRuns(runs))
+	}
+}
@@ -0,0 +1,241 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// calendarShorthands are the systemd OnCalendar shorthands and their normalized forms.
+var calendarShorthands = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+}
+
+var weekdayNames = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// calendarComponent is a field of an OnCalendar specification as written and in cron
+// syntax, with the 1-based position of the component holding it.
+type calendarComponent struct {
+	raw, value string
+	position   int
+}
+
+// ParseOnCalendar parses a systemd OnCalendar specification of the form
+// "[weekdays] [date] [time] [timezone]", such as "Mon..Fri *-*-* 09:00:00 Europe/Paris",
+// or one of its shorthands such as "daily". The fields are normalized to cron syntax.
+// Unlike cron, a date must match both the weekdays and the date. Field errors are
+// returned as *FieldError.
+func ParseOnCalendar(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("specification is empty")
+	}
+	s := &Schedule{Expression: spec, Dialect: DialectSystemd, intersectDays: true}
+
+	tokens := strings.Fields(spec)
+	positions := make([]int, len(tokens))
+	for i := range tokens {
+		positions[i] = i + 1
+	}
+	if last := len(tokens) - 1; isTimezoneToken(tokens[last]) {
+		now, err := timezone.GetNowInLocation(tokens[last])
+		if err != nil {
+			return nil, &FieldError{Field: FieldTimezone, Position: last + 1, Value: tokens[last], Reason: "not a known IANA timezone"}
+		}
+		s.Location = now.Location()
+		tokens, positions = tokens[:last], positions[:last]
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("specification has a timezone but no calendar event")
+		}
+	}
+	if len(tokens) == 1 {
+		if expanded, ok := calendarShorthands[strings.ToLower(tokens[0])]; ok {
+			tokens = strings.Fields(expanded)
+			positions = make([]int, len(tokens))
+			for i := range positions {
+				positions[i] = 1
+			}
+		}
+	}
+
+	components := map[string]calendarComponent{
+		FieldDayOfWeek:  {raw: "*", value: "*"},
+		FieldYear:       {raw: "*", value: "*"},
+		FieldMonth:      {raw: "*", value: "*"},
+		FieldDayOfMonth: {raw: "*", value: "*"},
+		FieldHour:       {raw: "00", value: "00"},
+		FieldMinute:     {raw: "00", value: "00"},
+		FieldSecond:     {raw: "00", value: "00"},
+	}
+	i := 0
+	if i < len(tokens) && startsWithLetter(tokens[i]) {
+		value, err := normalizeWeekdays(tokens[i])
+		if err != nil {
+			return nil, &FieldError{Field: FieldDayOfWeek, Position: positions[i], Value: tokens[i], Reason: err.Error()}
+		}
+		components[FieldDayOfWeek] = calendarComponent{raw: tokens[i], value: value, position: positions[i]}
+		i++
+	}
+	if i < len(tokens) && !strings.Contains(tokens[i], ":") {
+		if err := splitDate(tokens[i], positions[i], components); err != nil {
+			return nil, err
+		}
+		i++
+	}
+	if i < len(tokens) && strings.Contains(tokens[i], ":") {
+		if err := splitTime(tokens[i], positions[i], components); err != nil {
+			return nil, err
+		}
+		i++
+	}
+	if i < len(tokens) {
+		return nil, fmt.Errorf("unexpected %q at position %d: expected [weekdays] [date] [time] [timezone]", tokens[i], positions[i])
+	}
+
+	for _, name := range []string{FieldSecond, FieldMinute, FieldHour, FieldDayOfMonth, FieldMonth, FieldDayOfWeek, FieldYear} {
+		c := components[name]
+		s.Fields = append(s.Fields, Field{Name: name, Value: c.value, Raw: c.raw})
+		if err := s.parseField(name, c.value); err != nil {
+			return nil, &FieldError{Field: name, Position: c.position, Value: c.raw, Reason: err.Error()}
+		}
+	}
+	return s, nil
+}
+
+// LooksLikeOnCalendar reports whether spec reads as a systemd OnCalendar specification
+// rather than a cron expression: it uses "..", "~" or ':', starts with a name, or has
+// fewer fields than any cron dialect.
+func LooksLikeOnCalendar(spec string) bool {
+	tokens := strings.Fields(spec)
+	if len(tokens) == 0 || strings.HasPrefix(tokens[0], "@") {
+		return false
+	}
+	return strings.Contains(spec, "..") || strings.ContainsAny(spec, "~:") || startsWithLetter(tokens[0]) || len(tokens) < 5
+}
+
+// isTimezoneToken reports whether a token can only be a trailing timezone.
+func isTimezoneToken(token string) bool {
+	if !startsWithLetter(token) {
+		return false
+	}
+	if _, ok := calendarShorthands[strings.ToLower(token)]; ok {
+		return false
+	}
+	_, err := normalizeWeekdays(token)
+	return err != nil
+}
+
+func startsWithLetter(token string) bool {
+	return token != "" && unicode.IsLetter(rune(token[0]))
+}
+
+// normalizeWeekdays converts a weekday list such as "Mon..Fri,Sun" to cron syntax.
+func normalizeWeekdays(token string) (string, error) {
+	items := strings.Split(token, ",")
+	for i, item := range items {
+		days := strings.Split(item, "..")
+		if len(days) > 2 {
+			return "", fmt.Errorf("range %q has more than two ends", item)
+		}
+		for j, day := range days {
+			name, ok := weekdayAbbreviation(day)
+			if !ok {
+				return "", fmt.Errorf("%q is not a weekday", day)
+			}
+			days[j] = name
+		}
+		items[i] = strings.Join(days, "-")
+	}
+	return strings.Join(items, ","), nil
+}
+
+// weekdayAbbreviation returns the cron name of a full or three-letter weekday name.
+func weekdayAbbreviation(day string) (string, bool) {
+	day = strings.ToLower(day)
+	for _, name := range weekdayNames {
+		if day == name || day == name[:3] {
+			return strings.ToUpper(name[:3]), true
+		}
+	}
+	return "", false
+}
+
+// splitDate splits a date component ("*-*-*", "2026-01-01", "01-01" or "*-02~03") into
+// year, month and day. "~n" counts days from the end of the month.
+func splitDate(token string, position int, components map[string]calendarComponent) error {
+	datePart, lastDays, fromEnd := strings.Cut(token, "~")
+	parts := strings.Split(datePart, "-")
+	names := []string{FieldYear, FieldMonth, FieldDayOfMonth}
+	switch {
+	case fromEnd && len(parts) <= 2:
+		parts = append(parts, "~"+lastDays)
+	case !fromEnd && (len(parts) == 2 || len(parts) == 3):
+	default:
+		return fmt.Errorf("invalid date %q at position %d: expected [year-]month-day", token, position)
+	}
+	names = names[3-len(parts):]
+	for i, raw := range parts {
+		value, err := normalizeCalendarValue(raw, names[i] == FieldDayOfMonth)
+		if err != nil {
+			return &FieldError{Field: names[i], Position: position, Value: raw, Reason: err.Error()}
+		}
+		components[names[i]] = calendarComponent{raw: raw, value: value, position: position}
+	}
+	return nil
+}
+
+// splitTime splits a time component ("09:00", "*:0/15:00" or "12:00:00.000") into hour,
+// minute and second.
+func splitTime(token string, position int, components map[string]calendarComponent) error {
+	parts := strings.Split(token, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return fmt.Errorf("invalid time %q at position %d: expected hour:minute[:second]", token, position)
+	}
+	if len(parts) == 3 {
+		// Fractional seconds are accepted when they are zero
+		if sec, frac, ok := strings.Cut(parts[2], "."); ok && strings.Trim(frac, "0") == "" {
+			parts[2] = sec
+		}
+	}
+	for i, name := range []string{FieldHour, FieldMinute, FieldSecond}[:len(parts)] {
+		value, err := normalizeCalendarValue(parts[i], false)
+		if err != nil {
+			return &FieldError{Field: name, Position: position, Value: parts[i], Reason: err.Error()}
+		}
+		components[name] = calendarComponent{raw: parts[i], value: value, position: position}
+	}
+	return nil
+}
+
+// normalizeCalendarValue converts a numeric OnCalendar field to cron syntax: ".." ranges
+// become "-" and, for the day of the month, "~n" becomes "L-(n-1)".
+func normalizeCalendarValue(raw string, dayOfMonth bool) (string, error) {
+	if raw == "" {
+		return "", fmt.Errorf("value is empty")
+	}
+	if dayOfMonth && strings.HasPrefix(raw, "~") {
+		n, err := strconv.Atoi(raw[1:])
+		if err != nil || n < 1 || n > 31 {
+			return "", fmt.Errorf("%q: expected ~n with n from 1 to 31", raw)
+		}
+		return fmt.Sprintf("L-%d", n-1), nil
+	}
+	if i := strings.IndexFunc(raw, func(r rune) bool { return !strings.ContainsRune("0123456789*,./", r) }); i >= 0 {
+		return "", fmt.Errorf("unexpected character %q", raw[i])
+	}
+	if strings.Contains(strings.ReplaceAll(raw, "..", ""), ".") {
+		return "", fmt.Errorf("ranges are written with \"..\"")
+	}
+	return strings.ReplaceAll(raw, "..", "-"), nil
+}
@@ -0,0 +1,100 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseOnCalendar(t *testing.T) {
+	tests := []struct {
+		spec     string
+		fields   map[string]string
+		location string
+	}{
+		{"Mon..Fri *-*-* 09:00:00 Europe/Paris", map[string]string{FieldDayOfWeek: "MON-FRI", FieldHour: "09", FieldMinute: "00", FieldSecond: "00"}, "Europe/Paris"},
+		{"Sat,Sunday 10:30", map[string]string{FieldDayOfWeek: "SAT,SUN", FieldDayOfMonth: "*", FieldSecond: "00"}, ""},
+		{"2026-12-25", map[string]string{FieldYear: "2026", FieldMonth: "12", FieldDayOfMonth: "25", FieldHour: "00"}, ""},
+		{"*-02~03", map[string]string{FieldMonth: "02", FieldDayOfMonth: "L-2"}, ""},
+		{"*:0/15", map[string]string{FieldHour: "*", FieldMinute: "0/15", FieldSecond: "00"}, ""},
+		{"*-*-1..7 12:00:00.000", map[string]string{FieldDayOfMonth: "1-7", FieldSecond: "00"}, ""},
+		{"weekly UTC", map[string]string{FieldDayOfWeek: "MON", FieldHour: "00"}, "UTC"},
+		{"quarterly", map[string]string{FieldMonth: "01,04,07,10", FieldDayOfMonth: "01"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseOnCalendar(tt.spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s.Dialect != DialectSystemd {
+				t.Errorf("dialect = %s, want %s", s.Dialect, DialectSystemd)
+			}
+			if len(s.Fields) != 7 {
+				t.Errorf("got %d fields, want 7", len(s.Fields))
+			}
+			for name, want := range tt.fields {
+				if got := s.field(name); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+			switch {
+			case tt.location == "" && s.Location != nil:
+				t.Errorf("unexpected location %s", s.Location)
+			case tt.location != "" && (s.Location == nil || s.Location.String() != tt.location):
+				t.Errorf("location = %v, want %s", s.Location, tt.location)
+			}
+		})
+	}
+}
+
+func TestParseOnCalendarErrors(t *testing.T) {
+	tests := []struct {
+		spec     string
+		field    string
+		position int
+		value    string
+	}{
+		{"Mnday 09:00", FieldDayOfWeek, 1, "Mnday"},
+		{"Mon 25:00", FieldHour, 2, "25"},
+		{"*-13-01", FieldMonth, 1, "13"},
+		{"*-*-32 08:00", FieldDayOfMonth, 1, "32"},
+		{"*-*-* 09:0x", FieldMinute, 2, "0x"},
+		{"Mon *-*-* 09:00 Europe/Pariss", FieldTimezone, 4, "Europe/Pariss"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := ParseOnCalendar(tt.spec)
+			var fe *FieldError
+			if !errors.As(err, &fe) {
+				t.Fatalf("expected a FieldError, got %v", err)
+			}
+			if fe.Field != tt.field || fe.Position != tt.position || fe.Value != tt.value {
+				t.Errorf("error = %+v, want field %s at %d with value %q", fe, tt.field, tt.position, tt.value)
+			}
+		})
+	}
+
+	for _, spec := range []string{"", "Europe/Paris", "*-*-* 09:00 10:00 UTC", "1-2-3-4", "9"} {
+		if _, err := ParseOnCalendar(spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
+
+func TestOnCalendarNextMatchesBothDayFields(t *testing.T) {
+	s, err := ParseOnCalendar("Fri *-*-13 09:00")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	runs, _ := s.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 3)
+	want := []string{"2026-02-13T09:00:00+00:00", "2026-03-13T09:00:00+00:00", "2026-11-13T09:00:00+00:00"}
+	got := formatRuns(runs)
+	for i := range want {
+		if i >= len(got) || got[i] != want[i] {
+			t.Fatalf("Next = %v, want %v", got, want)
+		}
+	}
+}
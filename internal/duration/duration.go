@@ -0,0 +1,342 @@
+package duration
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// Addition modes for applying a Duration to a time.
+const (
+	// ModeCalendar applies years, months, weeks and days on the wall-clock calendar
+	// (clamping to the end of shorter months) and the time part as exact elapsed time.
+	ModeCalendar = "calendar"
+	// ModeAbsolute applies the whole duration as exact elapsed time, treating a day as 24h.
+	ModeAbsolute = "absolute"
+)
+
+// maxAbsoluteDays is the number of 24-hour days a time.Duration can hold, about 292 years.
+const maxAbsoluteDays = int64(math.MaxInt64 / (24 * time.Hour))
+
+// Duration is a signed duration split into calendar and clock components.
+type Duration struct {
+	Negative bool
+	Years    int
+	Months   int
+	Weeks    int
+	Days     int
+	// Clock holds the hours, minutes and seconds part as an exact duration.
+	Clock time.Duration
+}
+
+// Parse parses an ISO 8601 duration (e.g. "P1M2DT3H", "-PT90M", "P2W") or a Go duration
+// (e.g. "1h30m", "-90m").
+func Parse(s string) (Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Duration{}, fmt.Errorf("duration is empty")
+	}
+
+	body := strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	if strings.HasPrefix(strings.ToUpper(body), "P") {
+		d, err := parseISO(body)
+		if err != nil {
+			return Duration{}, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+		}
+		d.Negative = strings.HasPrefix(s, "-")
+		return d, nil
+	}
+
+	clock, err := time.ParseDuration(s)
+	if err != nil {
+		return Duration{}, fmt.Errorf("invalid duration %q: expected ISO 8601 (e.g. 'P1DT2H') or Go format (e.g. '1h30m')", s)
+	}
+	if clock < 0 {
+		return Duration{Negative: true, Clock: -clock}, nil
+	}
+	return Duration{Clock: clock}, nil
+}
+
+// parseISO parses the unsigned "PnYnMnWnDTnHnMnS" form. Only the clock components
+// (hours, minutes, seconds) may carry a fraction.
+func parseISO(s string) (Duration, error) {
+	var d Duration
+	s = strings.ToUpper(s)[1:]
+	if s == "" {
+		return d, fmt.Errorf("no components after 'P'")
+	}
+
+	inTime := false
+	// Components must appear in designator order, which also rules out duplicates
+	order := "YMWD"
+	components := 0
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime {
+				return d, fmt.Errorf("duplicate 'T' designator")
+			}
+			inTime = true
+			order = "HMS"
+			s = s[1:]
+			if s == "" {
+				return d, fmt.Errorf("no components after 'T'")
+			}
+			continue
+		}
+
+		end := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return d, fmt.Errorf("expected a number at %q", s)
+		}
+		number := strings.Replace(s[:end], ",", ".", 1)
+		unit := s[end]
+		s = s[end+1:]
+
+		pos := strings.IndexByte(order, unit)
+		if pos < 0 {
+			return d, fmt.Errorf("unexpected component %q", unit)
+		}
+		order = order[pos+1:]
+		components++
+
+		if inTime {
+			value, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return d, fmt.Errorf("invalid number %q", number)
+			}
+			unitDur := time.Second
+			switch unit {
+			case 'H':
+				unitDur = time.Hour
+			case 'M':
+				unitDur = time.Minute
+			}
+			add := value * float64(unitDur)
+			if add > math.MaxInt64-float64(d.Clock) {
+				return d, fmt.Errorf("duration too large")
+			}
+			d.Clock += time.Duration(math.Round(add))
+			continue
+		}
+
+		value, err := strconv.Atoi(number)
+		if err != nil {
+			return d, fmt.Errorf("date component %q must be a whole number", number+string(unit))
+		}
+		switch unit {
+		case 'Y':
+			d.Years = value
+		case 'M':
+			d.Months = value
+		case 'W':
+			d.Weeks = value
+		case 'D':
+			d.Days = value
+		}
+	}
+	if components == 0 {
+		return d, fmt.Errorf("no components")
+	}
+	return d, nil
+}
+
+// HasCalendarPart reports whether d has a year or month component, whose
+// length depends on where on the calendar it is applied.
+func (d Duration) HasCalendarPart() bool {
+	return d.Years != 0 || d.Months != 0
+}
+
+// AddTo applies d to t using the given mode (ModeCalendar when empty).
+// Calendar results that land in a DST gap or overlap are resolved with the
+// compatible disambiguation policy. Results outside the years 0001 to 9999 are errors.
+func (d Duration) AddTo(t time.Time, mode string) (time.Time, error) {
+	sign := 1
+	if d.Negative {
+		sign = -1
+	}
+
+	switch mode {
+	case ModeAbsolute:
+		if d.HasCalendarPart() {
+			return time.Time{}, fmt.Errorf("years and months have no fixed length; use %q mode", ModeCalendar)
+		}
+		// Check the range first: the multiplications below would silently overflow
+		if int64(d.Weeks) > maxAbsoluteDays/7 || int64(d.Days) > maxAbsoluteDays {
+			return time.Time{}, fmt.Errorf("duration too large for %q mode: at most %d days", ModeAbsolute, maxAbsoluteDays)
+		}
+		days := time.Duration(int64(d.Weeks)*7 + int64(d.Days))
+		if days > time.Duration(maxAbsoluteDays) || d.Clock > math.MaxInt64-days*24*time.Hour {
+			return time.Time{}, fmt.Errorf("duration too large for %q mode: at most %d days", ModeAbsolute, maxAbsoluteDays)
+		}
+		elapsed := days*24*time.Hour + d.Clock
+		return inYearRange(t.Add(time.Duration(sign) * elapsed))
+	case "", ModeCalendar:
+		// Bound the fields first: the month and day arithmetic below would silently
+		// overflow, and no larger value can land between the years 0001 and 9999
+		if abs(d.Years) > 9999 || abs(d.Months) > 9999*12 || abs(d.Weeks) > 9999*53 || abs(d.Days) > 9999*366 {
+			return time.Time{}, errOutOfRange
+		}
+		year, month, day := t.Date()
+		hour, min, sec := t.Clock()
+
+		// Move by whole months first so day-of-month can be clamped, e.g. Jan 31 + 1M = Feb 28
+		first := time.Date(year, month+time.Month(sign*(d.Years*12+d.Months)), 1, 0, 0, 0, 0, time.UTC)
+		year, month = first.Year(), first.Month()
+		if last := DaysIn(year, month); day > last {
+			day = last
+		}
+		day += sign * (d.Weeks*7 + d.Days)
+
+		// time.Date normalises the day overflow before ResolveLocalTime checks the wall clock
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		local, err := timezone.ResolveLocalTime(date.Year(), date.Month(), date.Day(), hour, min, sec, t.Nanosecond(), t.Location(), timezone.DisambiguateCompatible)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return inYearRange(local.Time.Add(time.Duration(sign) * d.Clock))
+	default:
+		return time.Time{}, fmt.Errorf("unknown mode %q: expected %q or %q", mode, ModeCalendar, ModeAbsolute)
+	}
+}
+
+var errOutOfRange = errors.New("result is outside the years 0001 to 9999")
+
+// inYearRange returns t, or an error when it falls outside the years 0001 to 9999 that
+// ISO 8601 output can represent.
+func inYearRange(t time.Time) (time.Time, error) {
+	if t.Year() < 1 || t.Year() > 9999 {
+		return time.Time{}, errOutOfRange
+	}
+	return t, nil
+}
+
+func abs(n int) int {
+	return max(n, -n)
+}
+
+// DaysIn returns the number of days in the given month.
+func DaysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Between returns the calendar difference from start to end, measured on the wall
+// clock of start's location: whole years, months and days first, with the remainder
+// as exact elapsed time. The result is negative when end is before start.
+func Between(start, end time.Time) Duration {
+	var d Duration
+	if end.Before(start) {
+		d = Between(end.In(start.Location()), start)
+		d.Negative = true
+		return d
+	}
+	end = end.In(start.Location())
+
+	// Estimate whole months from the calendar fields, then correct for clamping and time of day
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	for months > 0 && addCalendar(start, Duration{Months: months}).After(end) {
+		months--
+	}
+	anchor := addCalendar(start, Duration{Months: months})
+
+	days := int(end.Sub(anchor).Hours() / 24)
+	for days > 0 && addCalendar(anchor, Duration{Days: days}).After(end) {
+		days--
+	}
+	for !addCalendar(anchor, Duration{Days: days + 1}).After(end) {
+		days++
+	}
+	anchor = addCalendar(anchor, Duration{Days: days})
+
+	d.Years, d.Months = months/12, months%12
+	d.Days = days
+	d.Clock = end.Sub(anchor)
+	return d
+}
+
+// addCalendar is AddTo in ModeCalendar for durations known to be valid.
+func addCalendar(t time.Time, d Duration) time.Time {
+	res, _ := d.AddTo(t, ModeCalendar)
+	return res
+}
+
+// String formats d as an ISO 8601 duration such as "P1Y2M3DT4H5M6S", "-PT1.5S" or "PT0S".
+func (d Duration) String() string {
+	var b strings.Builder
+	if d.Negative {
+		b.WriteByte('-')
+	}
+	b.WriteByte('P')
+	for _, c := range []struct {
+		value int
+		unit  byte
+	}{{d.Years, 'Y'}, {d.Months, 'M'}, {d.Weeks, 'W'}, {d.Days, 'D'}} {
+		if c.value != 0 {
+			b.WriteString(strconv.Itoa(c.value))
+			b.WriteByte(c.unit)
+		}
+	}
+
+	clock := d.Clock
+	if clock != 0 {
+		b.WriteByte('T')
+		if h := clock / time.Hour; h != 0 {
+			fmt.Fprintf(&b, "%dH", h)
+			clock -= h * time.Hour
+		}
+		if m := clock / time.Minute; m != 0 {
+			fmt.Fprintf(&b, "%dM", m)
+			clock -= m * time.Minute
+		}
+		if clock != 0 {
+			b.WriteString(strconv.FormatFloat(clock.Seconds(), 'f', -1, 64))
+			b.WriteByte('S')
+		}
+	}
+	if b.Len() == 1 || (d.Negative && b.Len() == 2) {
+		b.WriteString("T0S")
+	}
+	return b.String()
+}
+
+// Humanize describes d in words, e.g. "1 year, 2 months and 3 hours".
+// Sub-second precision is dropped; an empty duration reads "0 seconds".
+func (d Duration) Humanize() string {
+	clock := d.Clock.Truncate(time.Second)
+	parts := []struct {
+		value int
+		unit  string
+	}{
+		{d.Years, "year"},
+		{d.Months, "month"},
+		{d.Weeks, "week"},
+		{d.Days, "day"},
+		{int(clock / time.Hour), "hour"},
+		{int(clock % time.Hour / time.Minute), "minute"},
+		{int(clock % time.Minute / time.Second), "second"},
+	}
+
+	var words []string
+	for _, p := range parts {
+		if p.value == 0 {
+			continue
+		}
+		word := fmt.Sprintf("%d %s", p.value, p.unit)
+		if p.value != 1 {
+			word += "s"
+		}
+		words = append(words, word)
+	}
+	switch len(words) {
+	case 0:
+		return "0 seconds"
+	case 1:
+		return words[0]
+	default:
+		return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+	}
+}
@@ -0,0 +1,239 @@
+package duration
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Duration
+	}{
+		{"ISO full", "P1Y2M3W4DT5H6M7S", Duration{Years: 1, Months: 2, Weeks: 3, Days: 4, Clock: 5*time.Hour + 6*time.Minute + 7*time.Second}},
+		{"ISO month and days", "P1M2DT3H", Duration{Months: 1, Days: 2, Clock: 3 * time.Hour}},
+		{"ISO minutes only", "PT90M", Duration{Clock: 90 * time.Minute}},
+		{"ISO fractional hours", "PT1.5H", Duration{Clock: 90 * time.Minute}},
+		{"ISO comma fraction", "PT0,5S", Duration{Clock: 500 * time.Millisecond}},
+		{"ISO negative", "-P1D", Duration{Negative: true, Days: 1}},
+		{"ISO lowercase", "p2w", Duration{Weeks: 2}},
+		{"Go duration", "1h30m", Duration{Clock: 90 * time.Minute}},
+		{"Go negative", "-45s", Duration{Negative: true, Clock: 45 * time.Second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	inputs := []string{"", "P", "PT", "P1.5D", "P1D1Y", "P1DT2H3H", "PT1Y", "P1X", "90 minutes", "PTT1H"}
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if _, err := Parse(input); err == nil {
+				t.Errorf("expected error for %q", input)
+			}
+		})
+	}
+}
+
+func TestAddToCalendar(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		duration string
+		want     string
+	}{
+		{"month end clamping", time.Date(2025, 1, 31, 9, 0, 0, 0, ny), "P1M", "2025-02-28T09:00:00-05:00"},
+		{"leap year clamping", time.Date(2024, 1, 31, 9, 0, 0, 0, ny), "P1M", "2024-02-29T09:00:00-05:00"},
+		{"year from leap day", time.Date(2024, 2, 29, 9, 0, 0, 0, ny), "P1Y", "2025-02-28T09:00:00-05:00"},
+		{"negative months across year", time.Date(2025, 3, 31, 9, 0, 0, 0, ny), "-P4M", "2024-11-30T09:00:00-05:00"},
+		{"day keeps wall clock across DST", time.Date(2025, 3, 8, 12, 0, 0, 0, ny), "P1D", "2025-03-09T12:00:00-04:00"},
+		{"hours are exact across DST", time.Date(2025, 3, 8, 12, 0, 0, 0, ny), "PT24H", "2025-03-09T13:00:00-04:00"},
+		{"landing in gap", time.Date(2025, 3, 8, 2, 30, 0, 0, ny), "P1D", "2025-03-09T03:30:00-04:00"},
+		{"month then clock", time.Date(2025, 1, 31, 22, 0, 0, 0, ny), "P1MT3H", "2025-03-01T01:00:00-05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Parse(tt.duration)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			got, err := d.AddTo(tt.start, ModeCalendar)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s := timezone.FormatISOSeconds(got); s != tt.want {
+				t.Errorf("AddTo = %s, want %s", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddToAbsolute(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	start := time.Date(2025, 3, 8, 12, 0, 0, 0, ny)
+
+	d, _ := Parse("P1D")
+	got, err := d.AddTo(start, ModeAbsolute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s := timezone.FormatISOSeconds(got); s != "2025-03-09T13:00:00-04:00" {
+		t.Errorf("AddTo absolute = %s, want 2025-03-09T13:00:00-04:00", s)
+	}
+
+	d, _ = Parse("P1M")
+	if _, err := d.AddTo(start, ModeAbsolute); err == nil {
+		t.Error("expected error adding months in absolute mode")
+	}
+	if _, err := d.AddTo(start, "sideways"); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+
+	// 106751 days is the most a time.Duration holds; beyond it the sum would overflow
+	for _, s := range []string{"P106752D", "P15251W", "P106751DT24H", "P99999999999999D"} {
+		d, _ = Parse(s)
+		if _, err := d.AddTo(start, ModeAbsolute); err == nil || !strings.Contains(err.Error(), "too large") {
+			t.Errorf("%s: expected a too large error, got %v", s, err)
+		}
+	}
+	d, _ = Parse("P106751D")
+	if _, err := d.AddTo(start, ModeAbsolute); err != nil {
+		t.Errorf("P106751D: unexpected error: %v", err)
+	}
+}
+
+func TestAddToYearRange(t *testing.T) {
+	start := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		duration string
+		mode     string
+		want     string
+	}{
+		{"P7973Y", ModeCalendar, "9999-03-08T12:00:00+00:00"},
+		{"-P2025Y", ModeCalendar, "0001-03-08T12:00:00+00:00"},
+		{"P7974Y", ModeCalendar, ""},
+		{"-P2026Y", ModeCalendar, ""},
+		{"P9999999999999Y", ModeCalendar, ""},
+		{"P99999999999999D", ModeCalendar, ""},
+		{"-P9999999999999M", ModeCalendar, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.duration, func(t *testing.T) {
+			d, err := Parse(tt.duration)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			got, err := d.AddTo(start, tt.mode)
+			if tt.want == "" {
+				if err == nil || !strings.Contains(err.Error(), "outside the years 0001 to 9999") {
+					t.Errorf("expected a range error, got %v, %v", got, err)
+				}
+				return
+			}
+			if err != nil || timezone.FormatISOSeconds(got) != tt.want {
+				t.Errorf("AddTo() = %s, %v, want %s", timezone.FormatISOSeconds(got), err, tt.want)
+			}
+		})
+	}
+
+	d, _ := Parse("PT1H")
+	if _, err := d.AddTo(time.Date(9999, 12, 31, 23, 30, 0, 0, time.UTC), ModeAbsolute); err == nil || !strings.Contains(err.Error(), "outside the years") {
+		t.Errorf("expected a range error in absolute mode, got %v", err)
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	if got := DaysIn(2024, time.February); got != 29 {
+		t.Errorf("DaysIn(2024, February) = %d, want 29", got)
+	}
+	if got := DaysIn(2025, time.February); got != 28 {
+		t.Errorf("DaysIn(2025, February) = %d, want 28", got)
+	}
+	if got := DaysIn(2025, time.December); got != 31 {
+		t.Errorf("DaysIn(2025, December) = %d, want 31", got)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		start     time.Time
+		end       time.Time
+		wantISO   string
+		wantHuman string
+	}{
+		{"same instant", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "PT0S", "0 seconds"},
+		{"full breakdown", time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC), time.Date(2025, 3, 18, 10, 30, 15, 0, time.UTC), "P1Y2M3DT2H30M15S", "1 year, 2 months, 3 days, 2 hours, 30 minutes and 15 seconds"},
+		{"month end start", time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC), "P1MT23H", "1 month and 23 hours"},
+		{"negative", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), "-PT12H", "12 hours"},
+		{"calendar day across DST", time.Date(2025, 3, 8, 12, 0, 0, 0, ny), time.Date(2025, 3, 9, 12, 0, 0, 0, ny), "P1D", "1 day"},
+		{"other zone converted to start zone", time.Date(2025, 6, 1, 9, 0, 0, 0, ny), time.Date(2025, 6, 1, 15, 0, 0, 0, time.UTC), "PT2H", "2 hours"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Between(tt.start, tt.end)
+			if got := d.String(); got != tt.wantISO {
+				t.Errorf("Between().String() = %s, want %s", got, tt.wantISO)
+			}
+			if got := d.Humanize(); got != tt.wantHuman {
+				t.Errorf("Between().Humanize() = %s, want %s", got, tt.wantHuman)
+			}
+			// Adding the difference back must land on end
+			back, err := d.AddTo(tt.start, ModeCalendar)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !back.Equal(tt.end) {
+				t.Errorf("start + difference = %v, want %v", back, tt.end)
+			}
+		})
+	}
+}
+
+func TestDurationString(t *testing.T) {
+	tests := []struct {
+		d    Duration
+		want string
+	}{
+		{Duration{}, "PT0S"},
+		{Duration{Negative: true}, "-PT0S"},
+		{Duration{Weeks: 2}, "P2W"},
+		{Duration{Clock: 1500 * time.Millisecond}, "PT1.5S"},
+		{Duration{Negative: true, Days: 1, Clock: 90 * time.Minute}, "-P1DT1H30M"},
+	}
+	for _, tt := range tests {
+		if got := tt.d.String(); got != tt.want {
+			t.Errorf("%+v.String() = %s, want %s", tt.d, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Package epoch converts between instants and Unix timestamps in seconds,
+// milliseconds, microseconds or nanoseconds, detecting the unit of a bare timestamp
+// from its magnitude.
+package epoch
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Unit is the resolution of a Unix timestamp.
+type Unit string
+
+// Supported units.
+const (
+	Seconds      Unit = "s"
+	Milliseconds Unit = "ms"
+	Microseconds Unit = "us"
+	Nanoseconds  Unit = "ns"
+)
+
+// Units lists the supported units from the coarsest.
+var Units = []Unit{Seconds, Milliseconds, Microseconds, Nanoseconds}
+
+// unitAliases maps accepted unit spellings to units.
+var unitAliases = map[string]Unit{
+	"s": Seconds, "sec": Seconds, "secs": Seconds, "second": Seconds, "seconds": Seconds,
+	"ms": Milliseconds, "milli": Milliseconds, "millis": Milliseconds, "millisecond": Milliseconds, "milliseconds": Milliseconds,
+	"us": Microseconds, "µs": Microseconds, "μs": Microseconds, "micro": Microseconds, "micros": Microseconds, "microsecond": Microseconds, "microseconds": Microseconds,
+	"ns": Nanoseconds, "nano": Nanoseconds, "nanos": Nanoseconds, "nanosecond": Nanoseconds, "nanoseconds": Nanoseconds,
+}
+
+// maxSeconds bounds timestamps to the years 0001 to 9999, which ISO 8601 output can
+// represent.
+var (
+	minSeconds = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxSeconds = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC).Unix()
+)
+
+// ParseUnit parses a unit name such as "ms", "µs" or "nanoseconds".
+func ParseUnit(s string) (Unit, error) {
+	if u, ok := unitAliases[strings.ToLower(strings.TrimSpace(s))]; ok {
+		return u, nil
+	}
+	return "", fmt.Errorf("unknown unit %q: expected s, ms, us or ns", s)
+}
+
+// nanosPer returns the number of nanoseconds in one unit.
+func (u Unit) nanosPer() int64 {
+	switch u {
+	case Milliseconds:
+		return int64(time.Millisecond)
+	case Microseconds:
+		return int64(time.Microsecond)
+	case Nanoseconds:
+		return 1
+	default:
+		return int64(time.Second)
+	}
+}
+
+// Detect guesses the unit of a timestamp from the magnitude of its integer part: up to
+// 11 digits are seconds, up to 14 milliseconds, up to 17 microseconds, and anything
+// longer nanoseconds. This reads present-day timestamps correctly in every unit, while
+// milliseconds before March 1973 read as seconds.
+func Detect(value string) Unit {
+	digits := strings.TrimLeft(strings.TrimLeft(integerPart(value), "+-"), "0")
+	switch {
+	case len(digits) <= 11:
+		return Seconds
+	case len(digits) <= 14:
+		return Milliseconds
+	case len(digits) <= 17:
+		return Microseconds
+	default:
+		return Nanoseconds
+	}
+}
+
+// Parse reads a Unix timestamp such as "1718035200", "-86400" or "1718035200.5" in the
+// given unit, detecting it when unit is empty, and returns the instant in UTC with the
+// unit used.
+func Parse(value string, unit Unit) (time.Time, Unit, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), "_", "")
+	integer, fraction, _ := strings.Cut(value, ".")
+	digits := strings.TrimLeft(integer, "+-")
+	if digits == "" && fraction == "" || len(integer)-len(digits) > 1 || !isDigits(digits) || !isDigits(fraction) {
+		return time.Time{}, "", fmt.Errorf("invalid timestamp %q: expected an integer or decimal number of seconds, milliseconds, microseconds or nanoseconds", value)
+	}
+	if unit == "" {
+		unit = Detect(value)
+	}
+
+	// Exact arithmetic in nanoseconds, as nanosecond timestamps overflow float64
+	perUnit := unit.nanosPer()
+	nanos, _ := new(big.Int).SetString("0"+digits, 10)
+	nanos.Mul(nanos, big.NewInt(perUnit))
+	if fraction != "" {
+		// Digits finer than a nanosecond are dropped
+		scale := len(fmt.Sprint(perUnit)) - 1
+		frac := (fraction + strings.Repeat("0", scale))[:scale]
+		if frac != "" {
+			f, _ := new(big.Int).SetString(frac, 10)
+			nanos.Add(nanos, f)
+		}
+	}
+	if strings.HasPrefix(integer, "-") {
+		nanos.Neg(nanos)
+	}
+
+	sec, nsec := new(big.Int).DivMod(nanos, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() || sec.Int64() < minSeconds || sec.Int64() > maxSeconds {
+		return time.Time{}, "", fmt.Errorf("timestamp %s read in %s is outside the years 0001 to 9999", value, unit)
+	}
+	return time.Unix(sec.Int64(), nsec.Int64()).UTC(), unit, nil
+}
+
+// Format returns t as a whole number of units since the Unix epoch, rounded down.
+func Format(t time.Time, unit Unit) string {
+	nanos := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second)))
+	nanos.Add(nanos, big.NewInt(int64(t.Nanosecond())))
+	// Euclidean division rounds down for the positive divisor
+	return nanos.Div(nanos, big.NewInt(unit.nanosPer())).String()
+}
+
+func integerPart(value string) string {
+	integer, _, _ := strings.Cut(strings.TrimSpace(value), ".")
+	return integer
+}
+
+func isDigits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
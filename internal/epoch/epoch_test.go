@@ -0,0 +1,84 @@
+package epoch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value string
+		unit  Unit
+		want  time.Time
+		// wantUnit is the unit used, detected when unit is empty
+		wantUnit Unit
+	}{
+		{"1718035200", "", time.Date(2024, time.June, 10, 16, 0, 0, 0, time.UTC), Seconds},
+		{"1718035200123", "", time.Date(2024, time.June, 10, 16, 0, 0, 123000000, time.UTC), Milliseconds},
+		{"1718035200123456", "", time.Date(2024, time.June, 10, 16, 0, 0, 123456000, time.UTC), Microseconds},
+		{"1718035200123456789", "", time.Date(2024, time.June, 10, 16, 0, 0, 123456789, time.UTC), Nanoseconds},
+		{"1718035200.25", "", time.Date(2024, time.June, 10, 16, 0, 0, 250000000, time.UTC), Seconds},
+		{"1_718_035_200", "", time.Date(2024, time.June, 10, 16, 0, 0, 0, time.UTC), Seconds},
+		{"-86400.5", "", time.Date(1969, time.December, 30, 23, 59, 59, 500000000, time.UTC), Seconds},
+		{"0", "", time.Unix(0, 0).UTC(), Seconds},
+		// An override reads a short value in a finer unit
+		{"1718035200", Milliseconds, time.Date(1970, time.January, 20, 21, 13, 55, 200000000, time.UTC), Milliseconds},
+		{"1718035200123.4567", Milliseconds, time.Date(2024, time.June, 10, 16, 0, 0, 123456700, time.UTC), Milliseconds},
+		// Twelve digits read as milliseconds unless the unit is given
+		{"253402300799", "", time.UnixMilli(253402300799).UTC(), Milliseconds},
+		{"253402300799", Seconds, time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC), Seconds},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value+string(tt.unit), func(t *testing.T) {
+			got, unit, err := Parse(tt.value, tt.unit)
+			if err != nil {
+				t.Fatalf("Parse returned error: %v", err)
+			}
+			if !got.Equal(tt.want) || unit != tt.wantUnit {
+				t.Errorf("Parse(%q, %q) = %s %s, want %s %s", tt.value, tt.unit, got, unit, tt.want, tt.wantUnit)
+			}
+		})
+	}
+
+	for _, value := range []string{"", ".", "12:30", "1e9", "--5", "253402300800"} {
+		if _, _, err := Parse(value, Seconds); err == nil {
+			t.Errorf("Parse(%q) expected error", value)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	at := time.Date(2024, time.June, 10, 16, 0, 0, 123456789, time.UTC)
+	before := time.Date(1969, time.December, 31, 23, 59, 59, 999000000, time.UTC)
+	tests := []struct {
+		t    time.Time
+		unit Unit
+		want string
+	}{
+		{at, Seconds, "1718035200"},
+		{at, Milliseconds, "1718035200123"},
+		{at, Microseconds, "1718035200123456"},
+		{at, Nanoseconds, "1718035200123456789"},
+		// Times before the epoch round down
+		{before, Seconds, "-1"},
+		{before, Milliseconds, "-1"},
+		{before, Nanoseconds, "-1000000"},
+		{time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC), Nanoseconds, "253402300799000000000"},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.t, tt.unit); got != tt.want {
+			t.Errorf("Format(%s, %s) = %s, want %s", tt.t, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	for input, want := range map[string]Unit{"s": Seconds, "Millis": Milliseconds, "µs": Microseconds, "nanoseconds": Nanoseconds} {
+		if got, err := ParseUnit(input); err != nil || got != want {
+			t.Errorf("ParseUnit(%q) = %s, %v; want %s", input, got, err, want)
+		}
+	}
+	if _, err := ParseUnit("fortnights"); err == nil {
+		t.Error("expected error for an unknown unit")
+	}
+}
@@ -0,0 +1,454 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/businessday"
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/holidays"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+	"github.com/r0mdau/mcp-time/internal/zones"
+)
+
+const (
+	maxBusinessDays      = 10000
+	maxBusinessRangeDays = 36525 // 100 years
+	maxBusinessHours     = 50000
+)
+
+// AddBusinessDays implements the add_business_days MCP tool handler.
+// It returns the date a number of business days before or after a date, skipping
+// weekends and holidays.
+func AddBusinessDays(ctx context.Context, req *mcp.CallToolRequest, input types.AddBusinessDaysInput) (
+	*mcp.CallToolResult,
+	types.AddBusinessDaysResult,
+	error,
+) {
+	if input.Days < -maxBusinessDays || input.Days > maxBusinessDays {
+		return nil, types.AddBusinessDaysResult{}, fmt.Errorf("days must be between -%d and %d", maxBusinessDays, maxBusinessDays)
+	}
+	calendar, err := buildCalendar(input.BusinessCalendarInput)
+	if err != nil {
+		return nil, types.AddBusinessDaysResult{}, err
+	}
+	start, err := businessDate(input.Date, input.Timezone)
+	if err != nil {
+		return nil, types.AddBusinessDaysResult{}, fmt.Errorf("invalid date: %w", err)
+	}
+
+	end := calendar.AddDays(start, input.Days)
+	if err := calendar.Covers(start, end); err != nil {
+		return nil, types.AddBusinessDaysResult{}, err
+	}
+	return nil, types.AddBusinessDaysResult{
+		Start:        start.Format(time.DateOnly),
+		Date:         end.Format(time.DateOnly),
+		DayOfWeek:    end.Weekday().String(),
+		BusinessDays: input.Days,
+		CalendarDays: int(end.Sub(start).Hours() / 24),
+		Weekend:      weekendNames(calendar),
+		Holidays:     holidayResults(calendar.Holidays(start, end)),
+		Notes:        calendarNotes(calendar),
+	}, nil
+}
+
+// CountBusinessDays implements the count_business_days MCP tool handler.
+// It counts the business days between two dates.
+func CountBusinessDays(ctx context.Context, req *mcp.CallToolRequest, input types.CountBusinessDaysInput) (
+	*mcp.CallToolResult,
+	types.CountBusinessDaysResult,
+	error,
+) {
+	calendar, err := buildCalendar(input.BusinessCalendarInput)
+	if err != nil {
+		return nil, types.CountBusinessDaysResult{}, err
+	}
+	start, err := businessDate(input.Start, input.Timezone)
+	if err != nil {
+		return nil, types.CountBusinessDaysResult{}, fmt.Errorf("invalid start: %w", err)
+	}
+	end, err := businessDate(input.End, input.Timezone)
+	if err != nil {
+		return nil, types.CountBusinessDaysResult{}, fmt.Errorf("invalid end: %w", err)
+	}
+	days := int(end.Sub(start).Hours() / 24)
+	if days < -maxBusinessRangeDays || days > maxBusinessRangeDays {
+		return nil, types.CountBusinessDaysResult{}, fmt.Errorf("start and end must be at most %d days apart", maxBusinessRangeDays)
+	}
+	if err := calendar.Covers(start, end); err != nil {
+		return nil, types.CountBusinessDaysResult{}, err
+	}
+
+	// Counting starts the day after from, so start one day earlier (later when going
+	// back) to count start itself
+	from := start
+	if input.Inclusive {
+		if days >= 0 {
+			from = start.AddDate(0, 0, -1)
+		} else {
+			from = start.AddDate(0, 0, 1)
+		}
+	}
+	businessDays := calendar.Count(from, end)
+	skipped := calendar.Holidays(from, end)
+	spanned := int(end.Sub(from).Hours() / 24)
+	weekendDays := max(spanned, -spanned) - max(businessDays, -businessDays) - len(skipped)
+
+	return nil, types.CountBusinessDaysResult{
+		Start:        start.Format(time.DateOnly),
+		End:          end.Format(time.DateOnly),
+		BusinessDays: businessDays,
+		CalendarDays: days,
+		WeekendDays:  weekendDays,
+		Weekend:      weekendNames(calendar),
+		Holidays:     holidayResults(skipped),
+		Notes:        calendarNotes(calendar),
+	}, nil
+}
+
+// CheckBusinessHours implements the check_business_hours MCP tool handler.
+// It reports whether a moment falls within working hours in a timezone, and when the
+// current working window closes or the next one opens.
+func CheckBusinessHours(ctx context.Context, req *mcp.CallToolRequest, input types.CheckBusinessHoursInput) (
+	*mcp.CallToolResult,
+	types.BusinessHoursResult,
+	error,
+) {
+	calendar, err := buildCalendar(input.BusinessCalendarInput)
+	if err != nil {
+		return nil, types.BusinessHoursResult{}, err
+	}
+	tz, t, err := resolveInstant(input.Datetime, input.Timezone)
+	if err != nil {
+		return nil, types.BusinessHoursResult{}, fmt.Errorf("invalid datetime or timezone: %w", err)
+	}
+	schedule, err := buildSchedule(calendar, t.Location(), input.WorkStart, input.WorkEnd)
+	if err != nil {
+		return nil, types.BusinessHoursResult{}, err
+	}
+
+	if err := calendar.Covers(t, t); err != nil {
+		return nil, types.BusinessHoursResult{}, err
+	}
+
+	status := schedule.Check(t)
+	result := types.BusinessHoursResult{
+		Time:   timeutil.BuildTimeResult(t, tz),
+		Open:   status.Open,
+		Reason: status.Reason,
+		Notes:  calendarNotes(calendar),
+	}
+	if status.Holiday != nil {
+		h := holidayResults([]holidays.Holiday{*status.Holiday})[0]
+		result.Holiday = &h
+	}
+	if !status.Opens.IsZero() {
+		opens := timeutil.BuildTimeResult(status.Opens, tz)
+		result.Opens = &opens
+	}
+	if !status.Closes.IsZero() {
+		closes := timeutil.BuildTimeResult(status.Closes, tz)
+		result.Closes = &closes
+	}
+	return nil, result, nil
+}
+
+// AddBusinessHours implements the add_business_hours MCP tool handler.
+// It returns the deadline a number of working hours after a start, counting only working
+// hours on business days in the schedule's timezone, converted to a target timezone.
+func AddBusinessHours(ctx context.Context, req *mcp.CallToolRequest, input types.AddBusinessHoursInput) (
+	*mcp.CallToolResult,
+	types.BusinessDeadlineResult,
+	error,
+) {
+	if input.Hours < 0 || input.Hours > maxBusinessHours {
+		return nil, types.BusinessDeadlineResult{}, fmt.Errorf("hours must be between 0 and %d", maxBusinessHours)
+	}
+	calendar, err := buildCalendar(input.BusinessCalendarInput)
+	if err != nil {
+		return nil, types.BusinessDeadlineResult{}, err
+	}
+	tz, start, err := resolveInstant(input.Start, input.Timezone)
+	if err != nil {
+		return nil, types.BusinessDeadlineResult{}, fmt.Errorf("invalid start or timezone: %w", err)
+	}
+	targetTZ := input.TargetTimezone
+	if targetTZ == "" {
+		targetTZ = tz
+	}
+	locTo, err := time.LoadLocation(targetTZ)
+	if err != nil {
+		return nil, types.BusinessDeadlineResult{}, fmt.Errorf("invalid target timezone %q: %w%s", targetTZ, err, didYouMean(targetTZ))
+	}
+	schedule, err := buildSchedule(calendar, start.Location(), input.WorkStart, input.WorkEnd)
+	if err != nil {
+		return nil, types.BusinessDeadlineResult{}, err
+	}
+
+	d := time.Duration(input.Hours * float64(time.Hour)).Round(time.Second)
+	clockStart := schedule.NextOpen(start)
+	deadline := schedule.Add(start, d)
+	if err := calendar.Covers(start, deadline); err != nil {
+		return nil, types.BusinessDeadlineResult{}, err
+	}
+	target := deadline.In(locTo)
+	_, offSource := deadline.Zone()
+	_, offTarget := target.Zone()
+
+	elapsed := "no time"
+	if deadline.After(start) {
+		elapsed = duration.Between(start, deadline).Humanize()
+	}
+	// Holidays covers the days after its first argument: start from the day before so a
+	// holiday on the start day itself is listed
+	skipped := calendar.Holidays(start.AddDate(0, 0, -1), deadline)
+	return nil, types.BusinessDeadlineResult{
+		Start:      timeutil.BuildTimeResult(start, tz),
+		ClockStart: timeutil.BuildTimeResult(clockStart, tz),
+		Deadline: types.TimeConversionResult{
+			Source:         timeutil.BuildTimeResult(deadline, tz),
+			Target:         timeutil.BuildTimeResult(target, targetTZ),
+			TimeDifference: timeutil.FormatTimeDifference(offSource, offTarget),
+		},
+		BusinessHours: d.Hours(),
+		Elapsed:       elapsed,
+		Holidays:      holidayResults(skipped),
+		Notes:         calendarNotes(calendar),
+	}, nil
+}
+
+// buildCalendar builds a business calendar from the shared calendar parameters.
+func buildCalendar(input types.BusinessCalendarInput) (*businessday.Calendar, error) {
+	var weekend []time.Weekday
+	if input.Weekend != nil {
+		weekend = []time.Weekday{}
+		for _, name := range input.Weekend {
+			day, err := timeutil.ParseWeekday(name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weekend: %w", err)
+			}
+			weekend = append(weekend, day)
+		}
+	}
+
+	var custom []holidays.Holiday
+	for _, s := range input.Holidays {
+		date, name, _ := strings.Cut(strings.TrimSpace(s), " ")
+		year, month, day, err := timeutil.ParseDateInput(date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday %q: %w", s, err)
+		}
+		name = strings.TrimSpace(name)
+		if name == "" {
+			name = "Custom holiday"
+		}
+		custom = append(custom, holidays.Holiday{Date: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), Name: name})
+	}
+	return businessday.NewCalendar(input.Country, weekend, custom)
+}
+
+// buildSchedule applies the 09:00-17:00 default working hours.
+func buildSchedule(calendar *businessday.Calendar, loc *time.Location, workStart, workEnd string) (businessday.Schedule, error) {
+	schedule := businessday.Schedule{Calendar: calendar, Location: loc, Start: 9 * time.Hour, End: 17 * time.Hour}
+	var err error
+	if workStart != "" {
+		if schedule.Start, err = parseClock(workStart); err != nil {
+			return businessday.Schedule{}, fmt.Errorf("invalid work_start: %w", err)
+		}
+	}
+	if workEnd != "" {
+		if schedule.End, err = parseEndClock(workEnd); err != nil {
+			return businessday.Schedule{}, fmt.Errorf("invalid work_end: %w", err)
+		}
+	}
+	if schedule.End <= schedule.Start {
+		return businessday.Schedule{}, fmt.Errorf("work_end must be after work_start")
+	}
+	return schedule, nil
+}
+
+// businessDate parses a YYYY-MM-DD date, defaulting to today in tz, as midnight UTC.
+func businessDate(date, tz string) (time.Time, error) {
+	if date != "" {
+		year, month, day, err := timeutil.ParseDateInput(date)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+	}
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	return holidays.Day(now), nil
+}
+
+// weekendNames lists the weekend days of a calendar, Monday first.
+func weekendNames(calendar *businessday.Calendar) []string {
+	names := []string{}
+	for i := 1; i <= 7; i++ {
+		if day := time.Weekday(i % 7); calendar.Weekend[day] {
+			names = append(names, day.String())
+		}
+	}
+	return names
+}
+
+// calendarNotes tells when a calendar's country has no built-in public holidays.
+func calendarNotes(calendar *businessday.Calendar) []string {
+	if calendar.Country == "" || calendar.PublicHolidays {
+		return nil
+	}
+	code, _, _ := strings.Cut(calendar.Country, "-")
+	name, _ := zones.CountryName(code)
+	return []string{fmt.Sprintf("public holidays of %s are not built in: only its weekend and the holidays given are days off", name)}
+}
+
+func holidayResults(list []holidays.Holiday) []types.HolidayResult {
+	results := []types.HolidayResult{}
+	for _, h := range list {
+		results = append(results, types.HolidayResult{
+			Date:      h.Date.Format(time.DateOnly),
+			DayOfWeek: h.Date.Weekday().String(),
+			Name:      h.Name,
+			LocalName: h.LocalName,
+			Observed:  h.Observed,
+			Regional:  h.Regional,
+		})
+	}
+	return results
+}
+
+func businessCalendarProperties() map[string]any {
+	return map[string]any{
+		"country": map[string]any{
+			"type":        "string",
+			"description": fmt.Sprintf("ISO 3166-1 alpha-2 country code whose usual weekend and public holidays are days off (e.g., 'US', 'DE', 'SA'), or an ISO 3166-2 subdivision code for regional holidays (e.g., 'DE-BY', 'GB-SCT'). Public holidays are built in for %s; any other country only sets the weekend, so list its holidays in holidays.", strings.Join(holidays.Countries(), ", ")),
+		},
+		"weekend": map[string]any{
+			"type":        "array",
+			"items":       map[string]any{"type": "string"},
+			"description": "Weekend days (e.g., ['Friday', 'Saturday']). Defaults to Saturday and Sunday, or the country's usual weekend.",
+		},
+		"holidays": map[string]any{
+			"type":        "array",
+			"items":       map[string]any{"type": "string"},
+			"description": "Extra days off as YYYY-MM-DD, optionally followed by a name (e.g., '2026-12-24 Office closed').",
+		},
+	}
+}
+
+func registerBusinessDays(server *mcp.Server, localTZ string) {
+	timezoneProperty := map[string]any{
+		"type":        "string",
+		"description": fmt.Sprintf("IANA timezone name whose date is today, used when a date is omitted. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+	}
+
+	addProperties := businessCalendarProperties()
+	addProperties["date"] = map[string]any{
+		"type":        "string",
+		"description": "Start date (YYYY-MM-DD), not itself counted. Defaults to today.",
+	}
+	addProperties["days"] = map[string]any{
+		"type":        "integer",
+		"description": fmt.Sprintf("Number of business days to add, negative to subtract (at most %d).", maxBusinessDays),
+	}
+	addProperties["timezone"] = timezoneProperty
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "add_business_days",
+		Description: "Add or subtract business days from a date, skipping weekends, the public holidays of a country and custom days off",
+		InputSchema: map[string]any{
+			"type":       "object",
+			"properties": addProperties,
+			"required":   []string{"days"},
+		},
+	}, AddBusinessDays)
+
+	countProperties := businessCalendarProperties()
+	countProperties["start"] = map[string]any{
+		"type":        "string",
+		"description": "Start date (YYYY-MM-DD), excluded from the count unless inclusive is set. Defaults to today.",
+	}
+	countProperties["end"] = map[string]any{
+		"type":        "string",
+		"description": "End date (YYYY-MM-DD), included in the count. Defaults to today.",
+	}
+	countProperties["inclusive"] = map[string]any{
+		"type":        "boolean",
+		"description": "Count the start date too, as spreadsheet NETWORKDAYS functions do.",
+	}
+	countProperties["timezone"] = timezoneProperty
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "count_business_days",
+		Description: "Count the business days between two dates, excluding weekends, the public holidays of a country and custom days off",
+		InputSchema: map[string]any{
+			"type":       "object",
+			"properties": countProperties,
+		},
+	}, CountBusinessDays)
+
+	checkProperties := businessCalendarProperties()
+	checkProperties["datetime"] = map[string]any{
+		"type":        "string",
+		"description": "ISO 8601 datetime to check (e.g., '2026-03-29T14:30:00' or '2026-03-29T14:30:00Z'). Defaults to now.",
+	}
+	checkProperties["timezone"] = map[string]any{
+		"type":        "string",
+		"description": fmt.Sprintf("IANA timezone of the working hours. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+	}
+	checkProperties["work_start"] = map[string]any{
+		"type":        "string",
+		"description": "Start of working hours in HH:MM (default 09:00)",
+	}
+	checkProperties["work_end"] = map[string]any{
+		"type":        "string",
+		"description": "End of working hours in HH:MM (default 17:00), 24:00 being midnight",
+	}
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "check_business_hours",
+		Description: "Check whether a moment falls within business hours in a timezone, accounting for weekends and holidays, and return when the current window closes or the next one opens",
+		InputSchema: map[string]any{
+			"type":       "object",
+			"properties": checkProperties,
+			"required":   []string{"timezone"},
+		},
+	}, CheckBusinessHours)
+
+	deadlineProperties := businessCalendarProperties()
+	deadlineProperties["start"] = map[string]any{
+		"type":        "string",
+		"description": "ISO 8601 datetime the clock starts from (e.g., '2026-03-26T16:45:00' in timezone, or with an offset). Out of hours, the clock starts at the next opening. Defaults to now.",
+	}
+	deadlineProperties["hours"] = map[string]any{
+		"type":        "number",
+		"description": "Working hours to add, possibly fractional (e.g., 8 or 4.5)",
+	}
+	deadlineProperties["timezone"] = map[string]any{
+		"type":        "string",
+		"description": fmt.Sprintf("IANA timezone of the working hours (e.g., 'Asia/Singapore'). Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+	}
+	deadlineProperties["target_timezone"] = map[string]any{
+		"type":        "string",
+		"description": "IANA timezone to show the deadline in as well, such as the viewer's (e.g., 'Europe/Dublin'). Defaults to timezone.",
+	}
+	deadlineProperties["work_start"] = checkProperties["work_start"]
+	deadlineProperties["work_end"] = checkProperties["work_end"]
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "add_business_hours",
+		Description: "Compute an SLA deadline: add working hours to a start time on a working-hours schedule in one timezone, skipping nights, weekends and holidays, and convert the deadline to the viewer's timezone",
+		InputSchema: map[string]any{
+			"type":       "object",
+			"properties": deadlineProperties,
+			"required":   []string{"hours", "timezone"},
+		},
+	}, AddBusinessHours)
+}
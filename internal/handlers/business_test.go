@@ -0,0 +1,281 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestAddBusinessDays(t *testing.T) {
+	_, out, err := AddBusinessDays(context.Background(), nil, types.AddBusinessDaysInput{
+		Date:                  "2026-04-03",
+		Days:                  5,
+		BusinessCalendarInput: types.BusinessCalendarInput{Country: "de", Holidays: []string{"2026-04-08 Team offsite"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Good Friday itself is not counted; Easter Monday and the offsite are skipped
+	if out.Date != "2026-04-14" || out.DayOfWeek != "Tuesday" || out.CalendarDays != 11 {
+		t.Errorf("unexpected result: %+v", out)
+	}
+	if len(out.Holidays) != 2 || out.Holidays[0].Name != "Easter Monday" || out.Holidays[0].LocalName != "Ostermontag" || out.Holidays[1].Name != "Team offsite" {
+		t.Errorf("unexpected holidays: %+v", out.Holidays)
+	}
+	if strings.Join(out.Weekend, ",") != "Saturday,Sunday" {
+		t.Errorf("unexpected weekend: %v", out.Weekend)
+	}
+
+	_, out, err = AddBusinessDays(context.Background(), nil, types.AddBusinessDaysInput{
+		Date:                  "2026-03-26",
+		Days:                  -2,
+		BusinessCalendarInput: types.BusinessCalendarInput{Weekend: []string{"Fri", "Sat"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Date != "2026-03-24" || strings.Join(out.Weekend, ",") != "Friday,Saturday" {
+		t.Errorf("unexpected result with a Friday and Saturday weekend: %+v", out)
+	}
+
+	_, out, err = AddBusinessDays(context.Background(), nil, types.AddBusinessDaysInput{
+		Date:                  "2026-03-26",
+		Days:                  1,
+		BusinessCalendarInput: types.BusinessCalendarInput{Country: "SA"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Date != "2026-03-29" || strings.Join(out.Weekend, ",") != "Friday,Saturday" || len(out.Notes) != 1 || !strings.Contains(out.Notes[0], "Saudi Arabia are not built in") {
+		t.Errorf("unexpected result for a country without holidays: %+v", out)
+	}
+}
+
+func TestCountBusinessDays(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.CountBusinessDaysInput
+		want  types.CountBusinessDaysResult
+	}{
+		{
+			name:  "US December",
+			input: types.CountBusinessDaysInput{Start: "2026-11-30", End: "2026-12-31", BusinessCalendarInput: types.BusinessCalendarInput{Country: "US"}},
+			want:  types.CountBusinessDaysResult{BusinessDays: 22, CalendarDays: 31, WeekendDays: 8},
+		},
+		{
+			name:  "inclusive",
+			input: types.CountBusinessDaysInput{Start: "2026-12-01", End: "2026-12-31", Inclusive: true, BusinessCalendarInput: types.BusinessCalendarInput{Country: "US"}},
+			want:  types.CountBusinessDaysResult{BusinessDays: 22, CalendarDays: 30, WeekendDays: 8},
+		},
+		{
+			name:  "reversed inclusive",
+			input: types.CountBusinessDaysInput{Start: "2026-12-31", End: "2026-12-01", Inclusive: true, BusinessCalendarInput: types.BusinessCalendarInput{Country: "US"}},
+			want:  types.CountBusinessDaysResult{BusinessDays: -22, CalendarDays: -30, WeekendDays: 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := CountBusinessDays(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.BusinessDays != tt.want.BusinessDays || out.CalendarDays != tt.want.CalendarDays || out.WeekendDays != tt.want.WeekendDays {
+				t.Errorf("got %+v, want %+v", out, tt.want)
+			}
+			if len(out.Holidays) != 1 || out.Holidays[0].Date != "2026-12-25" {
+				t.Errorf("unexpected holidays: %+v", out.Holidays)
+			}
+		})
+	}
+}
+
+func TestCheckBusinessHours(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.CheckBusinessHoursInput
+		reason  string
+		opens   string
+		closes  string
+		holiday string
+	}{
+		{
+			name:   "open in Tokyo for a UTC instant",
+			input:  types.CheckBusinessHoursInput{Datetime: "2026-03-27T01:00:00Z", Timezone: "Asia/Tokyo"},
+			reason: "open",
+			closes: "2026-03-27T17:00:00+09:00",
+		},
+		{
+			name:    "Japanese holiday",
+			input:   types.CheckBusinessHoursInput{Datetime: "2026-03-20T10:00:00", Timezone: "Asia/Tokyo", BusinessCalendarInput: types.BusinessCalendarInput{Country: "JP"}},
+			reason:  "holiday",
+			opens:   "2026-03-23T09:00:00+09:00",
+			holiday: "Vernal Equinox Day",
+		},
+		{
+			name:   "after custom hours",
+			input:  types.CheckBusinessHoursInput{Datetime: "2026-03-27T18:30:00", Timezone: "America/New_York", WorkStart: "08:30", WorkEnd: "18:30"},
+			reason: "after_hours",
+			opens:  "2026-03-30T08:30:00-04:00",
+		},
+		{
+			name:   "open until midnight",
+			input:  types.CheckBusinessHoursInput{Datetime: "2026-03-27T23:30:00", Timezone: "America/New_York", WorkStart: "12:00", WorkEnd: "24:00"},
+			reason: "open",
+			closes: "2026-03-28T00:00:00-04:00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := CheckBusinessHours(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Reason != tt.reason || out.Open != (tt.reason == "open") {
+				t.Errorf("unexpected status: %+v", out)
+			}
+			if (out.Opens == nil) != (tt.opens == "") || out.Opens != nil && out.Opens.Datetime != tt.opens {
+				t.Errorf("opens = %+v, want %q", out.Opens, tt.opens)
+			}
+			if (out.Closes == nil) != (tt.closes == "") || out.Closes != nil && out.Closes.Datetime != tt.closes {
+				t.Errorf("closes = %+v, want %q", out.Closes, tt.closes)
+			}
+			if (out.Holiday == nil) != (tt.holiday == "") || out.Holiday != nil && out.Holiday.Name != tt.holiday {
+				t.Errorf("holiday = %+v, want %q", out.Holiday, tt.holiday)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{"too many days", func() error {
+			_, _, err := AddBusinessDays(context.Background(), nil, types.AddBusinessDaysInput{Days: 20000})
+			return err
+		}, "days must be between"},
+		{"unknown country", func() error {
+			_, _, err := AddBusinessDays(context.Background(), nil, types.AddBusinessDaysInput{Days: 1, BusinessCalendarInput: types.BusinessCalendarInput{Country: "XX"}})
+			return err
+		}, "unknown ISO 3166 country code"},
+		{"bad weekend", func() error {
+			_, _, err := AddBusinessDays(context.Background(), nil, types.AddBusinessDaysInput{Days: 1, BusinessCalendarInput: types.BusinessCalendarInput{Weekend: []string{"Caturday"}}})
+			return err
+		}, "invalid weekend"},
+		{"bad holiday", func() error {
+			_, _, err := CountBusinessDays(context.Background(), nil, types.CountBusinessDaysInput{BusinessCalendarInput: types.BusinessCalendarInput{Holidays: []string{"24/12/2026"}}})
+			return err
+		}, "invalid holiday"},
+		{"range too long", func() error {
+			_, _, err := CountBusinessDays(context.Background(), nil, types.CountBusinessDaysInput{Start: "1900-01-01", End: "2100-01-01"})
+			return err
+		}, "days apart"},
+		{"year before the rules", func() error {
+			_, _, err := CountBusinessDays(context.Background(), nil, types.CountBusinessDaysInput{Start: "1960-01-01", End: "1960-12-31", BusinessCalendarInput: types.BusinessCalendarInput{Country: "US"}})
+			return err
+		}, "only computed from 1971"},
+		{"bad timezone", func() error {
+			_, _, err := CountBusinessDays(context.Background(), nil, types.CountBusinessDaysInput{Timezone: "Europe/Pari"})
+			return err
+		}, "Europe/Paris"},
+		{"negative hours", func() error {
+			_, _, err := AddBusinessHours(context.Background(), nil, types.AddBusinessHoursInput{Hours: -1, Timezone: "UTC"})
+			return err
+		}, "hours must be between"},
+		{"bad target timezone", func() error {
+			_, _, err := AddBusinessHours(context.Background(), nil, types.AddBusinessHoursInput{Hours: 1, Timezone: "UTC", TargetTimezone: "Europe/Dublln"})
+			return err
+		}, "Europe/Dublin"},
+		{"bad deadline hours", func() error {
+			_, _, err := AddBusinessHours(context.Background(), nil, types.AddBusinessHoursInput{Hours: 1, Timezone: "UTC", WorkStart: "9am"})
+			return err
+		}, "invalid work_start"},
+		{"hours reversed", func() error {
+			_, _, err := CheckBusinessHours(context.Background(), nil, types.CheckBusinessHoursInput{Timezone: "UTC", WorkStart: "18:00", WorkEnd: "09:00"})
+			return err
+		}, "work_end must be after work_start"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestAddBusinessHours(t *testing.T) {
+	_, out, err := AddBusinessHours(context.Background(), nil, types.AddBusinessHoursInput{
+		Start:          "2026-04-02T16:45:00",
+		Hours:          8,
+		Timezone:       "Asia/Singapore",
+		TargetTimezone: "Europe/Dublin",
+		WorkEnd:        "18:00",
+		BusinessCalendarInput: types.BusinessCalendarInput{
+			Country:  "SG",
+			Holidays: []string{"2026-04-03 Good Friday"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 1h15 on Thursday, then 6h45 on Monday after Good Friday and the weekend
+	if out.Deadline.Source.Datetime != "2026-04-06T15:45:00+08:00" || out.Deadline.Target.Datetime != "2026-04-06T08:45:00+01:00" || out.Deadline.TimeDifference != "-7.0h" {
+		t.Errorf("unexpected deadline: %+v", out.Deadline)
+	}
+	if out.ClockStart.Datetime != "2026-04-02T16:45:00+08:00" || out.BusinessHours != 8 || out.Elapsed != "3 days and 23 hours" {
+		t.Errorf("unexpected result: %+v", out)
+	}
+	if len(out.Holidays) != 1 || out.Holidays[0].Name != "Good Friday" {
+		t.Errorf("unexpected holidays: %+v", out.Holidays)
+	}
+	if len(out.Notes) != 1 || !strings.Contains(out.Notes[0], "Singapore") {
+		t.Errorf("unexpected notes: %v", out.Notes)
+	}
+
+	// No hours out of hours: the deadline is the next opening, not the start
+	_, out, err = AddBusinessHours(context.Background(), nil, types.AddBusinessHoursInput{
+		Start:    "2026-03-28T10:00:00",
+		Hours:    0,
+		Timezone: "Asia/Singapore",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ClockStart.Datetime != "2026-03-30T09:00:00+08:00" || out.Deadline.Source.Datetime != out.ClockStart.Datetime {
+		t.Errorf("unexpected result for no hours: %+v", out)
+	}
+
+	// Out of hours, the clock starts at the next opening
+	_, out, err = AddBusinessHours(context.Background(), nil, types.AddBusinessHoursInput{
+		Start:    "2026-03-27T20:00:00-04:00",
+		Hours:    0.5,
+		Timezone: "America/New_York",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ClockStart.Datetime != "2026-03-30T09:00:00-04:00" || out.Deadline.Source.Datetime != "2026-03-30T09:30:00-04:00" || out.Deadline.Target.Timezone != "America/New_York" {
+		t.Errorf("unexpected result: %+v", out)
+	}
+
+	// A holiday on the start day is skipped and listed too
+	_, out, err = AddBusinessHours(context.Background(), nil, types.AddBusinessHoursInput{
+		Start:    "2026-12-25T10:00:00",
+		Hours:    1,
+		Timezone: "Europe/London",
+		BusinessCalendarInput: types.BusinessCalendarInput{
+			Country: "GB",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Deadline.Source.Datetime != "2026-12-29T10:00:00+00:00" || len(out.Holidays) != 2 || out.Holidays[0].Date != "2026-12-25" || out.Holidays[1].Date != "2026-12-28" {
+		t.Errorf("unexpected result: %+v", out)
+	}
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/cron"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+const (
+	defaultCronRuns = 5
+	maxCronRuns     = 100
+)
+
+// DST event types reported by cron_next_runs.
+const (
+	cronSkipped  = "skipped"
+	cronRepeated = "repeated"
+)
+
+// CronNextRuns implements the cron_next_runs MCP tool handler.
+// It returns the next fire times of a cron expression in a timezone, reporting runs
+// skipped or repeated by DST transitions.
+func CronNextRuns(ctx context.Context, req *mcp.CallToolRequest, input types.CronNextRunsInput) (
+	*mcp.CallToolResult,
+	types.CronNextRunsResult,
+	error,
+) {
+	if input.Expression == "" {
+		return nil, types.CronNextRunsResult{}, fmt.Errorf("expression is required")
+	}
+	schedule, err := cron.Parse(input.Expression, input.Dialect)
+	if err != nil {
+		return nil, types.CronNextRunsResult{}, err
+	}
+	tz, from, err := resolveInstant(input.Start, input.Timezone)
+	if err != nil {
+		return nil, types.CronNextRunsResult{}, fmt.Errorf("invalid start: %w", err)
+	}
+	count := input.Count
+	if count <= 0 {
+		count = defaultCronRuns
+	}
+	count = min(count, maxCronRuns)
+
+	runs, skipped := schedule.Next(from, count)
+	if len(runs) == 0 {
+		return nil, types.CronNextRunsResult{}, fmt.Errorf("expression %q never fires after %s", input.Expression, timezone.FormatISOSeconds(from))
+	}
+
+	result := types.CronNextRunsResult{
+		Expression: input.Expression,
+		Dialect:    schedule.Dialect,
+		Timezone:   tz,
+		Runs:       []types.CronRun{},
+		DSTEvents:  []types.CronDSTEvent{},
+	}
+	for _, wall := range skipped {
+		result.DSTEvents = append(result.DSTEvents, types.CronDSTEvent{
+			Type:     cronSkipped,
+			WallTime: wall.Format("2006-01-02T15:04:05"),
+			Detail:   fmt.Sprintf("%s does not exist in %s because clocks are set forward, so this run does not fire", wall.Format("2006-01-02 15:04:05"), tz),
+		})
+	}
+	seen := make(map[string]bool)
+	for _, run := range runs {
+		result.Runs = append(result.Runs, types.CronRun{TimeResult: timeutil.BuildTimeResult(run.Time, tz), Repeated: run.Repeated})
+		wall := run.Time.Format("2006-01-02T15:04:05")
+		if run.Repeated && !seen[wall] {
+			seen[wall] = true
+			result.DSTEvents = append(result.DSTEvents, types.CronDSTEvent{
+				Type:     cronRepeated,
+				WallTime: wall,
+				Detail:   fmt.Sprintf("%s occurs twice in %s because clocks are set back, so the job fires at %s", run.Time.Format("2006-01-02 15:04:05"), tz, repeatedInstants(run.Time)),
+			})
+		}
+	}
+	return nil, result, nil
+}
+
+// repeatedInstants lists both instants sharing t's wall-clock time, e.g. "01:30:00-04:00 and 01:30:00-05:00".
+func repeatedInstants(t time.Time) string {
+	local, err := timezone.ResolveLocalTime(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, t.Location(), timezone.DisambiguateCompatible)
+	if err != nil || len(local.Candidates) < 2 {
+		return t.Format("15:04:05-07:00")
+	}
+	return local.Candidates[0].Format("15:04:05-07:00") + " and " + local.Candidates[1].Format("15:04:05-07:00")
+}
+
+func registerCronNextRuns(server *mcp.Server, localTZ string) {
+	cronNextRunsSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"expression": map[string]any{
+				"type":        "string",
+				"description": "Cron expression: standard 5 fields ('*/15 9-17 * * MON-FRI'), 6 fields with leading seconds, Quartz 6 or 7 fields with '?', 'L', 'W' and '#' ('0 0 12 ? * 6L'), or a macro such as '@daily'.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone the schedule runs in. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"count": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Number of fire times to return (default %d, max %d).", defaultCronRuns, maxCronRuns),
+			},
+			"start": map[string]any{
+				"type":        "string",
+				"description": "ISO 8601 datetime to list fire times after (e.g., '2026-03-07T12:00:00'). Defaults to now.",
+			},
+			"dialect": map[string]any{
+				"type":        "string",
+				"enum":        []string{cron.DialectAuto, cron.DialectStandard, cron.DialectQuartz},
+				"description": "Cron dialect. 'auto' (default) detects Quartz from 7 fields or Quartz-only characters. Day of week is 0-7 (0 and 7 Sunday) in standard cron and 1-7 (1 Sunday) in Quartz.",
+			},
+		},
+		"required": []string{"expression", "timezone"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "cron_next_runs",
+		Description: "List the next fire times of a cron expression in a timezone, reporting runs skipped or repeated by DST transitions",
+		InputSchema: cronNextRunsSchema,
+	}, CronNextRuns)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestCronNextRuns(t *testing.T) {
+	_, out, err := CronNextRuns(context.Background(), nil, types.CronNextRunsInput{
+		Expression: "0 9 * * MON-FRI",
+		Timezone:   "Europe/Paris",
+		Start:      "2026-01-16T10:00:00",
+		Count:      3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"2026-01-19T09:00:00+01:00", "2026-01-20T09:00:00+01:00", "2026-01-21T09:00:00+01:00"}
+	if len(out.Runs) != len(want) {
+		t.Fatalf("expected %d runs, got %d", len(want), len(out.Runs))
+	}
+	for i, w := range want {
+		if out.Runs[i].Datetime != w || out.Runs[i].Timezone != "Europe/Paris" {
+			t.Errorf("run %d = %+v, want %s", i, out.Runs[i], w)
+		}
+	}
+	if out.Dialect != "standard" || len(out.DSTEvents) != 0 {
+		t.Errorf("unexpected dialect or DST events: %+v", out)
+	}
+}
+
+func TestCronNextRunsDSTEvents(t *testing.T) {
+	_, out, err := CronNextRuns(context.Background(), nil, types.CronNextRunsInput{
+		Expression: "30 2 * * *",
+		Timezone:   "Europe/Paris",
+		Start:      "2026-03-28T12:00:00",
+		Count:      2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.DSTEvents) != 1 || out.DSTEvents[0].Type != "skipped" || out.DSTEvents[0].WallTime != "2026-03-29T02:30:00" {
+		t.Errorf("expected the 2026-03-29 run to be skipped, got %+v", out.DSTEvents)
+	}
+	if out.Runs[0].Datetime != "2026-03-30T02:30:00+02:00" {
+		t.Errorf("unexpected first run: %s", out.Runs[0].Datetime)
+	}
+
+	_, out, err = CronNextRuns(context.Background(), nil, types.CronNextRunsInput{
+		Expression: "0 30 2 * * *",
+		Timezone:   "Europe/Paris",
+		Start:      "2026-10-24T12:00:00",
+		Count:      3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.Runs[0].Repeated || !out.Runs[1].Repeated || out.Runs[2].Repeated {
+		t.Errorf("expected the first two runs to repeat: %+v", out.Runs)
+	}
+	if len(out.DSTEvents) != 1 || out.DSTEvents[0].Type != "repeated" || !strings.Contains(out.DSTEvents[0].Detail, "02:30:00+02:00 and 02:30:00+01:00") {
+		t.Errorf("unexpected DST events: %+v", out.DSTEvents)
+	}
+}
+
+func TestCronNextRunsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  types.CronNextRunsInput
+		errMsg string
+	}{
+		{"missing expression", types.CronNextRunsInput{Timezone: "UTC"}, "expression is required"},
+		{"bad field", types.CronNextRunsInput{Expression: "0 25 * * *", Timezone: "UTC"}, "invalid hour field"},
+		{"invalid timezone", types.CronNextRunsInput{Expression: "@daily", Timezone: "Europe/Pariss"}, "did you mean"},
+		{"never fires", types.CronNextRunsInput{Expression: "0 0 31 2 *", Timezone: "UTC"}, "never fires"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := CronNextRuns(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error = %v, want to contain %q", err, tt.errMsg)
+			}
+		})
+	}
+}
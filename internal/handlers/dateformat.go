@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/serialdate"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// ConvertDateFormat implements the convert_date_format MCP tool handler.
+// It reads a Julian Day, MJD, Rata Die, Excel serial or Cocoa date, or a datetime, and
+// returns the instant in every numeric date format.
+func ConvertDateFormat(ctx context.Context, req *mcp.CallToolRequest, input types.ConvertDateFormatInput) (
+	*mcp.CallToolResult,
+	types.DateFormatsResult,
+	error,
+) {
+	if input.Value != "" && input.Datetime != "" {
+		return nil, types.DateFormatsResult{}, fmt.Errorf("value and datetime are exclusive")
+	}
+	tz, t, err := resolveInstant(input.Datetime, input.Timezone)
+	if err != nil {
+		return nil, types.DateFormatsResult{}, fmt.Errorf("invalid datetime or timezone: %w", err)
+	}
+
+	var local timezone.LocalTime
+	if input.Value != "" {
+		if input.Format == "" {
+			return nil, types.DateFormatsResult{}, fmt.Errorf("format is required with value")
+		}
+		f, err := serialdate.ParseFormat(input.Format)
+		if err != nil {
+			return nil, types.DateFormatsResult{}, err
+		}
+		if local, err = serialdate.Parse(input.Value, f, t.Location()); err != nil {
+			return nil, types.DateFormatsResult{}, err
+		}
+		t = local.Time
+	}
+
+	result := types.DateFormatsResult{
+		Time:               timeutil.BuildTimeResult(t, tz),
+		Nonexistent:        local.Nonexistent,
+		Ambiguous:          local.Ambiguous,
+		JulianDay:          serialdate.ToJulianDay(t),
+		JulianDayNumber:    serialdate.ToJulianDayNumber(t),
+		ModifiedJulianDate: serialdate.ToModifiedJulianDate(t),
+		RataDie:            serialdate.ToRataDie(t),
+		Cocoa:              serialdate.ToCocoa(t),
+	}
+	if serial, ok := serialdate.ToExcel(t, serialdate.Excel1900); ok {
+		result.Excel = &serial
+	}
+	if serial, ok := serialdate.ToExcel(t, serialdate.Excel1904); ok {
+		result.Excel1904 = &serial
+	}
+	return nil, result, nil
+}
+
+func registerConvertDateFormat(server *mcp.Server, localTZ string) {
+	convertDateFormatSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"value": map[string]any{
+				"type":        "string",
+				"description": "Number to convert, in format (e.g., '2460471.1875' as jd, '45453.0625' as excel). Omit to convert datetime instead.",
+			},
+			"format": map[string]any{
+				"type":        "string",
+				"enum":        serialdate.Formats,
+				"description": "Format of value: 'jd' (Julian Day), 'jdn' (Julian Day Number), 'mjd' (Modified Julian Date), 'rata_die', 'excel' (Excel/Lotus 1900 date system, which counts the nonexistent 1900-02-29 as serial 60), 'excel_1904' (Excel 1904 date system) or 'cocoa' (seconds since 2001-01-01 UTC).",
+			},
+			"datetime": map[string]any{
+				"type":        "string",
+				"description": "ISO 8601 datetime to convert into every format (e.g., '2026-03-29T14:30:00'). Defaults to now when value is also omitted.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone whose calendar date and wall-clock time the jdn, rata_die and Excel values count; jd, mjd and cocoa count from UTC. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+		},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "convert_date_format",
+		Description: "Convert between datetimes and numeric date formats: Julian Day, Julian Day Number, Modified Julian Date, Rata Die, Excel/Lotus serial dates and Cocoa (Mac) reference dates",
+		InputSchema: convertDateFormatSchema,
+	}, ConvertDateFormat)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestConvertDateFormat(t *testing.T) {
+	_, out, err := ConvertDateFormat(context.Background(), nil, types.ConvertDateFormatInput{
+		Value:    "45453.0625",
+		Format:   "lotus",
+		Timezone: "Asia/Tokyo",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Time.Datetime != "2024-06-10T01:30:00+09:00" || out.JulianDay != 2460471.1875 || out.JulianDayNumber != 2460472 || out.RataDie != 739047 {
+		t.Errorf("unexpected result: %+v", out)
+	}
+	if out.Excel == nil || *out.Excel != 45453.0625 || out.Excel1904 == nil || *out.Excel1904 != 43991.0625 {
+		t.Errorf("unexpected Excel serials: %v %v", out.Excel, out.Excel1904)
+	}
+
+	_, out, err = ConvertDateFormat(context.Background(), nil, types.ConvertDateFormatInput{Datetime: "1858-11-17T00:00:00Z"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ModifiedJulianDate != 0 || out.Excel != nil || out.Time.Timezone != "UTC" {
+		t.Errorf("unexpected result for the MJD epoch: %+v", out)
+	}
+}
+
+func TestConvertDateFormatErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.ConvertDateFormatInput
+		want  string
+	}{
+		{"both inputs", types.ConvertDateFormatInput{Value: "1", Format: "jd", Datetime: "2026-01-01T00:00:00"}, "exclusive"},
+		{"missing format", types.ConvertDateFormatInput{Value: "1"}, "format is required"},
+		{"unknown format", types.ConvertDateFormatInput{Value: "1", Format: "stardate"}, "unknown date format"},
+		{"Lotus leap day", types.ConvertDateFormatInput{Value: "60", Format: "excel"}, "1900-02-29"},
+		{"bad timezone", types.ConvertDateFormatInput{Timezone: "Europe/Brussel"}, "Europe/Brussels"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ConvertDateFormat(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// TimeDifference implements the time_difference MCP tool handler.
+// It returns the elapsed time between two datetimes, each defaulting to now.
+func TimeDifference(ctx context.Context, req *mcp.CallToolRequest, input types.TimeDifferenceInput) (
+	*mcp.CallToolResult,
+	types.TimeDifferenceResult,
+	error,
+) {
+	startTZ, start, err := resolveInstant(input.Start, input.StartTimezone)
+	if err != nil {
+		return nil, types.TimeDifferenceResult{}, fmt.Errorf("invalid start: %w", err)
+	}
+	endTZ, end, err := resolveInstant(input.End, input.EndTimezone)
+	if err != nil {
+		return nil, types.TimeDifferenceResult{}, fmt.Errorf("invalid end: %w", err)
+	}
+
+	diff := duration.Between(start, end)
+	clock := diff.Clock.Truncate(time.Second)
+	human := diff.Humanize()
+	switch {
+	case start.Equal(end):
+		human = "no difference"
+	case diff.Negative:
+		human += " ago"
+	default:
+		human = "in " + human
+	}
+
+	return nil, types.TimeDifferenceResult{
+		Start:        timeutil.BuildTimeResult(start, startTZ),
+		End:          timeutil.BuildTimeResult(end, endTZ),
+		IsNegative:   diff.Negative,
+		Years:        diff.Years,
+		Months:       diff.Months,
+		Days:         diff.Days,
+		Hours:        int(clock / time.Hour),
+		Minutes:      int(clock % time.Hour / time.Minute),
+		Seconds:      int(clock % time.Minute / time.Second),
+		TotalSeconds: totalSeconds(start, end),
+		ISODuration:  diff.String(),
+		Human:        human,
+	}, nil
+}
+
+// totalSeconds returns the whole seconds from start to end, truncated toward zero.
+// Unlike end.Sub(start), it does not saturate for spans longer than about 292 years.
+func totalSeconds(start, end time.Time) int64 {
+	seconds := end.Unix() - start.Unix()
+	switch nanos := end.Nanosecond() - start.Nanosecond(); {
+	case seconds > 0 && nanos < 0:
+		seconds--
+	case seconds < 0 && nanos > 0:
+		seconds++
+	}
+	return seconds
+}
+
+// resolveInstant parses an ISO 8601 datetime in tz (default UTC), or returns now when tstr is empty.
+func resolveInstant(tstr, tz string) (string, time.Time, error) {
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w%s", err, didYouMean(tz))
+	}
+	if tstr == "" {
+		return tz, now, nil
+	}
+	local, err := timezone.ParseInLocation(tstr, now.Location(), "")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return tz, local.Time, nil
+}
+
+func registerTimeDifference(server *mcp.Server, localTZ string) {
+	timeDifferenceSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"start": map[string]any{
+				"type":        "string",
+				"description": "Start ISO 8601 datetime (e.g., '2026-03-29T14:30:00' or '2026-03-29T14:30:00+01:00'). Defaults to now.",
+			},
+			"start_timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone the start is expressed in, used when start has no offset. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"end": map[string]any{
+				"type":        "string",
+				"description": "End ISO 8601 datetime. Defaults to now.",
+			},
+			"end_timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone the end is expressed in, used when end has no offset. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+		},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "time_difference",
+		Description: "Calculate the elapsed time between two datetimes (e.g., how long until or since a moment)",
+		InputSchema: timeDifferenceSchema,
+	}, TimeDifference)
+}
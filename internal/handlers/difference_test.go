@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestTimeDifference(t *testing.T) {
+	input := types.TimeDifferenceInput{
+		Start:         "2026-01-15T09:00:00",
+		StartTimezone: "Europe/Paris",
+		End:           "2027-03-18T07:30:15",
+		EndTimezone:   "Europe/London",
+	}
+	_, out, err := TimeDifference(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 07:30:15 London is 08:30:15 Paris
+	if out.Years != 1 || out.Months != 2 || out.Days != 2 || out.Hours != 23 || out.Minutes != 30 || out.Seconds != 15 {
+		t.Errorf("unexpected breakdown: %+v", out)
+	}
+	if out.ISODuration != "P1Y2M2DT23H30M15S" {
+		t.Errorf("unexpected ISO duration: %s", out.ISODuration)
+	}
+	if out.Human != "in 1 year, 2 months, 2 days, 23 hours, 30 minutes and 15 seconds" {
+		t.Errorf("unexpected human phrase: %s", out.Human)
+	}
+	if out.IsNegative {
+		t.Error("expected positive difference")
+	}
+	if out.Start.Timezone != "Europe/Paris" || out.End.Timezone != "Europe/London" {
+		t.Errorf("unexpected timezones: %s, %s", out.Start.Timezone, out.End.Timezone)
+	}
+}
+
+func TestTimeDifferenceNegative(t *testing.T) {
+	input := types.TimeDifferenceInput{Start: "2026-01-02T00:00:00Z", End: "2026-01-01T22:30:00Z"}
+	_, out, err := TimeDifference(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.IsNegative {
+		t.Error("expected negative difference")
+	}
+	if out.TotalSeconds != -5400 {
+		t.Errorf("expected -5400 total seconds, got %d", out.TotalSeconds)
+	}
+	if out.ISODuration != "-PT1H30M" {
+		t.Errorf("unexpected ISO duration: %s", out.ISODuration)
+	}
+	if out.Human != "1 hour and 30 minutes ago" {
+		t.Errorf("unexpected human phrase: %s", out.Human)
+	}
+}
+
+func TestTimeDifferenceTotalSeconds(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		want       int64
+	}{
+		// Beyond the 292 years a time.Duration holds
+		{"long span", "1600-01-01T00:00:00Z", "2026-01-01T00:00:00Z", 13443321600},
+		{"long span back", "2026-01-01T00:00:00Z", "1600-01-01T00:00:00Z", -13443321600},
+		{"fraction truncated", "2026-01-01T00:00:00.5Z", "2026-01-01T00:00:02Z", 1},
+		{"negative fraction truncated", "2026-01-01T00:00:02Z", "2026-01-01T00:00:00.5Z", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := TimeDifference(context.Background(), nil, types.TimeDifferenceInput{Start: tt.start, End: tt.end})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.TotalSeconds != tt.want {
+				t.Errorf("total seconds = %d, want %d", out.TotalSeconds, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeDifferenceDefaultsToNow(t *testing.T) {
+	_, out, err := TimeDifference(context.Background(), nil, types.TimeDifferenceInput{End: "2000-01-01T00:00:00Z"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.IsNegative || !strings.HasSuffix(out.Human, " ago") {
+		t.Errorf("expected a past difference, got %+v", out)
+	}
+	if out.Start.Timezone != "UTC" {
+		t.Errorf("expected default timezone UTC, got %s", out.Start.Timezone)
+	}
+}
+
+func TestTimeDifferenceInvalidInput(t *testing.T) {
+	cases := []types.TimeDifferenceInput{
+		{Start: "not a date"},
+		{End: "not a date"},
+		{StartTimezone: "Invalid/Zone"},
+		{EndTimezone: "Invalid/Zone"},
+	}
+	for i, tc := range cases {
+		if _, _, err := TimeDifference(context.Background(), nil, tc); err == nil {
+			t.Errorf("case %d: expected error, got nil", i)
+		}
+	}
+}
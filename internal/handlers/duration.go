@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// AddDuration implements the add_duration MCP tool handler.
+// It adds (or with a leading '-', subtracts) a duration to now or to a given start time.
+func AddDuration(ctx context.Context, req *mcp.CallToolRequest, input types.AddDurationInput) (
+	*mcp.CallToolResult,
+	types.TimeResult,
+	error,
+) {
+	if err := timeutil.ValidateAddDurationInput(input); err != nil {
+		return nil, types.TimeResult{}, err
+	}
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+
+	start, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return nil, types.TimeResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	if input.Start != "" {
+		local, err := timezone.ParseInLocation(input.Start, start.Location(), "")
+		if err != nil {
+			return nil, types.TimeResult{}, fmt.Errorf("invalid start: %w", err)
+		}
+		start = local.Time
+	}
+
+	// Business days depend on a holiday calendar, which add_business_days handles
+	if strings.Contains(strings.ToLower(input.Duration), "business") {
+		return nil, types.TimeResult{}, fmt.Errorf("business days are not supported by add_duration: use the add_business_days tool")
+	}
+	d, err := duration.Parse(input.Duration)
+	if err != nil {
+		return nil, types.TimeResult{}, err
+	}
+	result, err := d.AddTo(start, input.Mode)
+	if err != nil {
+		return nil, types.TimeResult{}, err
+	}
+	return nil, timeutil.BuildTimeResult(result, tz), nil
+}
+
+func registerAddDuration(server *mcp.Server, localTZ string) {
+	addDurationSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone name the calculation happens in (e.g., 'Australia/Sydney'). Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"duration": map[string]any{
+				"type":        "string",
+				"description": "Duration to add, as ISO 8601 (e.g., 'PT90M', 'P1M2DT3H', 'P2W') or Go format (e.g., '1h30m'). Prefix with '-' to subtract. Business days are not supported here: use add_business_days.",
+			},
+			"start": map[string]any{
+				"type":        "string",
+				"description": "Optional ISO 8601 start datetime (e.g., '2026-01-31T09:00:00'). Times without an offset are read in the given timezone. Defaults to now.",
+			},
+			"mode": map[string]any{
+				"type":        "string",
+				"enum":        []string{duration.ModeCalendar, duration.ModeAbsolute},
+				"description": "'calendar' (default) adds years, months, weeks and days on the wall-clock calendar, clamping to month end (Jan 31 + 1 month = Feb 28), then hours/minutes/seconds as elapsed time. 'absolute' adds exact elapsed time, counting a day as 24 hours even across DST changes.",
+			},
+		},
+		"required": []string{"timezone", "duration"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "add_duration",
+		Description: "Add or subtract a duration to the current time or a given time in a specific timezone",
+		InputSchema: addDurationSchema,
+	}, AddDuration)
+}
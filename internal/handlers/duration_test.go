@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestAddDurationFromStart(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.AddDurationInput
+		want  string
+	}{
+		{
+			name:  "calendar month clamps to month end",
+			input: types.AddDurationInput{Timezone: "Australia/Sydney", Duration: "P1M", Start: "2026-01-31T09:00:00"},
+			want:  "2026-02-28T09:00:00+11:00",
+		},
+		{
+			name:  "calendar day keeps wall clock across DST",
+			input: types.AddDurationInput{Timezone: "Australia/Sydney", Duration: "P1D", Start: "2026-04-04T12:00:00"},
+			want:  "2026-04-05T12:00:00+10:00",
+		},
+		{
+			name:  "absolute day is 24 hours across DST",
+			input: types.AddDurationInput{Timezone: "Australia/Sydney", Duration: "P1D", Start: "2026-04-04T12:00:00", Mode: "absolute"},
+			want:  "2026-04-05T11:00:00+10:00",
+		},
+		{
+			name:  "Go duration subtraction",
+			input: types.AddDurationInput{Timezone: "UTC", Duration: "-90m", Start: "2026-01-01T00:30:00Z"},
+			want:  "2025-12-31T23:00:00+00:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := AddDuration(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Datetime != tt.want {
+				t.Errorf("datetime = %s, want %s", out.Datetime, tt.want)
+			}
+			if out.Timezone != tt.input.Timezone {
+				t.Errorf("timezone = %s, want %s", out.Timezone, tt.input.Timezone)
+			}
+		})
+	}
+}
+
+func TestAddDurationFromNow(t *testing.T) {
+	before := time.Now()
+	_, out, err := AddDuration(context.Background(), nil, types.AddDurationInput{Duration: "PT90M"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Timezone != "UTC" {
+		t.Errorf("expected default timezone UTC, got %s", out.Timezone)
+	}
+	got, err := time.Parse(time.RFC3339, out.Datetime)
+	if err != nil {
+		t.Fatalf("datetime not RFC3339: %v", err)
+	}
+	if diff := got.Sub(before); diff < 89*time.Minute || diff > 91*time.Minute {
+		t.Errorf("expected result about 90 minutes from now, got %v", diff)
+	}
+}
+
+func TestAddDurationInvalidInput(t *testing.T) {
+	cases := []types.AddDurationInput{
+		{Timezone: "UTC", Duration: ""},
+		{Timezone: "Invalid/Zone", Duration: "PT1H"},
+		{Timezone: "UTC", Duration: "ninety minutes"},
+		{Timezone: "UTC", Duration: "PT1H", Start: "tomorrow"},
+		{Timezone: "UTC", Duration: "P1M", Mode: "absolute"},
+		{Timezone: "UTC", Duration: "3 business days"},
+	}
+	for i, tc := range cases {
+		if _, _, err := AddDuration(context.Background(), nil, tc); err == nil {
+			t.Errorf("case %d: expected error, got nil", i)
+		}
+	}
+}
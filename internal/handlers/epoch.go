@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/epoch"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// EpochConvert implements the epoch_convert MCP tool handler.
+// It reads a Unix timestamp, detecting its unit from its magnitude unless one is given,
+// or a datetime, and returns the instant in a timezone and in every epoch unit.
+func EpochConvert(ctx context.Context, req *mcp.CallToolRequest, input types.EpochConvertInput) (
+	*mcp.CallToolResult,
+	types.EpochConvertResult,
+	error,
+) {
+	if input.Timestamp != "" && input.Datetime != "" {
+		return nil, types.EpochConvertResult{}, fmt.Errorf("timestamp and datetime are exclusive")
+	}
+	tz, t, err := resolveInstant(input.Datetime, input.Timezone)
+	if err != nil {
+		return nil, types.EpochConvertResult{}, fmt.Errorf("invalid datetime or timezone: %w", err)
+	}
+
+	var result types.EpochConvertResult
+	if input.Timestamp != "" {
+		var unit epoch.Unit
+		if input.Unit != "" && input.Unit != "auto" {
+			if unit, err = epoch.ParseUnit(input.Unit); err != nil {
+				return nil, types.EpochConvertResult{}, err
+			}
+		}
+		utc, used, err := epoch.Parse(input.Timestamp, unit)
+		if err != nil {
+			return nil, types.EpochConvertResult{}, err
+		}
+		t = utc.In(t.Location())
+		result.Unit, result.AutoDetected = string(used), unit == ""
+	}
+
+	result.Time = timeutil.BuildTimeResult(t, tz)
+	result.Seconds = epoch.Format(t, epoch.Seconds)
+	result.Milliseconds = epoch.Format(t, epoch.Milliseconds)
+	result.Microseconds = epoch.Format(t, epoch.Microseconds)
+	result.Nanoseconds = epoch.Format(t, epoch.Nanoseconds)
+	return nil, result, nil
+}
+
+func registerEpochConvert(server *mcp.Server, localTZ string) {
+	epochConvertSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"timestamp": map[string]any{
+				"type":        "string",
+				"description": "Unix timestamp to convert, such as '1718035200', '1718035200123' or '1718035200.5'. Omit to convert datetime instead.",
+			},
+			"unit": map[string]any{
+				"type":        "string",
+				"enum":        []string{"auto", string(epoch.Seconds), string(epoch.Milliseconds), string(epoch.Microseconds), string(epoch.Nanoseconds)},
+				"description": "Unit of timestamp. 'auto' (default) reads up to 11 digits as seconds, up to 14 as milliseconds, up to 17 as microseconds and longer values as nanoseconds.",
+			},
+			"datetime": map[string]any{
+				"type":        "string",
+				"description": "ISO 8601 datetime to convert into every epoch unit (e.g., '2026-03-29T14:30:00'), read in timezone when it has no offset. Defaults to now when timestamp is also omitted.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone the time is shown in and naive datetimes are read in. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+		},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "epoch_convert",
+		Description: "Convert a Unix timestamp in seconds, milliseconds, microseconds or nanoseconds (unit auto-detected from its magnitude) into a time in any timezone, or a datetime into every epoch unit",
+		InputSchema: epochConvertSchema,
+	}, EpochConvert)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestEpochConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    types.EpochConvertInput
+		datetime string
+		unit     string
+		auto     bool
+		millis   string
+	}{
+		{
+			name:     "seconds detected",
+			input:    types.EpochConvertInput{Timestamp: "1718035200", Timezone: "Asia/Tokyo"},
+			datetime: "2024-06-11T01:00:00+09:00",
+			unit:     "s",
+			auto:     true,
+			millis:   "1718035200000",
+		},
+		{
+			name:     "nanoseconds detected",
+			input:    types.EpochConvertInput{Timestamp: "1718035200123456789", Timezone: "UTC"},
+			datetime: "2024-06-10T16:00:00+00:00",
+			unit:     "ns",
+			auto:     true,
+			millis:   "1718035200123",
+		},
+		{
+			name:     "unit override",
+			input:    types.EpochConvertInput{Timestamp: "1718035200", Unit: "ms"},
+			datetime: "1970-01-20T21:13:55+00:00",
+			unit:     "ms",
+			millis:   "1718035200",
+		},
+		{
+			name:     "datetime to epoch",
+			input:    types.EpochConvertInput{Datetime: "2024-06-10T18:00:00.5", Timezone: "Europe/Paris"},
+			datetime: "2024-06-10T18:00:00+02:00",
+			millis:   "1718035200500",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := EpochConvert(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Time.Datetime != tt.datetime || out.Unit != tt.unit || out.AutoDetected != tt.auto || out.Milliseconds != tt.millis {
+				t.Errorf("unexpected result: %+v", out)
+			}
+		})
+	}
+}
+
+func TestEpochConvertErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.EpochConvertInput
+		want  string
+	}{
+		{"both inputs", types.EpochConvertInput{Timestamp: "1", Datetime: "2026-01-01T00:00:00"}, "exclusive"},
+		{"bad unit", types.EpochConvertInput{Timestamp: "1", Unit: "fortnights"}, "unknown unit"},
+		{"bad timestamp", types.EpochConvertInput{Timestamp: "yesterday"}, "invalid timestamp"},
+		{"bad timezone", types.EpochConvertInput{Timestamp: "1", Timezone: "Asia/Tokio"}, "Asia/Tokyo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := EpochConvert(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/cron"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// Schedule formats accepted by explain_schedule.
+const (
+	scheduleFormatAuto    = "auto"
+	scheduleFormatCron    = "cron"
+	scheduleFormatSystemd = "systemd"
+)
+
+// ExplainSchedule implements the explain_schedule MCP tool handler.
+// It describes a cron expression or systemd OnCalendar specification in plain English.
+// An invalid schedule is not a tool error: the result is marked invalid and lists the
+// field at fault.
+func ExplainSchedule(ctx context.Context, req *mcp.CallToolRequest, input types.ExplainScheduleInput) (
+	*mcp.CallToolResult,
+	types.ExplainScheduleResult,
+	error,
+) {
+	if input.Schedule == "" {
+		return nil, types.ExplainScheduleResult{}, fmt.Errorf("schedule is required")
+	}
+	format := input.Format
+	switch format {
+	case "", scheduleFormatAuto:
+		format = scheduleFormatCron
+		if cron.LooksLikeOnCalendar(input.Schedule) {
+			format = scheduleFormatSystemd
+		}
+	case scheduleFormatCron, scheduleFormatSystemd:
+	default:
+		return nil, types.ExplainScheduleResult{}, fmt.Errorf("unknown format %q: expected %q, %q or %q", format, scheduleFormatAuto, scheduleFormatCron, scheduleFormatSystemd)
+	}
+
+	var schedule *cron.Schedule
+	var err error
+	if format == scheduleFormatSystemd {
+		schedule, err = cron.ParseOnCalendar(input.Schedule)
+	} else {
+		schedule, err = cron.Parse(input.Schedule, input.Dialect)
+	}
+
+	result := types.ExplainScheduleResult{
+		Schedule: input.Schedule,
+		Format:   format,
+		Fields:   []types.ScheduleField{},
+		Errors:   []types.ScheduleError{},
+	}
+	if err != nil {
+		result.Errors = append(result.Errors, scheduleError(err))
+		return nil, result, nil
+	}
+
+	result.Valid = true
+	result.Description = schedule.Describe()
+	if format == scheduleFormatCron {
+		result.Dialect = schedule.Dialect
+	}
+	if schedule.Location != nil {
+		result.Timezone = schedule.Location.String()
+	}
+	for _, f := range schedule.Fields {
+		result.Fields = append(result.Fields, types.ScheduleField{
+			Name:        f.Name,
+			Value:       f.Raw,
+			Description: schedule.DescribeField(f.Name, f.Value),
+		})
+	}
+	return nil, result, nil
+}
+
+// scheduleError converts a parse error to a ScheduleError, keeping the field position
+// of a *cron.FieldError.
+func scheduleError(err error) types.ScheduleError {
+	var fe *cron.FieldError
+	if !errors.As(err, &fe) {
+		return types.ScheduleError{Message: err.Error()}
+	}
+	msg := fe.Error()
+	if fe.Field == cron.FieldTimezone {
+		msg += didYouMean(fe.Value)
+	}
+	return types.ScheduleError{Field: fe.Field, Position: fe.Position, Value: fe.Value, Message: msg}
+}
+
+func registerExplainSchedule(server *mcp.Server) {
+	explainScheduleSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"schedule": map[string]any{
+				"type":        "string",
+				"description": "Cron expression (e.g., '*/15 9-17 * * MON-FRI', '0 0 12 ? * 6L', '@daily') or systemd OnCalendar specification (e.g., 'Mon..Fri *-*-* 09:00:00 Europe/Paris', '*-*~03 12:00', 'weekly').",
+			},
+			"format": map[string]any{
+				"type":        "string",
+				"enum":        []string{scheduleFormatAuto, scheduleFormatCron, scheduleFormatSystemd},
+				"description": "Schedule syntax. 'auto' (default) treats schedules using '..', '~' or ':', starting with a weekday or shorthand, or with fewer than 5 fields as systemd OnCalendar.",
+			},
+			"dialect": map[string]any{
+				"type":        "string",
+				"enum":        []string{cron.DialectAuto, cron.DialectStandard, cron.DialectQuartz},
+				"description": "Cron dialect. 'auto' (default) detects Quartz from 7 fields or Quartz-only characters. Ignored for systemd specifications.",
+			},
+		},
+		"required": []string{"schedule"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "explain_schedule",
+		Description: "Describe a cron expression or systemd OnCalendar specification in plain English and validate it, pointing at the invalid field",
+		InputSchema: explainScheduleSchema,
+	}, ExplainSchedule)
+}
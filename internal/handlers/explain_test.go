@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestExplainSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       types.ExplainScheduleInput
+		format      string
+		description string
+		timezone    string
+	}{
+		{
+			name:        "cron",
+			input:       types.ExplainScheduleInput{Schedule: "*/15 9-17 * * MON-FRI"},
+			format:      "cron",
+			description: "Every 15 minutes, between 09:00 and 17:59, on Monday through Friday",
+		},
+		{
+			name:        "systemd with timezone",
+			input:       types.ExplainScheduleInput{Schedule: "Mon..Fri *-*-* 09:00:00 Europe/Paris"},
+			format:      "systemd",
+			description: "At 09:00, on Monday through Friday, in the Europe/Paris timezone",
+			timezone:    "Europe/Paris",
+		},
+		{
+			name:        "systemd shorthand",
+			input:       types.ExplainScheduleInput{Schedule: "daily"},
+			format:      "systemd",
+			description: "At 00:00, every day",
+		},
+		{
+			name:        "explicit format",
+			input:       types.ExplainScheduleInput{Schedule: "*-*-* 06:30", Format: "systemd"},
+			format:      "systemd",
+			description: "At 06:30, every day",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := ExplainSchedule(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !out.Valid || len(out.Errors) != 0 {
+				t.Fatalf("expected a valid schedule, got %+v", out.Errors)
+			}
+			if out.Format != tt.format || out.Description != tt.description || out.Timezone != tt.timezone {
+				t.Errorf("got format %q, description %q, timezone %q", out.Format, out.Description, out.Timezone)
+			}
+			if len(out.Fields) == 0 {
+				t.Error("expected field descriptions")
+			}
+		})
+	}
+}
+
+func TestExplainScheduleInvalid(t *testing.T) {
+	tests := []struct {
+		schedule string
+		field    string
+		position int
+		contains string
+	}{
+		{"0 25 * * *", "hour", 2, "out of range 0-23"},
+		{"0 0 12 ? * MON#6", "day_of_week", 6, "expected day#n"},
+		{"Mon..Fry 09:00", "day_of_week", 1, "is not a weekday"},
+		{"Mon *-*-* 09:00 Europe/Pariss", "timezone", 4, "did you mean"},
+		{"* * *", "", 0, "expected [year-]month-day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			_, out, err := ExplainSchedule(context.Background(), nil, types.ExplainScheduleInput{Schedule: tt.schedule})
+			if err != nil {
+				t.Fatalf("invalid schedules are reported in the result, got error: %v", err)
+			}
+			if out.Valid || len(out.Errors) != 1 {
+				t.Fatalf("expected one error, got %+v", out)
+			}
+			e := out.Errors[0]
+			if e.Field != tt.field || e.Position != tt.position || !strings.Contains(e.Message, tt.contains) {
+				t.Errorf("error = %+v, want field %q at %d containing %q", e, tt.field, tt.position, tt.contains)
+			}
+		})
+	}
+
+	for _, input := range []types.ExplainScheduleInput{{}, {Schedule: "daily", Format: "ical"}} {
+		if _, _, err := ExplainSchedule(context.Background(), nil, input); err == nil {
+			t.Errorf("expected an error for %+v", input)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/locale"
+	"github.com/r0mdau/mcp-time/internal/timefmt"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// formatDescription documents the format parameter shared by the tools that accept one.
+var formatDescription = fmt.Sprintf("Format name (%s) or pattern: strftime ('%%a, %%d %%b %%Y %%H:%%M'), Go layout ('Mon Jan 2 15:04'), Java SimpleDateFormat ('EEE, d MMM yyyy HH:mm') or moment.js ('ddd, Do MMM YYYY HH:mm'). The syntax of a pattern is detected from marks such as '%%', 2006, yyyy or YYYY; set syntax when it has none.", strings.Join(timefmt.NamedFormats(), ", "))
+
+// FormatDatetime implements the format_datetime MCP tool handler.
+// It formats a datetime, or now, with a named format or a pattern.
+func FormatDatetime(ctx context.Context, req *mcp.CallToolRequest, input types.FormatDatetimeInput) (
+	*mcp.CallToolResult,
+	types.FormatDatetimeResult,
+	error,
+) {
+	f, err := timefmt.Compile(input.Format, timefmt.Syntax(strings.ToLower(input.Syntax)))
+	if err != nil {
+		return nil, types.FormatDatetimeResult{}, err
+	}
+	tz, t, err := resolveInstant(input.Datetime, input.Timezone)
+	if err != nil {
+		return nil, types.FormatDatetimeResult{}, fmt.Errorf("invalid datetime or timezone: %w", err)
+	}
+	layout, _ := f.GoLayout()
+	return nil, types.FormatDatetimeResult{
+		Time:      timeutil.BuildTimeResult(t, tz),
+		Formatted: f.Format(t),
+		Syntax:    string(f.Syntax()),
+		GoLayout:  layout,
+	}, nil
+}
+
+// outputOptions are the optional format and locale of get_current_time and
+// convert_time results.
+type outputOptions struct {
+	format *timefmt.Formatter
+	locale *locale.Locale
+}
+
+func newOutputOptions(format, tag string) (outputOptions, error) {
+	var o outputOptions
+	var err error
+	if format != "" {
+		if o.format, err = timefmt.Compile(format, timefmt.Auto); err != nil {
+			return o, err
+		}
+	}
+	if tag != "" {
+		if o.locale, err = locale.Lookup(tag); err != nil {
+			return o, err
+		}
+	}
+	return o, nil
+}
+
+// apply adds the formatted and localized forms of t to r. Patterns use the locale's
+// names when both are given.
+func (o outputOptions) apply(r *types.TimeResult, t time.Time) {
+	var names *timefmt.Names
+	if o.locale != nil {
+		names = &o.locale.Names
+		r.Localized = &types.LocalizedTime{
+			Locale:    o.locale.Tag,
+			DayOfWeek: o.locale.DayName(t),
+			Month:     o.locale.MonthName(t),
+			Long:      o.locale.Format(t, locale.Long),
+			Short:     o.locale.Format(t, locale.Short),
+			ZoneName:  o.locale.ZoneName(t),
+		}
+	}
+	if o.format != nil {
+		r.Formatted = o.format.FormatNames(t, names)
+	}
+}
+
+// localeDescription documents the locale parameter.
+var localeDescription = fmt.Sprintf("Optional BCP 47 locale (%s; regional variants such as 'pt-BR' use their language). Adds localized day and month names, long and short date-times and the zone display name as 'localized', and localizes the names in format patterns.", strings.Join(locale.Tags(), ", "))
+
+func registerFormatDatetime(server *mcp.Server, localTZ string) {
+	formatDatetimeSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"datetime": map[string]any{
+				"type":        "string",
+				"description": "ISO 8601 datetime to format (e.g., '2026-03-29T14:30:00' or '2026-03-29T14:30:00+01:00'). Defaults to now.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone to format the time in. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"format": map[string]any{
+				"type":        "string",
+				"description": formatDescription,
+			},
+			"syntax": map[string]any{
+				"type":        "string",
+				"enum":        append([]timefmt.Syntax{timefmt.Named}, timefmt.Syntaxes...),
+				"description": "Pattern language of format when detection guesses wrong or finds no mark of a syntax: 'auto' (default), 'named', 'strftime', 'go', 'java' or 'moment'.",
+			},
+		},
+		"required": []string{"format"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "format_datetime",
+		Description: "Format a datetime with a named format (RFC 2822, RFC 1123/HTTP-date, Unix time...) or a strftime, Go layout, Java SimpleDateFormat or moment.js pattern, returning the equivalent Go layout when there is one",
+		InputSchema: formatDatetimeSchema,
+	}, FormatDatetime)
+}
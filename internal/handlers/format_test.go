@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestFormatDatetime(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      types.FormatDatetimeInput
+		wantText   string
+		wantSyntax string
+		wantLayout string
+	}{
+		{
+			name:       "HTTP-date is in GMT",
+			input:      types.FormatDatetimeInput{Datetime: "2026-03-29T14:30:00", Timezone: "Europe/Paris", Format: "http"},
+			wantText:   "Sun, 29 Mar 2026 12:30:00 GMT",
+			wantSyntax: "named",
+			wantLayout: "Mon, 02 Jan 2006 15:04:05 GMT",
+		},
+		{
+			name:       "strftime",
+			input:      types.FormatDatetimeInput{Datetime: "2026-03-29T14:30:00", Timezone: "Europe/Paris", Format: "%a %-d %b %Y, %H:%M %Z"},
+			wantText:   "Sun 29 Mar 2026, 14:30 CEST",
+			wantSyntax: "strftime",
+			wantLayout: "Mon 2 Jan 2006, 15:04 MST",
+		},
+		{
+			name:       "moment ordinal has no Go layout",
+			input:      types.FormatDatetimeInput{Datetime: "2026-03-01T09:00:00Z", Format: "MMMM Do, YYYY"},
+			wantText:   "March 1st, 2026",
+			wantSyntax: "moment",
+		},
+		{
+			name:       "explicit Java syntax",
+			input:      types.FormatDatetimeInput{Datetime: "2026-03-01T09:00:00Z", Format: "EEE, d MMM yyyy", Syntax: "Java"},
+			wantText:   "Sun, 1 Mar 2026",
+			wantSyntax: "java",
+			wantLayout: "Mon, 2 Jan 2006",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := FormatDatetime(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Formatted != tt.wantText || out.Syntax != tt.wantSyntax || out.GoLayout != tt.wantLayout {
+				t.Errorf("got %q (%s, layout %q), want %q (%s, layout %q)", out.Formatted, out.Syntax, out.GoLayout, tt.wantText, tt.wantSyntax, tt.wantLayout)
+			}
+		})
+	}
+}
+
+func TestFormatDatetimeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.FormatDatetimeInput
+		want  string
+	}{
+		{"missing format", types.FormatDatetimeInput{}, "format is empty"},
+		{"bad pattern", types.FormatDatetimeInput{Format: "%Y-%Q"}, "unsupported conversion"},
+		{"unknown name", types.FormatDatetimeInput{Format: "rfc9999", Syntax: "named"}, "unknown format name"},
+		{"bad timezone", types.FormatDatetimeInput{Format: "rfc3339", Timezone: "Europe/Brussel"}, "Europe/Brussels"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := FormatDatetime(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestFormatParameter(t *testing.T) {
+	_, now, err := GetCurrentTime(context.Background(), nil, types.GetCurrentTimeInput{Timezone: "UTC", Format: "unix"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if now.Formatted == "" || strings.Trim(now.Formatted, "0123456789") != "" {
+		t.Errorf("expected a Unix timestamp, got %q", now.Formatted)
+	}
+
+	_, out, err := ConvertTime(context.Background(), nil, types.ConvertTimeInput{
+		SourceTimezone: "Europe/London",
+		Time:           "2026-03-22T14:30:00",
+		TargetTimezone: "America/New_York",
+		Format:         "rfc2822",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Source.Formatted != "Sun, 22 Mar 2026 14:30:00 +0000" || out.Target.Formatted != "Sun, 22 Mar 2026 10:30:00 -0400" {
+		t.Errorf("unexpected formatted times: %q, %q", out.Source.Formatted, out.Target.Formatted)
+	}
+
+	if _, _, err := GetCurrentTime(context.Background(), nil, types.GetCurrentTimeInput{Timezone: "UTC", Format: "%Q"}); err == nil {
+		t.Error("expected an error for an invalid format")
+	}
+}
+
+func TestLocaleParameter(t *testing.T) {
+	_, out, err := ConvertTime(context.Background(), nil, types.ConvertTimeInput{
+		SourceTimezone: "Asia/Tokyo",
+		Time:           "2026-02-09T22:05:00",
+		TargetTimezone: "Europe/Paris",
+		Locale:         "fr-FR",
+		Format:         "EEEE d MMMM",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := types.LocalizedTime{
+		Locale:    "fr",
+		DayOfWeek: "lundi",
+		Month:     "février",
+		Long:      "9 février 2026 à 14:05:00 CET",
+		Short:     "09/02/2026 14:05",
+		ZoneName:  "heure normale d’Europe centrale",
+	}
+	if out.Target.Localized == nil || *out.Target.Localized != want {
+		t.Errorf("target localized = %+v, want %+v", out.Target.Localized, want)
+	}
+	if out.Target.Formatted != "lundi 9 février" || out.Target.DayOfWeek != "Monday" {
+		t.Errorf("unexpected target: %+v", out.Target)
+	}
+	if out.Source.Localized == nil || out.Source.Localized.ZoneName != "heure normale du Japon" {
+		t.Errorf("unexpected source localized: %+v", out.Source.Localized)
+	}
+
+	_, now, err := GetCurrentTime(context.Background(), nil, types.GetCurrentTimeInput{Timezone: "Asia/Tokyo", Locale: "ja"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if now.Localized == nil || now.Localized.ZoneName != "日本標準時" || now.Formatted != "" {
+		t.Errorf("unexpected result: %+v", now)
+	}
+
+	if _, _, err := GetCurrentTime(context.Background(), nil, types.GetCurrentTimeInput{Timezone: "UTC", Locale: "xx"}); err == nil || !strings.Contains(err.Error(), "unsupported locale") {
+		t.Errorf("expected an unsupported locale error, got %v", err)
+	}
+}
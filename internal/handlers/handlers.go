@@ -25,13 +25,20 @@ func GetCurrentTime(ctx context.Context, req *mcp.CallToolRequest, input types.G
 	now, err := timezone.GetNowInLocation(tz)
 	if err != nil {
 		// Return error for invalid timezone - SDK will handle it properly
-		return nil, types.TimeResult{}, fmt.Errorf("invalid timezone: %w", err)
+		return nil, types.TimeResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
 	}
-	return nil, timeutil.BuildTimeResult(now, tz), nil
+	output, err := newOutputOptions(input.Format, input.Locale)
+	if err != nil {
+		return nil, types.TimeResult{}, err
+	}
+	result := timeutil.BuildTimeResult(now, tz)
+	output.apply(&result, now)
+	return nil, result, nil
 }
 
 // ConvertTime implements the convert_time MCP tool handler.
-// It converts a time specified in HH:MM format from one timezone to another.
+// It converts a time specified in HH:MM format (on an optional date) or as a full
+// ISO 8601 datetime from one timezone to another.
 func ConvertTime(ctx context.Context, req *mcp.CallToolRequest, input types.ConvertTimeInput) (
 	*mcp.CallToolResult,
 	types.TimeConversionResult,
@@ -41,9 +48,7 @@ func ConvertTime(ctx context.Context, req *mcp.CallToolRequest, input types.Conv
 	if err := timeutil.ValidateConvertTimeInput(input); err != nil {
 		return nil, types.TimeConversionResult{}, err
 	}
-
-	// Parse time input
-	hour, minute, err := timeutil.ParseTimeInput(input.Time)
+	output, err := newOutputOptions(input.Format, input.Locale)
 	if err != nil {
 		return nil, types.TimeConversionResult{}, err
 	}
@@ -51,16 +56,18 @@ func ConvertTime(ctx context.Context, req *mcp.CallToolRequest, input types.Conv
 	// Get source location and build source time
 	sourceNow, err := timezone.GetNowInLocation(input.SourceTimezone)
 	if err != nil {
-		return nil, types.TimeConversionResult{}, fmt.Errorf("invalid source timezone %q: %w", input.SourceTimezone, err)
+		return nil, types.TimeConversionResult{}, fmt.Errorf("invalid source timezone %q: %w%s", input.SourceTimezone, err, didYouMean(input.SourceTimezone))
 	}
-	// GetNowInLocation already validated the timezone, so this LoadLocation will succeed
-	locFrom, _ := time.LoadLocation(input.SourceTimezone)
-	sourceTime := time.Date(sourceNow.Year(), sourceNow.Month(), sourceNow.Day(), hour, minute, 0, 0, locFrom)
+	local, err := buildSourceTime(input, sourceNow)
+	if err != nil {
+		return nil, types.TimeConversionResult{}, err
+	}
+	sourceTime := local.Time
 
 	// Convert to target timezone
 	locTo, err := time.LoadLocation(input.TargetTimezone)
 	if err != nil {
-		return nil, types.TimeConversionResult{}, fmt.Errorf("invalid target timezone %q: %w", input.TargetTimezone, err)
+		return nil, types.TimeConversionResult{}, fmt.Errorf("invalid target timezone %q: %w%s", input.TargetTimezone, err, didYouMean(input.TargetTimezone))
 	}
 	targetTime := sourceTime.In(locTo)
 
@@ -69,11 +76,47 @@ func ConvertTime(ctx context.Context, req *mcp.CallToolRequest, input types.Conv
 	_, offTarget := targetTime.Zone()
 	timeDiffStr := timeutil.FormatTimeDifference(offSource, offTarget)
 
-	return nil, types.TimeConversionResult{
+	result := types.TimeConversionResult{
 		Source:         timeutil.BuildTimeResult(sourceTime, input.SourceTimezone),
 		Target:         timeutil.BuildTimeResult(targetTime, input.TargetTimezone),
 		TimeDifference: timeDiffStr,
-	}, nil
+		Nonexistent:    local.Nonexistent,
+		Ambiguous:      local.Ambiguous,
+	}
+	for _, candidate := range local.Candidates {
+		result.Candidates = append(result.Candidates, timeutil.BuildTimeResult(candidate, input.SourceTimezone))
+	}
+	output.apply(&result.Source, sourceTime)
+	output.apply(&result.Target, targetTime)
+	return nil, result, nil
+}
+
+// buildSourceTime resolves the time and optional date of a convert_time request
+// in the source location of sourceNow. HH:MM times default to sourceNow's date.
+// Wall-clock times are checked for DST gaps and overlaps using input.Disambiguation.
+func buildSourceTime(input types.ConvertTimeInput, sourceNow time.Time) (timezone.LocalTime, error) {
+	locFrom := sourceNow.Location()
+
+	hour, minute, err := timeutil.ParseTimeInput(input.Time)
+	if err != nil {
+		// Not HH:MM, so the time must be a full datetime carrying its own date
+		if input.Date != "" {
+			return timezone.LocalTime{}, fmt.Errorf("date must not be set when time is a full datetime")
+		}
+		if _, _, perr := timezone.ParseDateTime(input.Time); perr != nil {
+			return timezone.LocalTime{}, fmt.Errorf("invalid time format. Expected HH:MM [24-hour format] or ISO 8601 datetime")
+		}
+		return timezone.ParseInLocation(input.Time, locFrom, input.Disambiguation)
+	}
+
+	year, month, day := sourceNow.Date()
+	if input.Date != "" {
+		year, month, day, err = timeutil.ParseDateInput(input.Date)
+		if err != nil {
+			return timezone.LocalTime{}, err
+		}
+	}
+	return timezone.ResolveLocalTime(year, month, day, hour, minute, 0, 0, locFrom, input.Disambiguation)
 }
 
 // RegisterTools attaches the tool handlers to the given server. Extracted for testability.
@@ -87,6 +130,14 @@ func RegisterTools(server *mcp.Server, localTZ string) {
 				"type":        "string",
 				"description": fmt.Sprintf("IANA timezone name (e.g., 'America/New_York', 'Europe/London'). Use '%s' as local timezone if no timezone provided by the user.", localTZ),
 			},
+			"format": map[string]any{
+				"type":        "string",
+				"description": "Optional output format, added to the result as 'formatted'. " + formatDescription,
+			},
+			"locale": map[string]any{
+				"type":        "string",
+				"description": localeDescription,
+			},
 		},
 		"required": []string{"timezone"},
 	}
@@ -107,12 +158,29 @@ func RegisterTools(server *mcp.Server, localTZ string) {
 			},
 			"time": map[string]any{
 				"type":        "string",
-				"description": "Time to convert in 24-hour format (HH:MM)",
+				"description": "Time to convert in 24-hour format (HH:MM), or a full ISO 8601 datetime (e.g., '2026-03-29T14:30:00' or '2026-03-29T14:30:00+01:00')",
+			},
+			"date": map[string]any{
+				"type":        "string",
+				"description": "Optional date (YYYY-MM-DD) the HH:MM time falls on, so the offsets in force on that day are used. Defaults to today in the source timezone.",
+			},
+			"disambiguation": map[string]any{
+				"type":        "string",
+				"enum":        timezone.DisambiguationPolicies,
+				"description": "How to resolve a source time that does not exist (DST gap) or occurs twice (DST overlap): 'compatible' (default: later reading of a gap, earlier occurrence of an overlap), 'earlier', 'later', 'reject' (return an error), or 'shift_forward' (move a nonexistent time to the end of the gap).",
 			},
 			"target_timezone": map[string]any{
 				"type":        "string",
 				"description": fmt.Sprintf("Target IANA timezone name (e.g., 'Asia/Tokyo', 'America/San_Francisco'). Use '%s' as local timezone if no target timezone provided by the user.", localTZ),
 			},
+			"format": map[string]any{
+				"type":        "string",
+				"description": "Optional output format for the source and target times, added to each as 'formatted'. " + formatDescription,
+			},
+			"locale": map[string]any{
+				"type":        "string",
+				"description": localeDescription,
+			},
 		},
 		"required": []string{"source_timezone", "time", "target_timezone"},
 	}
@@ -122,4 +190,25 @@ func RegisterTools(server *mcp.Server, localTZ string) {
 		Description: "Convert time between timezones",
 		InputSchema: convertTimeSchema,
 	}, ConvertTime)
+
+	registerAddDuration(server, localTZ)
+	registerTimeDifference(server, localTZ)
+	registerSearchTimezones(server)
+	registerListTimezones(server)
+	registerTimezoneInfo(server, localTZ)
+	registerFindMeetingTimes(server, localTZ)
+	registerMultiZone(server, localTZ)
+	registerParseDatetime(server, localTZ)
+	registerCronNextRuns(server, localTZ)
+	registerExplainSchedule(server)
+	registerExpandRecurrence(server, localTZ)
+	registerParseICS(server, localTZ)
+	registerCreateICS(server, localTZ)
+	registerEpochConvert(server, localTZ)
+	registerConvertTimeScale(server, localTZ)
+	registerConvertDateFormat(server, localTZ)
+	registerFormatDatetime(server, localTZ)
+	registerBusinessDays(server, localTZ)
+	registerListHolidays(server)
+	registerSunTimes(server, localTZ)
 }
@@ -194,3 +194,133 @@ func TestGetCurrentTimeMultipleTimezones(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertTimeWithDate(t *testing.T) {
+	// 2026-03-29 is the day Europe/London switches to BST, New York is already on EDT
+	input := types.ConvertTimeInput{
+		SourceTimezone: "Europe/London",
+		Time:           "14:30",
+		Date:           "2026-03-29",
+		TargetTimezone: "America/New_York",
+	}
+	_, out, err := ConvertTime(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Source.Datetime != "2026-03-29T14:30:00+01:00" {
+		t.Errorf("unexpected source datetime: %s", out.Source.Datetime)
+	}
+	if out.Target.Datetime != "2026-03-29T09:30:00-04:00" {
+		t.Errorf("unexpected target datetime: %s", out.Target.Datetime)
+	}
+	if out.TimeDifference != "-5.0h" {
+		t.Errorf("expected -5.0h, got %s", out.TimeDifference)
+	}
+}
+
+func TestConvertTimeFullDatetime(t *testing.T) {
+	tests := []struct {
+		name       string
+		time       string
+		wantSource string
+		wantTarget string
+	}{
+		{"naive ISO 8601", "2026-03-22T14:30:00", "2026-03-22T14:30:00+00:00", "2026-03-22T10:30:00-04:00"},
+		{"naive without seconds", "2026-03-22T14:30", "2026-03-22T14:30:00+00:00", "2026-03-22T10:30:00-04:00"},
+		{"RFC 3339 with offset", "2026-03-22T15:30:00+01:00", "2026-03-22T14:30:00+00:00", "2026-03-22T10:30:00-04:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := types.ConvertTimeInput{SourceTimezone: "Europe/London", Time: tt.time, TargetTimezone: "America/New_York"}
+			_, out, err := ConvertTime(context.Background(), nil, input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Source.Datetime != tt.wantSource {
+				t.Errorf("source datetime = %s, want %s", out.Source.Datetime, tt.wantSource)
+			}
+			if out.Target.Datetime != tt.wantTarget {
+				t.Errorf("target datetime = %s, want %s", out.Target.Datetime, tt.wantTarget)
+			}
+		})
+	}
+}
+
+func TestConvertTimeInvalidDate(t *testing.T) {
+	cases := []types.ConvertTimeInput{
+		{SourceTimezone: "UTC", Time: "12:00", Date: "29/03/2026", TargetTimezone: "Europe/Paris"},
+		{SourceTimezone: "UTC", Time: "2026-03-29T12:00:00", Date: "2026-03-29", TargetTimezone: "Europe/Paris"},
+	}
+	for i, tc := range cases {
+		_, _, err := ConvertTime(context.Background(), nil, tc)
+		if err == nil {
+			t.Fatalf("case %d: expected error, got nil", i)
+		}
+	}
+}
+
+func TestConvertTimeNonexistentLocalTime(t *testing.T) {
+	input := types.ConvertTimeInput{
+		SourceTimezone: "America/New_York",
+		Time:           "02:30",
+		Date:           "2026-03-08",
+		TargetTimezone: "UTC",
+	}
+	_, out, err := ConvertTime(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.Nonexistent || out.Ambiguous {
+		t.Fatalf("expected nonexistent flag only, got nonexistent=%v ambiguous=%v", out.Nonexistent, out.Ambiguous)
+	}
+	if out.Source.Datetime != "2026-03-08T03:30:00-04:00" {
+		t.Errorf("unexpected source datetime: %s", out.Source.Datetime)
+	}
+
+	input.Disambiguation = "shift_forward"
+	_, out, err = ConvertTime(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Target.Datetime != "2026-03-08T07:00:00+00:00" {
+		t.Errorf("unexpected shifted target datetime: %s", out.Target.Datetime)
+	}
+
+	input.Disambiguation = "reject"
+	if _, _, err := ConvertTime(context.Background(), nil, input); err == nil {
+		t.Error("expected error when rejecting a nonexistent time")
+	}
+}
+
+func TestConvertTimeAmbiguousLocalTime(t *testing.T) {
+	input := types.ConvertTimeInput{
+		SourceTimezone: "America/New_York",
+		Time:           "2026-11-01T01:30:00",
+		TargetTimezone: "UTC",
+		Disambiguation: "later",
+	}
+	_, out, err := ConvertTime(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.Ambiguous {
+		t.Fatal("expected ambiguous flag")
+	}
+	if len(out.Candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %d", len(out.Candidates))
+	}
+	if out.Candidates[0].Datetime != "2026-11-01T01:30:00-04:00" || out.Candidates[1].Datetime != "2026-11-01T01:30:00-05:00" {
+		t.Errorf("unexpected candidates: %+v", out.Candidates)
+	}
+	if out.Target.Datetime != "2026-11-01T06:30:00+00:00" {
+		t.Errorf("expected later instant, got %s", out.Target.Datetime)
+	}
+}
+
+func TestConvertTimeInvalidDisambiguation(t *testing.T) {
+	input := types.ConvertTimeInput{SourceTimezone: "UTC", Time: "12:00", TargetTimezone: "Europe/Paris", Disambiguation: "sideways"}
+	if _, _, err := ConvertTime(context.Background(), nil, input); err == nil {
+		t.Error("expected error for unknown disambiguation policy")
+	}
+}
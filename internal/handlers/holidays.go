@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/holidays"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+const maxHolidayRangeDays = 3660 // 10 years
+
+// ListHolidays implements the list_holidays MCP tool handler.
+// It returns the public holidays of a country, or of one of its subdivisions, in a year
+// or between two dates.
+func ListHolidays(ctx context.Context, req *mcp.CallToolRequest, input types.ListHolidaysInput) (
+	*mcp.CallToolResult,
+	types.ListHolidaysResult,
+	error,
+) {
+	country, subdivision, _ := strings.Cut(strings.ToUpper(strings.TrimSpace(input.Country)), "-")
+	if country == "" {
+		return nil, types.ListHolidaysResult{}, fmt.Errorf("country is required")
+	}
+	if input.Subdivision != "" {
+		subdivision = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(input.Subdivision)), country+"-")
+	}
+	code := country
+	if subdivision != "" {
+		code += "-" + subdivision
+	}
+
+	from, to, err := holidayRange(input)
+	if err != nil {
+		return nil, types.ListHolidaysResult{}, err
+	}
+	list, err := holidays.Between(code, from, to)
+	if err != nil {
+		return nil, types.ListHolidaysResult{}, err
+	}
+
+	result := types.ListHolidaysResult{
+		Country:     country,
+		CountryName: holidays.CountryName(country),
+		Start:       from.Format(time.DateOnly),
+		End:         to.Format(time.DateOnly),
+		Holidays:    holidayResults(list),
+	}
+	if subdivision != "" {
+		result.Subdivision = code
+		result.SubdivisionName = holidays.Subdivisions(country)[code]
+	}
+	return nil, result, nil
+}
+
+// holidayRange resolves the year or the start and end dates of a request, defaulting to
+// the current year.
+func holidayRange(input types.ListHolidaysInput) (time.Time, time.Time, error) {
+	if input.Year != 0 && (input.Start != "" || input.End != "") {
+		return time.Time{}, time.Time{}, fmt.Errorf("year and start/end are exclusive")
+	}
+	if input.Start == "" {
+		if input.End != "" {
+			return time.Time{}, time.Time{}, fmt.Errorf("end requires start")
+		}
+		year := input.Year
+		if year == 0 {
+			year = time.Now().UTC().Year()
+		}
+		if year < 1 || year > 9999 {
+			return time.Time{}, time.Time{}, fmt.Errorf("year must be between 1 and 9999")
+		}
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	from, err := businessDate(input.Start, "")
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start: %w", err)
+	}
+	to := from
+	if input.End != "" {
+		if to, err = businessDate(input.End, ""); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end: %w", err)
+		}
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end must not be before start")
+	}
+	if to.Sub(from) > maxHolidayRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("start and end must be at most %d days apart", maxHolidayRangeDays)
+	}
+	return from, to, nil
+}
+
+func registerListHolidays(server *mcp.Server) {
+	var withSubdivisions []string
+	for _, code := range holidays.Countries() {
+		if len(holidays.Subdivisions(code)) > 0 {
+			withSubdivisions = append(withSubdivisions, code)
+		}
+	}
+
+	listHolidaysSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"country": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("ISO 3166-1 alpha-2 country code, one of %s. GB without a subdivision lists the bank holidays of England and Wales.", strings.Join(holidays.Countries(), ", ")),
+			},
+			"subdivision": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("Optional ISO 3166-2 subdivision code adding regional holidays (e.g., 'DE-BY' for Bavaria, 'GB-SCT' for Scotland). Available for %s.", strings.Join(withSubdivisions, ", ")),
+			},
+			"year": map[string]any{
+				"type":        "integer",
+				"description": "Year to list. Defaults to the current year when start is omitted.",
+			},
+			"start": map[string]any{
+				"type":        "string",
+				"description": "First date (YYYY-MM-DD) to list instead of a year. Give only start to check a single day.",
+			},
+			"end": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("Last date (YYYY-MM-DD), included. Defaults to start; at most %d days after it.", maxHolidayRangeDays),
+			},
+		},
+		"required": []string{"country"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_holidays",
+		Description: "List the public holidays of a country or subdivision in a year or date range, offline, with local names and substitute days observed for holidays on weekends. Movable feasts are computed: Easter-relative days, Chinese and Korean lunar festivals and solar terms. Each country's rules only cover the years since its last holiday reform",
+		InputSchema: listHolidaysSchema,
+	}, ListHolidays)
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestListHolidays(t *testing.T) {
+	_, out, err := ListHolidays(context.Background(), nil, types.ListHolidaysInput{Country: "gb", Subdivision: "sct", Year: 2027})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.CountryName != "United Kingdom" || out.Subdivision != "GB-SCT" || out.SubdivisionName != "Scotland" || out.Start != "2027-01-01" || out.End != "2027-12-31" {
+		t.Errorf("unexpected result: %+v", out)
+	}
+	var names []string
+	for _, h := range out.Holidays {
+		names = append(names, h.Date+" "+h.Name)
+	}
+	for _, want := range []string{"2027-01-04 2nd January (observed)", "2027-08-02 Summer bank holiday", "2027-11-30 Saint Andrew's Day"} {
+		if !strings.Contains(strings.Join(names, "\n"), want) {
+			t.Errorf("missing %q in:\n%s", want, strings.Join(names, "\n"))
+		}
+	}
+
+	// A single day: Monday 2026-09-21 is Respect for the Aged Day in Japan
+	_, out, err = ListHolidays(context.Background(), nil, types.ListHolidaysInput{Country: "JP", Start: "2026-09-21"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Holidays) != 1 || out.Holidays[0].LocalName != "敬老の日" || out.Holidays[0].DayOfWeek != "Monday" || out.Subdivision != "" {
+		t.Errorf("unexpected result: %+v", out)
+	}
+
+	// The subdivision can be given in the country code
+	_, out, err = ListHolidays(context.Background(), nil, types.ListHolidaysInput{Country: "DE-BY", Start: "2026-06-01", End: "2026-06-30"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Holidays) != 1 || out.Holidays[0].Name != "Corpus Christi" || !out.Holidays[0].Regional || out.SubdivisionName != "Bayern" {
+		t.Errorf("unexpected result: %+v", out)
+	}
+}
+
+func TestListHolidaysErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.ListHolidaysInput
+		want  string
+	}{
+		{"missing country", types.ListHolidaysInput{}, "country is required"},
+		{"unknown country", types.ListHolidaysInput{Country: "XX"}, "no holiday calendar"},
+		{"unknown subdivision", types.ListHolidaysInput{Country: "DE", Subdivision: "XX"}, "unknown subdivision"},
+		{"year and start", types.ListHolidaysInput{Country: "US", Year: 2026, Start: "2026-01-01"}, "exclusive"},
+		{"end without start", types.ListHolidaysInput{Country: "US", End: "2026-01-01"}, "end requires start"},
+		{"reversed range", types.ListHolidaysInput{Country: "US", Start: "2026-02-01", End: "2026-01-01"}, "must not be before"},
+		{"range too long", types.ListHolidaysInput{Country: "US", Start: "2000-01-01", End: "2026-01-01"}, "days apart"},
+		{"bad date", types.ListHolidaysInput{Country: "US", Start: "01/02/2026"}, "invalid start"},
+		{"bad year", types.ListHolidaysInput{Country: "US", Year: -5}, "year must be between"},
+		{"year before the rules", types.ListHolidaysInput{Country: "US", Year: 1950}, "only computed from 1971"},
+		{"range before the rules", types.ListHolidaysInput{Country: "JP", Start: "2099-06-01", End: "2100-01-31"}, "not for 2100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ListHolidays(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/ical"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// ParseICS implements the parse_ics MCP tool handler.
+// It reads the VEVENTs of an iCalendar document and converts their start and end times
+// into the requested timezone, using the document's VTIMEZONE definitions for its TZIDs.
+func ParseICS(ctx context.Context, req *mcp.CallToolRequest, input types.ParseICSInput) (
+	*mcp.CallToolResult,
+	types.ParseICSResult,
+	error,
+) {
+	if input.ICS == "" {
+		return nil, types.ParseICSResult{}, fmt.Errorf("ics is required")
+	}
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return nil, types.ParseICSResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	target := now.Location()
+	floating := target
+	if input.FloatingTimezone != "" {
+		now, err := timezone.GetNowInLocation(input.FloatingTimezone)
+		if err != nil {
+			return nil, types.ParseICSResult{}, fmt.Errorf("invalid floating timezone: %w%s", err, didYouMean(input.FloatingTimezone))
+		}
+		floating = now.Location()
+	}
+
+	root, err := ical.Parse(input.ICS)
+	if err != nil {
+		return nil, types.ParseICSResult{}, fmt.Errorf("invalid iCalendar data: %w", err)
+	}
+	cal, err := ical.NewCalendar(root)
+	if err != nil {
+		return nil, types.ParseICSResult{}, err
+	}
+	events, err := cal.Events(floating)
+	if err != nil {
+		return nil, types.ParseICSResult{}, err
+	}
+
+	result := types.ParseICSResult{
+		Timezone:        tz,
+		Events:          []types.ICSEvent{},
+		CustomTimezones: []string{},
+	}
+	result.CustomTimezones = append(result.CustomTimezones, cal.Custom...)
+	for _, e := range events {
+		result.Events = append(result.Events, types.ICSEvent{
+			UID:        e.UID,
+			Summary:    e.Summary,
+			Location:   e.Location,
+			AllDay:     e.AllDay,
+			Recurrence: e.Recurrence,
+			Start:      buildConversion(e.Start, e.Timezone, target, tz),
+			End:        buildConversion(e.End, e.Timezone, target, tz),
+		})
+	}
+	return nil, result, nil
+}
+
+// buildConversion converts a resolved wall-clock time into the target location, as
+// convert_time does.
+func buildConversion(local timezone.LocalTime, sourceTZ string, target *time.Location, targetTZ string) types.TimeConversionResult {
+	targetTime := local.Time.In(target)
+	_, offSource := local.Time.Zone()
+	_, offTarget := targetTime.Zone()
+	result := types.TimeConversionResult{
+		Source:         timeutil.BuildTimeResult(local.Time, sourceTZ),
+		Target:         timeutil.BuildTimeResult(targetTime, targetTZ),
+		TimeDifference: timeutil.FormatTimeDifference(offSource, offTarget),
+		Nonexistent:    local.Nonexistent,
+		Ambiguous:      local.Ambiguous,
+	}
+	for _, candidate := range local.Candidates {
+		result.Candidates = append(result.Candidates, timeutil.BuildTimeResult(candidate, sourceTZ))
+	}
+	return result
+}
+
+func registerParseICS(server *mcp.Server, localTZ string) {
+	parseICSSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"ics": map[string]any{
+				"type":        "string",
+				"description": "iCalendar (RFC 5545) data: a VCALENDAR, or bare VEVENT and VTIMEZONE blocks. TZIDs are resolved from the document's VTIMEZONE definitions first, then as IANA names.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone to convert event times into. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"floating_timezone": map[string]any{
+				"type":        "string",
+				"description": "IANA timezone of floating times and all-day dates, which carry no TZID. Defaults to timezone.",
+			},
+		},
+		"required": []string{"ics", "timezone"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "parse_ics",
+		Description: "Parse the VEVENTs of an iCalendar (.ics) document, including custom VTIMEZONE definitions, and convert each event's start and end into a timezone",
+		InputSchema: parseICSSchema,
+	}, ParseICS)
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+const outlookInvite = `BEGIN:VCALENDAR
+BEGIN:VTIMEZONE
+TZID:Eastern Standard Time
+BEGIN:STANDARD
+DTSTART:16011104T020000
+RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11
+TZOFFSETFROM:-0400
+TZOFFSETTO:-0500
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:16010311T020000
+RRULE:FREQ=YEARLY;BYDAY=2SU;BYMONTH=3
+TZOFFSETFROM:-0500
+TZOFFSETTO:-0400
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:standup-1
+SUMMARY:Standup
+DTSTART;TZID=Eastern Standard Time:20260309T090000
+DTEND;TZID=Eastern Standard Time:20260309T091500
+RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Offsite
+DTSTART;VALUE=DATE:20260320
+DTEND;VALUE=DATE:20260321
+END:VEVENT
+END:VCALENDAR`
+
+func TestParseICS(t *testing.T) {
+	_, out, err := ParseICS(context.Background(), nil, types.ParseICSInput{
+		ICS:      outlookInvite,
+		Timezone: "Europe/London",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Events) != 2 || len(out.CustomTimezones) != 1 || out.CustomTimezones[0] != "Eastern Standard Time" {
+		t.Fatalf("unexpected result: %+v", out)
+	}
+
+	standup := out.Events[0]
+	if standup.Start.Source.Datetime != "2026-03-09T09:00:00-04:00" || !standup.Start.Source.IsDst {
+		t.Errorf("unexpected source start: %+v", standup.Start.Source)
+	}
+	if standup.Start.Target.Datetime != "2026-03-09T13:00:00+00:00" {
+		t.Errorf("unexpected target start: %+v", standup.Start.Target)
+	}
+	if standup.Start.TimeDifference != "+4.0h" || standup.End.Target.Timezone != "Europe/London" {
+		t.Errorf("unexpected conversion: %+v", standup.End)
+	}
+	if len(standup.Recurrence) != 1 {
+		t.Errorf("unexpected recurrence: %v", standup.Recurrence)
+	}
+
+	offsite := out.Events[1]
+	if !offsite.AllDay || offsite.Start.Source.Timezone != "Europe/London" || offsite.End.Source.Datetime != "2026-03-21T00:00:00+00:00" {
+		t.Errorf("unexpected all-day event: %+v", offsite)
+	}
+}
+
+func TestParseICSErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.ParseICSInput
+		want  string
+	}{
+		{"missing ics", types.ParseICSInput{Timezone: "UTC"}, "ics is required"},
+		{"bad timezone", types.ParseICSInput{ICS: outlookInvite, Timezone: "Europe/Pari"}, "Europe/Paris"},
+		{"unclosed", types.ParseICSInput{ICS: "BEGIN:VEVENT", Timezone: "UTC"}, "not closed"},
+		{"unknown TZID", types.ParseICSInput{ICS: "BEGIN:VEVENT\nDTSTART;TZID=Pacific Time:20260101T090000\nEND:VEVENT", Timezone: "UTC"}, "unknown TZID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParseICS(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
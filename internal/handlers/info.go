@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+const (
+	defaultTransitionCount = 2
+	maxTransitionCount     = 20
+)
+
+// GetTimezoneInfo implements the get_timezone_info MCP tool handler.
+// It returns a timezone's offsets and its upcoming and previous clock changes.
+func GetTimezoneInfo(ctx context.Context, req *mcp.CallToolRequest, input types.TimezoneInfoInput) (
+	*mcp.CallToolResult,
+	types.TimezoneInfoResult,
+	error,
+) {
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return nil, types.TimezoneInfoResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	count := input.Transitions
+	if count <= 0 {
+		count = defaultTransitionCount
+	}
+	count = min(count, maxTransitionCount)
+
+	abbr, offset := now.Zone()
+	standard, daylight, observesDST := timezone.StandardOffsets(now)
+	result := types.TimezoneInfoResult{
+		Timezone:            tz,
+		Current:             timeutil.BuildTimeResult(now, tz),
+		Abbreviation:        abbr,
+		UTCOffset:           timeutil.FormatUTCOffset(offset),
+		StandardOffset:      timeutil.FormatUTCOffset(standard),
+		ObservesDst:         observesDST,
+		NextTransitions:     buildTransitions(timezone.NextTransitions(now, count)),
+		PreviousTransitions: buildTransitions(timezone.PreviousTransitions(now, count)),
+	}
+	if observesDST {
+		result.DaylightOffset = timeutil.FormatUTCOffset(daylight)
+	}
+	return nil, result, nil
+}
+
+// buildTransitions converts zone transitions to their tool output form.
+func buildTransitions(transitions []timezone.Transition) []types.TimezoneTransition {
+	out := []types.TimezoneTransition{}
+	for _, tr := range transitions {
+		out = append(out, types.TimezoneTransition{
+			Datetime:           timezone.FormatISOSeconds(tr.At),
+			UTC:                tr.At.UTC().Format(time.RFC3339),
+			OffsetBefore:       timeutil.FormatUTCOffset(tr.OffsetBefore),
+			OffsetAfter:        timeutil.FormatUTCOffset(tr.OffsetAfter),
+			AbbreviationBefore: tr.AbbreviationBefore,
+			AbbreviationAfter:  tr.AbbreviationAfter,
+			Delta:              timeutil.FormatTimeDifference(tr.OffsetBefore, tr.OffsetAfter),
+		})
+	}
+	return out
+}
+
+func registerTimezoneInfo(server *mcp.Server, localTZ string) {
+	timezoneInfoSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone name (e.g., 'America/New_York', 'Europe/London'). Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"transitions": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Number of upcoming and previous clock changes to return (default %d, max %d).", defaultTransitionCount, maxTransitionCount),
+			},
+		},
+		"required": []string{"timezone"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_timezone_info",
+		Description: "Get a timezone's abbreviation, standard and daylight offsets, and its next and previous DST transitions",
+		InputSchema: timezoneInfoSchema,
+	}, GetTimezoneInfo)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestGetTimezoneInfoWithDST(t *testing.T) {
+	_, out, err := GetTimezoneInfo(context.Background(), nil, types.TimezoneInfoInput{Timezone: "Europe/Paris", Transitions: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.ObservesDst || out.StandardOffset != "+01:00" || out.DaylightOffset != "+02:00" {
+		t.Errorf("unexpected offsets: %+v", out)
+	}
+	if len(out.NextTransitions) != 3 || len(out.PreviousTransitions) != 3 {
+		t.Fatalf("expected 3 transitions each way, got %d and %d", len(out.NextTransitions), len(out.PreviousTransitions))
+	}
+
+	now := time.Now()
+	for _, tr := range out.NextTransitions {
+		at, err := time.Parse(time.RFC3339, tr.UTC)
+		if err != nil {
+			t.Fatalf("transition utc not RFC3339: %v", err)
+		}
+		if !at.After(now) {
+			t.Errorf("next transition %s is not in the future", tr.UTC)
+		}
+		if tr.Delta != "+1.0h" && tr.Delta != "-1.0h" {
+			t.Errorf("unexpected delta %s", tr.Delta)
+		}
+	}
+	for _, tr := range out.PreviousTransitions {
+		at, _ := time.Parse(time.RFC3339, tr.UTC)
+		if at.After(now) {
+			t.Errorf("previous transition %s is in the future", tr.UTC)
+		}
+	}
+}
+
+func TestGetTimezoneInfoCasablanca(t *testing.T) {
+	// Morocco stays on +01 but moves to +00 during Ramadan, which the Jan/Jul offsets miss
+	_, out, err := GetTimezoneInfo(context.Background(), nil, types.TimezoneInfoInput{Timezone: "Africa/Casablanca", Transitions: 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.ObservesDst || out.StandardOffset != "+00:00" || out.DaylightOffset != "+01:00" {
+		t.Errorf("unexpected offsets: %+v", out)
+	}
+	for _, tr := range out.NextTransitions {
+		for _, off := range []string{tr.OffsetBefore, tr.OffsetAfter} {
+			if off != out.StandardOffset && off != out.DaylightOffset {
+				t.Errorf("transition at %s to or from %s, neither standard nor daylight", tr.UTC, off)
+			}
+		}
+	}
+	if out.Current.IsDst != (out.UTCOffset == out.DaylightOffset) {
+		t.Errorf("is_dst = %v at offset %s", out.Current.IsDst, out.UTCOffset)
+	}
+}
+
+func TestGetTimezoneInfoWithoutDST(t *testing.T) {
+	_, out, err := GetTimezoneInfo(context.Background(), nil, types.TimezoneInfoInput{Timezone: "Asia/Kolkata"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ObservesDst || out.DaylightOffset != "" {
+		t.Errorf("expected no DST for Asia/Kolkata: %+v", out)
+	}
+	if out.UTCOffset != "+05:30" || out.Abbreviation != "IST" {
+		t.Errorf("unexpected offset or abbreviation: %s %s", out.UTCOffset, out.Abbreviation)
+	}
+	if out.NextTransitions == nil || len(out.NextTransitions) != 0 {
+		t.Errorf("expected an empty next transition list, got %#v", out.NextTransitions)
+	}
+	if len(out.PreviousTransitions) == 0 {
+		t.Error("expected historical transitions for Asia/Kolkata")
+	}
+}
+
+func TestGetTimezoneInfoInvalidTimezone(t *testing.T) {
+	if _, _, err := GetTimezoneInfo(context.Background(), nil, types.TimezoneInfoInput{Timezone: "Invalid/Zone"}); err == nil {
+		t.Error("expected error for invalid timezone")
+	}
+}
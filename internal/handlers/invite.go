@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/ical"
+	"github.com/r0mdau/mcp-time/internal/recurrence"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// CreateICS implements the create_ics MCP tool handler.
+// It writes an event as an iCalendar document with a VTIMEZONE generated from the zone
+// data, returned as text and as an embedded text/calendar resource.
+func CreateICS(ctx context.Context, req *mcp.CallToolRequest, input types.CreateICSInput) (
+	*mcp.CallToolResult,
+	types.CreateICSResult,
+	error,
+) {
+	if input.Title == "" || input.Start == "" || input.Duration == "" {
+		return nil, types.CreateICSResult{}, fmt.Errorf("title, start and duration are required")
+	}
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return nil, types.CreateICSResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	start, err := timezone.ParseInLocation(input.Start, now.Location(), timezone.DisambiguateCompatible)
+	if err != nil {
+		return nil, types.CreateICSResult{}, fmt.Errorf("invalid start: %w", err)
+	}
+	d, err := duration.Parse(input.Duration)
+	if err != nil {
+		return nil, types.CreateICSResult{}, err
+	}
+	if d.Negative {
+		return nil, types.CreateICSResult{}, fmt.Errorf("duration must not be negative")
+	}
+	end, err := d.AddTo(start.Time, duration.ModeCalendar)
+	if err != nil {
+		return nil, types.CreateICSResult{}, err
+	}
+
+	event := ical.Event{
+		UID:      input.UID,
+		Summary:  input.Title,
+		Location: input.Location,
+		Timezone: tz,
+		Start:    start,
+		End:      timezone.LocalTime{Time: end},
+	}
+	result := types.CreateICSResult{
+		Start:       timeutil.BuildTimeResult(start.Time, tz),
+		End:         timeutil.BuildTimeResult(end, tz),
+		Nonexistent: start.Nonexistent,
+		Ambiguous:   start.Ambiguous,
+	}
+	if input.Recurrence != "" {
+		r, err := recurrence.ParseRule(input.Recurrence)
+		if err != nil {
+			return nil, types.CreateICSResult{}, err
+		}
+		result.Recurrence = r.String()
+		event.Recurrence = []string{result.Recurrence}
+	}
+	if event.UID == "" {
+		// Derived from the event so that regenerating it updates the same calendar entry
+		sum := sha256.Sum256(fmt.Appendf(nil, "%s\n%s\n%s\n%s", input.Title, tz, timezone.FormatISOSeconds(start.Time), result.Recurrence))
+		event.UID = hex.EncodeToString(sum[:16]) + "@mcp-time"
+	}
+	result.UID = event.UID
+
+	doc, err := ical.NewDocument([]ical.Event{event}, time.Now())
+	if err != nil {
+		return nil, types.CreateICSResult{}, err
+	}
+	result.ICS = doc.String()
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: result.ICS},
+			&mcp.EmbeddedResource{Resource: &mcp.ResourceContents{
+				URI:      "mcp-time://events/" + event.UID + ".ics",
+				MIMEType: "text/calendar",
+				Text:     result.ICS,
+			}},
+		},
+	}, result, nil
+}
+
+func registerCreateICS(server *mcp.Server, localTZ string) {
+	createICSSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"title": map[string]any{
+				"type":        "string",
+				"description": "Event title (SUMMARY).",
+			},
+			"start": map[string]any{
+				"type":        "string",
+				"description": "Event start as an ISO 8601 datetime on the timezone's wall clock (e.g., '2026-03-10T15:00:00').",
+			},
+			"duration": map[string]any{
+				"type":        "string",
+				"description": "Event length as an ISO 8601 duration ('PT45M', 'P1D') or Go duration ('1h30m').",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone of the event; a VTIMEZONE for it is generated from the zone data. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"recurrence": map[string]any{
+				"type":        "string",
+				"description": "Optional RFC 5545 RRULE value (e.g., 'FREQ=WEEKLY;BYDAY=TU;COUNT=10').",
+			},
+			"location": map[string]any{
+				"type":        "string",
+				"description": "Optional event location.",
+			},
+			"uid": map[string]any{
+				"type":        "string",
+				"description": "Optional UID, to update an event created earlier. Generated from the event when omitted.",
+			},
+		},
+		"required": []string{"title", "start", "duration", "timezone"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "create_ics",
+		Description: "Create an iCalendar (.ics) invite for an event, with a VTIMEZONE generated from the zone data, returned as text and as an embedded text/calendar resource",
+		InputSchema: createICSSchema,
+	}, CreateICS)
+}
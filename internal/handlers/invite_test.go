@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestCreateICS(t *testing.T) {
+	input := types.CreateICSInput{
+		Title:      "Planning, Q2",
+		Start:      "2026-03-10T15:00:00",
+		Duration:   "PT45M",
+		Timezone:   "America/New_York",
+		Recurrence: "FREQ=WEEKLY;BYDAY=TU;COUNT=4",
+	}
+	res, out, err := CreateICS(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Start.Datetime != "2026-03-10T15:00:00-04:00" || out.End.Datetime != "2026-03-10T15:45:00-04:00" {
+		t.Errorf("unexpected times: %+v - %+v", out.Start, out.End)
+	}
+	for _, want := range []string{
+		"BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n",
+		"DTSTART;TZID=America/New_York:20260310T150000\r\n",
+		"DTEND;TZID=America/New_York:20260310T154500\r\n",
+		"SUMMARY:Planning\\, Q2\r\n",
+		"RRULE:FREQ=WEEKLY;COUNT=4;BYDAY=TU\r\n",
+		"UID:" + out.UID + "\r\n",
+	} {
+		if !strings.Contains(out.ICS, want) {
+			t.Errorf("ics does not contain %q:\n%s", want, out.ICS)
+		}
+	}
+
+	if res == nil || len(res.Content) != 2 {
+		t.Fatalf("expected text and resource content, got %+v", res)
+	}
+	if text, ok := res.Content[0].(*mcp.TextContent); !ok || text.Text != out.ICS {
+		t.Errorf("unexpected text content: %+v", res.Content[0])
+	}
+	resource, ok := res.Content[1].(*mcp.EmbeddedResource)
+	if !ok || resource.Resource.MIMEType != "text/calendar" || resource.Resource.Text != out.ICS || !strings.HasSuffix(resource.Resource.URI, ".ics") {
+		t.Errorf("unexpected resource content: %+v", res.Content[1])
+	}
+
+	// The generated document reads back through parse_ics
+	_, parsed, err := ParseICS(context.Background(), nil, types.ParseICSInput{ICS: out.ICS, Timezone: "Europe/Paris"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(parsed.Events) != 1 || parsed.Events[0].Start.Target.Datetime != "2026-03-10T20:00:00+01:00" {
+		t.Errorf("unexpected round trip: %+v", parsed.Events)
+	}
+
+	// The same event gets the same UID
+	_, again, _ := CreateICS(context.Background(), nil, input)
+	if again.UID != out.UID {
+		t.Errorf("UID changed from %q to %q", out.UID, again.UID)
+	}
+}
+
+func TestCreateICSErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.CreateICSInput
+		want  string
+	}{
+		{"missing title", types.CreateICSInput{Start: "2026-03-10T15:00:00", Duration: "1h", Timezone: "UTC"}, "required"},
+		{"bad timezone", types.CreateICSInput{Title: "x", Start: "2026-03-10T15:00:00", Duration: "1h", Timezone: "America/New_Yrok"}, "America/New_York"},
+		{"negative duration", types.CreateICSInput{Title: "x", Start: "2026-03-10T15:00:00", Duration: "-1h", Timezone: "UTC"}, "negative"},
+		{"bad rule", types.CreateICSInput{Title: "x", Start: "2026-03-10T15:00:00", Duration: "1h", Timezone: "UTC", Recurrence: "FREQ=SOMETIMES"}, "FREQ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := CreateICS(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+	"github.com/r0mdau/mcp-time/internal/zones"
+)
+
+// ListTimezones implements the list_timezones MCP tool handler.
+// It enumerates valid IANA timezones filtered by country, region prefix and current UTC offset.
+func ListTimezones(ctx context.Context, req *mcp.CallToolRequest, input types.ListTimezonesInput) (
+	*mcp.CallToolResult,
+	types.ListTimezonesResult,
+	error,
+) {
+	candidates := zones.All()
+	if input.Country != "" {
+		if _, ok := zones.CountryName(input.Country); !ok {
+			return nil, types.ListTimezonesResult{}, fmt.Errorf("unknown ISO 3166 country code %q", input.Country)
+		}
+		// Keep the country's own ordering, most populous zone first
+		candidates = nil
+		for _, name := range zones.CountryZones(input.Country) {
+			z, _ := zones.Lookup(name)
+			candidates = append(candidates, z)
+		}
+	}
+
+	wantOffset, filterOffset := 0, input.Offset != ""
+	if filterOffset {
+		var err error
+		if wantOffset, err = timeutil.ParseUTCOffset(input.Offset); err != nil {
+			return nil, types.ListTimezonesResult{}, err
+		}
+	}
+	region := strings.ToLower(strings.Trim(input.Region, "/"))
+
+	now := time.Now()
+	result := types.ListTimezonesResult{Timezones: []types.TimezoneListEntry{}}
+	for _, z := range candidates {
+		if region != "" && !strings.HasPrefix(strings.ToLower(z.Name)+"/", region+"/") {
+			continue
+		}
+		// zone1970.tab names always load thanks to the embedded tzdata
+		loc, err := time.LoadLocation(z.Name)
+		if err != nil {
+			continue
+		}
+		local := now.In(loc)
+		abbr, offset := local.Zone()
+		if filterOffset && offset != wantOffset {
+			continue
+		}
+		result.Timezones = append(result.Timezones, types.TimezoneListEntry{
+			Timezone:     z.Name,
+			UTCOffset:    timeutil.FormatUTCOffset(offset),
+			Abbreviation: abbr,
+			IsDst:        timezone.IsDST(local),
+			Countries:    z.Countries,
+			Comment:      z.Comment,
+		})
+	}
+	result.Count = len(result.Timezones)
+	return nil, result, nil
+}
+
+func registerListTimezones(server *mcp.Server) {
+	listTimezonesSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"country": map[string]any{
+				"type":        "string",
+				"description": "Optional ISO 3166 alpha-2 country code (e.g., 'US', 'IN', 'AU'). Zones are returned most populous first.",
+			},
+			"region": map[string]any{
+				"type":        "string",
+				"description": "Optional zone name prefix such as a continent (e.g., 'Europe', 'America', 'America/Argentina').",
+			},
+			"offset": map[string]any{
+				"type":        "string",
+				"description": "Optional current UTC offset to match (e.g., '+05:30', '-08:00', 'UTC+2').",
+			},
+		},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_timezones",
+		Description: "List valid IANA timezones with their current UTC offset, abbreviation and DST status, filtered by country, region or offset",
+		InputSchema: listTimezonesSchema,
+	}, ListTimezones)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestListTimezonesAll(t *testing.T) {
+	_, out, err := ListTimezones(context.Background(), nil, types.ListTimezonesInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Count < 300 || out.Count != len(out.Timezones) {
+		t.Errorf("unexpected count %d for %d zones", out.Count, len(out.Timezones))
+	}
+}
+
+func TestListTimezonesByCountry(t *testing.T) {
+	_, out, err := ListTimezones(context.Background(), nil, types.ListTimezonesInput{Country: "au"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Count == 0 {
+		t.Fatal("expected Australian zones")
+	}
+	foundSydney := false
+	for _, z := range out.Timezones {
+		if !slices.Contains(z.Countries, "AU") {
+			t.Errorf("zone %s does not cover AU: %v", z.Timezone, z.Countries)
+		}
+		foundSydney = foundSydney || z.Timezone == "Australia/Sydney"
+	}
+	if !foundSydney {
+		t.Error("expected Australia/Sydney in AU zones")
+	}
+}
+
+func TestListTimezonesByRegionAndOffset(t *testing.T) {
+	_, out, err := ListTimezones(context.Background(), nil, types.ListTimezonesInput{Region: "Asia", Offset: "+05:45"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Count != 1 || out.Timezones[0].Timezone != "Asia/Kathmandu" {
+		t.Fatalf("expected only Asia/Kathmandu, got %+v", out.Timezones)
+	}
+	z := out.Timezones[0]
+	if z.UTCOffset != "+05:45" || z.IsDst || z.Abbreviation == "" {
+		t.Errorf("unexpected entry: %+v", z)
+	}
+}
+
+func TestListTimezonesRegionIsPathPrefix(t *testing.T) {
+	_, out, err := ListTimezones(context.Background(), nil, types.ListTimezonesInput{Region: "America/Argentina"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Count == 0 {
+		t.Fatal("expected Argentinian zones")
+	}
+	// "Europe/Lon" is not a complete path segment
+	_, out, err = ListTimezones(context.Background(), nil, types.ListTimezonesInput{Region: "Europe/Lon"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Count != 0 {
+		t.Errorf("expected no zones for partial segment, got %d", out.Count)
+	}
+}
+
+func TestListTimezonesInvalidInput(t *testing.T) {
+	cases := []types.ListTimezonesInput{
+		{Country: "XX"},
+		{Offset: "half past five"},
+	}
+	for i, tc := range cases {
+		if _, _, err := ListTimezones(context.Background(), nil, tc); err == nil {
+			t.Errorf("case %d: expected error, got nil", i)
+		}
+	}
+}
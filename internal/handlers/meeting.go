@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/meeting"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+const (
+	defaultMeetingDays  = 7
+	maxMeetingDays      = 31
+	defaultMeetingStep  = 30
+	minMeetingStep      = 5
+	defaultMeetingLimit = 10
+	maxMeetingLimit     = 50
+)
+
+// FindMeetingTimes implements the find_meeting_times MCP tool handler.
+// It returns ranked slots that fall within every participant's working hours.
+func FindMeetingTimes(ctx context.Context, req *mcp.CallToolRequest, input types.FindMeetingTimesInput) (
+	*mcp.CallToolResult,
+	types.FindMeetingTimesResult,
+	error,
+) {
+	if len(input.Participants) == 0 {
+		return nil, types.FindMeetingTimesResult{}, fmt.Errorf("participants is required")
+	}
+	if input.DurationMinutes <= 0 {
+		return nil, types.FindMeetingTimesResult{}, fmt.Errorf("duration_minutes must be positive")
+	}
+
+	participants := make([]meeting.Participant, len(input.Participants))
+	for i, p := range input.Participants {
+		participant, err := buildParticipant(p)
+		if err != nil {
+			return nil, types.FindMeetingTimesResult{}, fmt.Errorf("participant %d: %w", i+1, err)
+		}
+		participants[i] = participant
+	}
+
+	from, to, err := meetingRange(input)
+	if err != nil {
+		return nil, types.FindMeetingTimesResult{}, err
+	}
+	step := input.StepMinutes
+	if step <= 0 {
+		step = defaultMeetingStep
+	}
+	step = max(step, minMeetingStep)
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultMeetingLimit
+	}
+	limit = min(limit, maxMeetingLimit)
+
+	slots := meeting.Find(participants, from, to, time.Duration(input.DurationMinutes)*time.Minute, time.Duration(step)*time.Minute)
+	result := types.FindMeetingTimesResult{Slots: []types.MeetingSlot{}}
+	for _, s := range slots[:min(limit, len(slots))] {
+		slot := types.MeetingSlot{
+			StartUTC: s.Start.UTC().Format(time.RFC3339),
+			EndUTC:   s.End.UTC().Format(time.RFC3339),
+			Score:    s.Score,
+		}
+		for i, p := range input.Participants {
+			loc := participants[i].Location
+			slot.Participants = append(slot.Participants, types.MeetingSlotParticipant{
+				Name:  p.Name,
+				Start: timeutil.BuildTimeResult(s.Start.In(loc), p.Timezone),
+				End:   timeutil.BuildTimeResult(s.End.In(loc), p.Timezone),
+			})
+		}
+		result.Slots = append(result.Slots, slot)
+	}
+	return nil, result, nil
+}
+
+// buildParticipant validates a participant and applies the 09:00-17:00 Monday to Friday defaults.
+func buildParticipant(p types.MeetingParticipant) (meeting.Participant, error) {
+	if p.Timezone == "" {
+		return meeting.Participant{}, fmt.Errorf("timezone is required")
+	}
+	now, err := timezone.GetNowInLocation(p.Timezone)
+	if err != nil {
+		return meeting.Participant{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(p.Timezone))
+	}
+	participant := meeting.Participant{
+		Location:  now.Location(),
+		WorkStart: 9 * time.Hour,
+		WorkEnd:   17 * time.Hour,
+	}
+	if p.WorkStart != "" {
+		if participant.WorkStart, err = parseClock(p.WorkStart); err != nil {
+			return meeting.Participant{}, fmt.Errorf("invalid work_start: %w", err)
+		}
+	}
+	if p.WorkEnd != "" {
+		if participant.WorkEnd, err = parseEndClock(p.WorkEnd); err != nil {
+			return meeting.Participant{}, fmt.Errorf("invalid work_end: %w", err)
+		}
+	}
+
+	if len(p.WorkDays) == 0 {
+		for d := time.Monday; d <= time.Friday; d++ {
+			participant.WorkDays[d] = true
+		}
+	}
+	for _, name := range p.WorkDays {
+		day, err := timeutil.ParseWeekday(name)
+		if err != nil {
+			return meeting.Participant{}, err
+		}
+		participant.WorkDays[day] = true
+	}
+	return participant, nil
+}
+
+// parseClock parses an HH:MM time into an offset from midnight.
+func parseClock(s string) (time.Duration, error) {
+	hour, minute, err := timeutil.ParseTimeInput(s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
+
+// parseEndClock parses the HH:MM end of working hours, where 24:00 is the midnight
+// ending the day.
+func parseEndClock(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	return parseClock(s)
+}
+
+// meetingRange resolves the inclusive date range of a request to the instants [from, to).
+func meetingRange(input types.FindMeetingTimesInput) (time.Time, time.Time, error) {
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	loc := now.Location()
+
+	startDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if input.StartDate != "" {
+		year, month, day, err := timeutil.ParseDateInput(input.StartDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start_date: %w", err)
+		}
+		startDay = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	endDay := startDay.AddDate(0, 0, defaultMeetingDays-1)
+	if input.EndDate != "" {
+		year, month, day, err := timeutil.ParseDateInput(input.EndDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end_date: %w", err)
+		}
+		endDay = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	if endDay.Before(startDay) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end_date must not be before start_date")
+	}
+	if endDay.Sub(startDay) >= maxMeetingDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range must not exceed %d days", maxMeetingDays)
+	}
+
+	from := time.Date(startDay.Year(), startDay.Month(), startDay.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(endDay.Year(), endDay.Month(), endDay.Day()+1, 0, 0, 0, 0, loc)
+	// Never propose slots that have already started
+	if from.Before(now) {
+		from = now
+	}
+	return from, to, nil
+}
+
+func registerFindMeetingTimes(server *mcp.Server, localTZ string) {
+	findMeetingTimesSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"participants": map[string]any{
+				"type":        "array",
+				"description": "Participants with their IANA timezone and optional working hours.",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"name": map[string]any{
+							"type":        "string",
+							"description": "Optional participant name, echoed in the results.",
+						},
+						"timezone": map[string]any{
+							"type":        "string",
+							"description": "IANA timezone name of the participant (e.g., 'Europe/Berlin', 'Asia/Kolkata').",
+						},
+						"work_start": map[string]any{
+							"type":        "string",
+							"description": "Start of working hours in 24-hour format (HH:MM), default 09:00.",
+						},
+						"work_end": map[string]any{
+							"type":        "string",
+							"description": "End of working hours in 24-hour format (HH:MM), default 17:00, 24:00 being midnight. An end before the start means an overnight shift.",
+						},
+						"work_days": map[string]any{
+							"type":        "array",
+							"items":       map[string]any{"type": "string"},
+							"description": "Weekdays the participant works (e.g., ['Mon', 'Tue']), default Monday to Friday.",
+						},
+					},
+					"required": []string{"timezone"},
+				},
+			},
+			"duration_minutes": map[string]any{
+				"type":        "integer",
+				"description": "Meeting length in minutes.",
+			},
+			"start_date": map[string]any{
+				"type":        "string",
+				"description": "First day to search (YYYY-MM-DD), default today.",
+			},
+			"end_date": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("Last day to search, inclusive (YYYY-MM-DD), default %d days from start_date, at most %d days.", defaultMeetingDays-1, maxMeetingDays),
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone the dates are read in. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"step_minutes": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Granularity of candidate start times in minutes (default %d).", defaultMeetingStep),
+			},
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Maximum number of slots to return (default %d, max %d).", defaultMeetingLimit, maxMeetingLimit),
+			},
+		},
+		"required": []string{"participants", "duration_minutes"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "find_meeting_times",
+		Description: "Find meeting slots that fall within the working hours of participants in different timezones",
+		InputSchema: findMeetingTimesSchema,
+	}, FindMeetingTimes)
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestFindMeetingTimes(t *testing.T) {
+	// Pick a future Monday so that the range never starts in the past
+	day := time.Now().UTC().AddDate(0, 0, 7)
+	for day.Weekday() != time.Monday {
+		day = day.AddDate(0, 0, 1)
+	}
+	input := types.FindMeetingTimesInput{
+		Participants: []types.MeetingParticipant{
+			{Name: "Anna", Timezone: "Europe/Berlin"},
+			{Name: "Ravi", Timezone: "Asia/Kolkata", WorkStart: "10:00", WorkEnd: "19:00"},
+			{Name: "Sam", Timezone: "America/New_York", WorkStart: "07:00", WorkEnd: "15:00"},
+		},
+		DurationMinutes: 30,
+		StartDate:       day.Format("2006-01-02"),
+		EndDate:         day.AddDate(0, 0, 4).Format("2006-01-02"),
+		Limit:           5,
+	}
+	_, out, err := FindMeetingTimes(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Slots) == 0 || len(out.Slots) > 5 {
+		t.Fatalf("expected between 1 and 5 slots, got %d", len(out.Slots))
+	}
+	for _, slot := range out.Slots {
+		if len(slot.Participants) != 3 {
+			t.Fatalf("expected 3 participant renderings, got %d", len(slot.Participants))
+		}
+		if slot.Participants[1].Name != "Ravi" || slot.Participants[1].Start.Timezone != "Asia/Kolkata" {
+			t.Errorf("unexpected participant rendering: %+v", slot.Participants[1])
+		}
+		start, err := time.Parse(time.RFC3339, slot.StartUTC)
+		if err != nil {
+			t.Fatalf("start_utc not RFC3339: %v", err)
+		}
+		end, _ := time.Parse(time.RFC3339, slot.EndUTC)
+		if end.Sub(start) != 30*time.Minute {
+			t.Errorf("unexpected slot length %v", end.Sub(start))
+		}
+	}
+}
+
+func TestFindMeetingTimesWeekendWorkers(t *testing.T) {
+	input := types.FindMeetingTimesInput{
+		Participants: []types.MeetingParticipant{
+			{Timezone: "Asia/Dubai", WorkDays: []string{"Sat"}},
+		},
+		DurationMinutes: 60,
+		StartDate:       time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02"),
+		Limit:           50,
+	}
+	_, out, err := FindMeetingTimes(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Slots) == 0 {
+		t.Fatal("expected Saturday slots within a week")
+	}
+	for _, slot := range out.Slots {
+		if slot.Participants[0].Start.DayOfWeek != "Saturday" {
+			t.Errorf("slot on %s, expected Saturday only", slot.Participants[0].Start.DayOfWeek)
+		}
+	}
+}
+
+func TestFindMeetingTimesUntilMidnight(t *testing.T) {
+	input := types.FindMeetingTimesInput{
+		Participants: []types.MeetingParticipant{
+			{Timezone: "UTC", WorkStart: "22:00", WorkEnd: "24:00", WorkDays: []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}},
+		},
+		DurationMinutes: 60,
+		Limit:           50,
+	}
+	_, out, err := FindMeetingTimes(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Slots) == 0 {
+		t.Fatal("expected slots between 22:00 and midnight")
+	}
+	for _, slot := range out.Slots {
+		start, err := time.Parse(time.RFC3339, slot.Participants[0].Start.Datetime)
+		if err != nil {
+			t.Fatalf("start not RFC3339: %v", err)
+		}
+		if start.Hour() < 22 {
+			t.Errorf("slot starts at %s, before 22:00", slot.Participants[0].Start.Datetime)
+		}
+	}
+}
+
+func TestFindMeetingTimesInvalidInput(t *testing.T) {
+	valid := []types.MeetingParticipant{{Timezone: "UTC"}}
+	cases := []types.FindMeetingTimesInput{
+		{DurationMinutes: 30},
+		{Participants: valid},
+		{Participants: []types.MeetingParticipant{{Timezone: ""}}, DurationMinutes: 30},
+		{Participants: []types.MeetingParticipant{{Timezone: "Invalid/Zone"}}, DurationMinutes: 30},
+		{Participants: []types.MeetingParticipant{{Timezone: "UTC", WorkStart: "9am"}}, DurationMinutes: 30},
+		{Participants: []types.MeetingParticipant{{Timezone: "UTC", WorkStart: "24:00"}}, DurationMinutes: 30},
+		{Participants: []types.MeetingParticipant{{Timezone: "UTC", WorkEnd: "24:30"}}, DurationMinutes: 30},
+		{Participants: []types.MeetingParticipant{{Timezone: "UTC", WorkDays: []string{"Funday"}}}, DurationMinutes: 30},
+		{Participants: valid, DurationMinutes: 30, StartDate: "2026-02-30"},
+		{Participants: valid, DurationMinutes: 30, StartDate: "2026-03-10", EndDate: "2026-03-01"},
+		{Participants: valid, DurationMinutes: 30, StartDate: "2026-01-01", EndDate: "2026-06-01"},
+	}
+	for i, tc := range cases {
+		if _, _, err := FindMeetingTimes(context.Background(), nil, tc); err == nil {
+			t.Errorf("case %d: expected error, got nil", i)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// maxZones caps the number of timezones in a single multi-zone request.
+const maxZones = 50
+
+// ConvertTimeMulti implements the convert_time_multi MCP tool handler.
+// It converts one source time into several target timezones at once.
+func ConvertTimeMulti(ctx context.Context, req *mcp.CallToolRequest, input types.ConvertTimeMultiInput) (
+	*mcp.CallToolResult,
+	types.MultiConversionResult,
+	error,
+) {
+	if err := timeutil.ValidateConvertTimeMultiInput(input); err != nil {
+		return nil, types.MultiConversionResult{}, err
+	}
+
+	sourceNow, err := timezone.GetNowInLocation(input.SourceTimezone)
+	if err != nil {
+		return nil, types.MultiConversionResult{}, fmt.Errorf("invalid source timezone %q: %w%s", input.SourceTimezone, err, didYouMean(input.SourceTimezone))
+	}
+	local, err := buildSourceTime(types.ConvertTimeInput{
+		SourceTimezone: input.SourceTimezone,
+		Time:           input.Time,
+		Date:           input.Date,
+		Disambiguation: input.Disambiguation,
+	}, sourceNow)
+	if err != nil {
+		return nil, types.MultiConversionResult{}, err
+	}
+
+	targets, err := buildZoneTimes(local.Time, input.TargetTimezones)
+	if err != nil {
+		return nil, types.MultiConversionResult{}, err
+	}
+	result := types.MultiConversionResult{
+		Source:      timeutil.BuildTimeResult(local.Time, input.SourceTimezone),
+		Targets:     targets,
+		Nonexistent: local.Nonexistent,
+		Ambiguous:   local.Ambiguous,
+	}
+	for _, candidate := range local.Candidates {
+		result.Candidates = append(result.Candidates, timeutil.BuildTimeResult(candidate, input.SourceTimezone))
+	}
+	return nil, result, nil
+}
+
+// GetWorldClock implements the get_world_clock MCP tool handler.
+// It returns the current time in several timezones relative to a reference timezone.
+func GetWorldClock(ctx context.Context, req *mcp.CallToolRequest, input types.WorldClockInput) (
+	*mcp.CallToolResult,
+	types.WorldClockResult,
+	error,
+) {
+	if len(input.Timezones) == 0 {
+		return nil, types.WorldClockResult{}, fmt.Errorf("timezones is required")
+	}
+	ref := input.ReferenceTimezone
+	if ref == "" {
+		ref = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(ref)
+	if err != nil {
+		return nil, types.WorldClockResult{}, fmt.Errorf("invalid reference timezone %q: %w%s", ref, err, didYouMean(ref))
+	}
+
+	clocks, err := buildZoneTimes(now, input.Timezones)
+	if err != nil {
+		return nil, types.WorldClockResult{}, err
+	}
+	return nil, types.WorldClockResult{
+		Reference: timeutil.BuildTimeResult(now, ref),
+		Clocks:    clocks,
+	}, nil
+}
+
+// buildZoneTimes renders ref in each of tzs with its offset relative to ref's zone.
+func buildZoneTimes(ref time.Time, tzs []string) ([]types.ZoneTimeResult, error) {
+	if len(tzs) > maxZones {
+		return nil, fmt.Errorf("at most %d timezones are supported, got %d", maxZones, len(tzs))
+	}
+	_, offRef := ref.Zone()
+	results := make([]types.ZoneTimeResult, 0, len(tzs))
+	for _, tz := range tzs {
+		if tz == "" {
+			return nil, fmt.Errorf("timezone names must not be empty")
+		}
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w%s", tz, err, didYouMean(tz))
+		}
+		t := ref.In(loc)
+		_, off := t.Zone()
+		results = append(results, types.ZoneTimeResult{
+			TimeResult:     timeutil.BuildTimeResult(t, tz),
+			TimeDifference: timeutil.FormatTimeDifference(offRef, off),
+		})
+	}
+	return results, nil
+}
+
+func registerMultiZone(server *mcp.Server, localTZ string) {
+	convertTimeMultiSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"source_timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("Source IANA timezone name (e.g., 'America/New_York', 'Europe/London'). Use '%s' as local timezone if no source timezone provided by the user.", localTZ),
+			},
+			"time": map[string]any{
+				"type":        "string",
+				"description": "Time to convert in 24-hour format (HH:MM), or a full ISO 8601 datetime (e.g., '2026-03-29T14:30:00')",
+			},
+			"date": map[string]any{
+				"type":        "string",
+				"description": "Optional date (YYYY-MM-DD) the HH:MM time falls on. Defaults to today in the source timezone.",
+			},
+			"target_timezones": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": fmt.Sprintf("Target IANA timezone names (e.g., ['Asia/Tokyo', 'Europe/Paris']), at most %d.", maxZones),
+			},
+			"disambiguation": map[string]any{
+				"type":        "string",
+				"enum":        timezone.DisambiguationPolicies,
+				"description": "How to resolve a source time in a DST gap or overlap: 'compatible' (default), 'earlier', 'later', 'reject' or 'shift_forward'.",
+			},
+		},
+		"required": []string{"source_timezone", "time", "target_timezones"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "convert_time_multi",
+		Description: "Convert a time from one timezone into many target timezones at once",
+		InputSchema: convertTimeMultiSchema,
+	}, ConvertTimeMulti)
+
+	worldClockSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"timezones": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": fmt.Sprintf("IANA timezone names to show the current time for, at most %d.", maxZones),
+			},
+			"reference_timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone the offsets are relative to, default UTC. Use '%s' as local timezone when the user asks relative to their own time.", localTZ),
+			},
+		},
+		"required": []string{"timezones"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_world_clock",
+		Description: "Get the current time in several timezones at once",
+		InputSchema: worldClockSchema,
+	}, GetWorldClock)
+}
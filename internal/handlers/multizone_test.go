@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestConvertTimeMulti(t *testing.T) {
+	input := types.ConvertTimeMultiInput{
+		SourceTimezone:  "Europe/London",
+		Time:            "14:30",
+		Date:            "2026-03-29",
+		TargetTimezones: []string{"America/New_York", "Asia/Kathmandu", "Europe/London"},
+	}
+	_, out, err := ConvertTimeMulti(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Source.Datetime != "2026-03-29T14:30:00+01:00" {
+		t.Errorf("unexpected source datetime: %s", out.Source.Datetime)
+	}
+	want := []struct {
+		tz       string
+		datetime string
+		diff     string
+	}{
+		{"America/New_York", "2026-03-29T09:30:00-04:00", "-5.0h"},
+		{"Asia/Kathmandu", "2026-03-29T19:15:00+05:45", "+4.75h"},
+		{"Europe/London", "2026-03-29T14:30:00+01:00", "+0.0h"},
+	}
+	if len(out.Targets) != len(want) {
+		t.Fatalf("expected %d targets, got %d", len(want), len(out.Targets))
+	}
+	for i, w := range want {
+		got := out.Targets[i]
+		if got.Timezone != w.tz || got.Datetime != w.datetime || got.TimeDifference != w.diff {
+			t.Errorf("target %d = %+v, want %s %s %s", i, got, w.tz, w.datetime, w.diff)
+		}
+	}
+}
+
+func TestConvertTimeMultiAmbiguous(t *testing.T) {
+	input := types.ConvertTimeMultiInput{
+		SourceTimezone:  "Europe/Paris",
+		Time:            "2026-10-25T02:30:00",
+		TargetTimezones: []string{"UTC"},
+	}
+	_, out, err := ConvertTimeMulti(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.Ambiguous || len(out.Candidates) != 2 {
+		t.Errorf("expected ambiguous source with 2 candidates, got %+v", out)
+	}
+}
+
+func TestConvertTimeMultiInvalidInput(t *testing.T) {
+	cases := []types.ConvertTimeMultiInput{
+		{Time: "12:00", TargetTimezones: []string{"UTC"}},
+		{SourceTimezone: "UTC", TargetTimezones: []string{"UTC"}},
+		{SourceTimezone: "UTC", Time: "12:00"},
+		{SourceTimezone: "Invalid/Zone", Time: "12:00", TargetTimezones: []string{"UTC"}},
+		{SourceTimezone: "UTC", Time: "noon", TargetTimezones: []string{"UTC"}},
+		{SourceTimezone: "UTC", Time: "12:00", TargetTimezones: []string{"UTC", "Invalid/Zone"}},
+		{SourceTimezone: "UTC", Time: "12:00", TargetTimezones: []string{""}},
+	}
+	for i, tc := range cases {
+		if _, _, err := ConvertTimeMulti(context.Background(), nil, tc); err == nil {
+			t.Errorf("case %d: expected error, got nil", i)
+		}
+	}
+}
+
+func TestGetWorldClock(t *testing.T) {
+	input := types.WorldClockInput{
+		Timezones:         []string{"Asia/Tokyo", "Asia/Kolkata"},
+		ReferenceTimezone: "Asia/Tokyo",
+	}
+	_, out, err := GetWorldClock(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Reference.Timezone != "Asia/Tokyo" {
+		t.Errorf("unexpected reference: %+v", out.Reference)
+	}
+	if len(out.Clocks) != 2 {
+		t.Fatalf("expected 2 clocks, got %d", len(out.Clocks))
+	}
+	if out.Clocks[0].TimeDifference != "+0.0h" || out.Clocks[1].TimeDifference != "-3.5h" {
+		t.Errorf("unexpected differences: %s, %s", out.Clocks[0].TimeDifference, out.Clocks[1].TimeDifference)
+	}
+	ref, _ := time.Parse(time.RFC3339, out.Reference.Datetime)
+	other, err := time.Parse(time.RFC3339, out.Clocks[1].Datetime)
+	if err != nil {
+		t.Fatalf("datetime not RFC3339: %v", err)
+	}
+	if !ref.Equal(other) {
+		t.Errorf("clocks should show the same instant: %s vs %s", out.Reference.Datetime, out.Clocks[1].Datetime)
+	}
+}
+
+func TestGetWorldClockInvalidInput(t *testing.T) {
+	if _, _, err := GetWorldClock(context.Background(), nil, types.WorldClockInput{}); err == nil {
+		t.Error("expected error without timezones")
+	}
+	_, _, err := GetWorldClock(context.Background(), nil, types.WorldClockInput{Timezones: []string{"Asia/Tokio"}})
+	if err == nil || !strings.Contains(err.Error(), "Asia/Tokyo") {
+		t.Errorf("expected did you mean hint, got %v", err)
+	}
+	tooMany := make([]string, maxZones+1)
+	for i := range tooMany {
+		tooMany[i] = "UTC"
+	}
+	if _, _, err := GetWorldClock(context.Background(), nil, types.WorldClockInput{Timezones: tooMany}); err == nil {
+		t.Error("expected error for too many timezones")
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/naturaltime"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// ParseDatetime implements the parse_datetime MCP tool handler.
+// It resolves a natural-language phrase such as "next Tuesday at 3pm Paris time" against now.
+func ParseDatetime(ctx context.Context, req *mcp.CallToolRequest, input types.ParseDatetimeInput) (
+	*mcp.CallToolResult,
+	types.ParseDatetimeResult,
+	error,
+) {
+	if strings.TrimSpace(input.Text) == "" {
+		return nil, types.ParseDatetimeResult{}, fmt.Errorf("text is required")
+	}
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return nil, types.ParseDatetimeResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	lang := strings.ToLower(input.Language)
+	if lang == "" {
+		lang = naturaltime.DefaultLanguage
+	}
+
+	res, err := naturaltime.Parse(input.Text, lang, now)
+	if err != nil {
+		return nil, types.ParseDatetimeResult{}, err
+	}
+	result := types.ParseDatetimeResult{
+		Text:           input.Text,
+		Language:       lang,
+		Result:         timeutil.BuildTimeResult(res.Time, res.Time.Location().String()),
+		Reference:      timeutil.BuildTimeResult(res.Time.In(now.Location()), tz),
+		Granularity:    res.Granularity,
+		Interpretation: res.Interpretation,
+		Nonexistent:    res.Nonexistent,
+		Ambiguous:      res.Ambiguous,
+	}
+	for _, candidate := range res.Candidates {
+		result.Candidates = append(result.Candidates, timeutil.BuildTimeResult(candidate, res.Time.Location().String()))
+	}
+	return nil, result, nil
+}
+
+func registerParseDatetime(server *mcp.Server, localTZ string) {
+	parseDatetimeSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"text": map[string]any{
+				"type":        "string",
+				"description": "Date or time phrase to resolve, e.g. 'next Tuesday at 3pm Paris time', 'tomorrow morning', 'in two weeks', 'end of day Friday' or an ISO 8601 datetime.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone relative phrases are resolved in, unless the phrase names its own timezone. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"language": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("ISO 639-1 code of the phrase's language. Supported: %s (default %s).", strings.Join(naturaltime.Languages(), ", "), naturaltime.DefaultLanguage),
+			},
+		},
+		"required": []string{"text", "timezone"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "parse_datetime",
+		Description: "Resolve a natural-language date or time phrase into an exact time, with the interpretation chosen",
+		InputSchema: parseDatetimeSchema,
+	}, ParseDatetime)
+}
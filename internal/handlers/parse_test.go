@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestParseDatetime(t *testing.T) {
+	_, out, err := ParseDatetime(context.Background(), nil, types.ParseDatetimeInput{
+		Text:     "tomorrow at 3pm Tokyo time",
+		Timezone: "America/New_York",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Language != "en" || out.Granularity != "minute" {
+		t.Errorf("unexpected language or granularity: %+v", out)
+	}
+	if out.Result.Timezone != "Asia/Tokyo" || out.Reference.Timezone != "America/New_York" {
+		t.Errorf("unexpected timezones: %s, %s", out.Result.Timezone, out.Reference.Timezone)
+	}
+	result, err := time.Parse(time.RFC3339, out.Result.Datetime)
+	if err != nil {
+		t.Fatalf("result not RFC3339: %v", err)
+	}
+	if result.Hour() != 15 || result.Minute() != 0 {
+		t.Errorf("expected 15:00 in Tokyo, got %s", out.Result.Datetime)
+	}
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	if want := time.Now().In(tokyo).AddDate(0, 0, 1).Format("2006-01-02"); result.Format("2006-01-02") != want {
+		t.Errorf("expected tomorrow %s in Tokyo, got %s", want, out.Result.Datetime)
+	}
+	reference, _ := time.Parse(time.RFC3339, out.Reference.Datetime)
+	if !reference.Equal(result) {
+		t.Errorf("reference %s is not the same instant as %s", out.Reference.Datetime, out.Result.Datetime)
+	}
+	if !strings.Contains(out.Interpretation, "Asia/Tokyo") {
+		t.Errorf("unexpected interpretation: %s", out.Interpretation)
+	}
+}
+
+func TestParseDatetimeDST(t *testing.T) {
+	tests := []struct {
+		text        string
+		want        string
+		nonexistent bool
+		candidates  int
+	}{
+		{"2027-03-14T02:30:00", "2027-03-14T03:30:00-04:00", true, 0},
+		{"2026-11-01T01:30:00", "2026-11-01T01:30:00-04:00", false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			_, out, err := ParseDatetime(context.Background(), nil, types.ParseDatetimeInput{
+				Text:     tt.text,
+				Timezone: "America/New_York",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Result.Datetime != tt.want {
+				t.Errorf("result = %s, want %s", out.Result.Datetime, tt.want)
+			}
+			if out.Nonexistent != tt.nonexistent || out.Ambiguous != (tt.candidates > 0) {
+				t.Errorf("unexpected flags: nonexistent %v, ambiguous %v", out.Nonexistent, out.Ambiguous)
+			}
+			if len(out.Candidates) != tt.candidates {
+				t.Fatalf("expected %d candidates, got %d", tt.candidates, len(out.Candidates))
+			}
+			if tt.candidates > 0 && out.Candidates[1].Datetime != "2026-11-01T01:30:00-05:00" {
+				t.Errorf("unexpected later candidate: %s", out.Candidates[1].Datetime)
+			}
+		})
+	}
+}
+
+func TestParseDatetimeInvalidInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  types.ParseDatetimeInput
+		errMsg string
+	}{
+		{"missing text", types.ParseDatetimeInput{Timezone: "UTC"}, "text is required"},
+		{"invalid timezone", types.ParseDatetimeInput{Text: "tomorrow", Timezone: "Europe/Pariss"}, "did you mean"},
+		{"unsupported language", types.ParseDatetimeInput{Text: "demain", Language: "fr"}, "unsupported language"},
+		{"not a date", types.ParseDatetimeInput{Text: "purple elephants"}, "could not understand"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParseDatetime(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error = %v, want to contain %q", err, tt.errMsg)
+			}
+		})
+	}
+}
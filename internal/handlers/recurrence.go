@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/recurrence"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+const (
+	defaultOccurrences = 10
+	maxOccurrences     = 500
+)
+
+// ExpandRecurrence implements the expand_recurrence MCP tool handler.
+// It expands an RFC 5545 recurrence set on the wall clock of the DTSTART timezone.
+func ExpandRecurrence(ctx context.Context, req *mcp.CallToolRequest, input types.ExpandRecurrenceInput) (
+	*mcp.CallToolResult,
+	types.ExpandRecurrenceResult,
+	error,
+) {
+	if input.RRule == "" {
+		return nil, types.ExpandRecurrenceResult{}, fmt.Errorf("rrule is required")
+	}
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	now, err := timezone.GetNowInLocation(tz)
+	if err != nil {
+		return nil, types.ExpandRecurrenceResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	loc := now.Location()
+
+	set, err := recurrence.ParseSet(input.RRule, loc)
+	if err != nil {
+		return nil, types.ExpandRecurrenceResult{}, err
+	}
+	if input.Dtstart != "" {
+		if set.Start, _, err = recurrence.ParseDateTime(input.Dtstart, loc); err != nil {
+			return nil, types.ExpandRecurrenceResult{}, fmt.Errorf("invalid dtstart: %w", err)
+		}
+	}
+	if set.Start.IsZero() {
+		return nil, types.ExpandRecurrenceResult{}, fmt.Errorf("dtstart is required when rrule has no DTSTART line")
+	}
+	if err := set.AddDates(input.RDate, input.ExDate); err != nil {
+		return nil, types.ExpandRecurrenceResult{}, err
+	}
+
+	var after, before time.Time
+	if input.After != "" {
+		if after, _, err = recurrence.ParseDateTime(input.After, set.Start.Location()); err != nil {
+			return nil, types.ExpandRecurrenceResult{}, fmt.Errorf("invalid after: %w", err)
+		}
+	}
+	if input.Before != "" {
+		if before, _, err = recurrence.ParseDateTime(input.Before, set.Start.Location()); err != nil {
+			return nil, types.ExpandRecurrenceResult{}, fmt.Errorf("invalid before: %w", err)
+		}
+	}
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultOccurrences
+	}
+	limit = min(limit, maxOccurrences)
+
+	occurrences, truncated := set.Occurrences(after, before, limit)
+	tz = set.Start.Location().String()
+	result := types.ExpandRecurrenceResult{
+		Timezone:    tz,
+		Start:       timeutil.BuildTimeResult(set.Start, tz),
+		Rules:       []string{},
+		Occurrences: []types.TimeResult{},
+		Truncated:   truncated,
+	}
+	for _, r := range set.Rules {
+		result.Rules = append(result.Rules, r.String())
+	}
+	for _, t := range occurrences {
+		result.Occurrences = append(result.Occurrences, timeutil.BuildTimeResult(t, tz))
+	}
+	return nil, result, nil
+}
+
+func registerExpandRecurrence(server *mcp.Server, localTZ string) {
+	expandRecurrenceSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"rrule": map[string]any{
+				"type":        "string",
+				"description": "RFC 5545 RRULE value (e.g., 'FREQ=MONTHLY;BYDAY=-1FR;COUNT=6'), or a block of DTSTART, RRULE, RDATE and EXDATE lines as found in an iCalendar event.",
+			},
+			"dtstart": map[string]any{
+				"type":        "string",
+				"description": "First occurrence, as an ISO 8601 datetime (e.g., '2026-01-30T10:00:00') or iCalendar DATE-TIME ('20260130T100000'). Required unless rrule has a DTSTART line.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone (TZID) of dtstart; the rule is evaluated on its wall clock. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+			"rdate": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "Extra occurrences (RDATE). Dates without a time take the time of dtstart.",
+			},
+			"exdate": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "Excluded occurrences (EXDATE). Dates without a time take the time of dtstart.",
+			},
+			"after": map[string]any{
+				"type":        "string",
+				"description": "Only return occurrences at or after this ISO 8601 datetime.",
+			},
+			"before": map[string]any{
+				"type":        "string",
+				"description": "Only return occurrences before this ISO 8601 datetime.",
+			},
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Maximum number of occurrences to return (default %d, max %d).", defaultOccurrences, maxOccurrences),
+			},
+		},
+		"required": []string{"rrule"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "expand_recurrence",
+		Description: "Expand an RFC 5545 recurrence rule (RRULE, RDATE, EXDATE) into occurrences, evaluated in the DTSTART timezone across DST changes",
+		InputSchema: expandRecurrenceSchema,
+	}, ExpandRecurrence)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestExpandRecurrence(t *testing.T) {
+	_, out, err := ExpandRecurrence(context.Background(), nil, types.ExpandRecurrenceInput{
+		RRule:    "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6",
+		Dtstart:  "2026-01-30T10:00:00",
+		Timezone: "Europe/Paris",
+		ExDate:   []string{"2026-02-27"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"2026-01-30T10:00:00+01:00", "2026-03-27T10:00:00+01:00", "2026-04-24T10:00:00+02:00", "2026-05-29T10:00:00+02:00", "2026-06-26T10:00:00+02:00"}
+	if len(out.Occurrences) != len(want) {
+		t.Fatalf("expected %d occurrences, got %+v", len(want), out.Occurrences)
+	}
+	for i, w := range want {
+		if out.Occurrences[i].Datetime != w || out.Occurrences[i].Timezone != "Europe/Paris" {
+			t.Errorf("occurrence %d = %+v, want %s", i, out.Occurrences[i], w)
+		}
+	}
+	if !out.Occurrences[2].IsDst || out.Truncated {
+		t.Errorf("unexpected DST flag or truncation: %+v", out)
+	}
+	if len(out.Rules) != 1 || out.Rules[0] != "FREQ=MONTHLY;COUNT=6;BYDAY=-1FR" {
+		t.Errorf("unexpected rules: %v", out.Rules)
+	}
+}
+
+func TestExpandRecurrenceBlockAndCap(t *testing.T) {
+	_, out, err := ExpandRecurrence(context.Background(), nil, types.ExpandRecurrenceInput{
+		RRule: "DTSTART;TZID=America/New_York:20260101T090000\nRRULE:FREQ=DAILY",
+		After: "2026-03-07T00:00:00",
+		Limit: 3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"2026-03-07T09:00:00-05:00", "2026-03-08T09:00:00-04:00", "2026-03-09T09:00:00-04:00"}
+	if len(out.Occurrences) != 3 || !out.Truncated || out.Timezone != "America/New_York" {
+		t.Fatalf("unexpected result: %+v", out)
+	}
+	for i, w := range want {
+		if out.Occurrences[i].Datetime != w {
+			t.Errorf("occurrence %d = %s, want %s", i, out.Occurrences[i].Datetime, w)
+		}
+	}
+
+	_, out, err = ExpandRecurrence(context.Background(), nil, types.ExpandRecurrenceInput{
+		RRule: "FREQ=MINUTELY", Dtstart: "2026-01-01T00:00:00", Limit: 100000,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Occurrences) != maxOccurrences || !out.Truncated {
+		t.Errorf("expected the result to be capped at %d, got %d", maxOccurrences, len(out.Occurrences))
+	}
+}
+
+func TestExpandRecurrenceErrors(t *testing.T) {
+	tests := []types.ExpandRecurrenceInput{
+		{},
+		{RRule: "FREQ=DAILY"},
+		{RRule: "FREQ=DAILY", Dtstart: "2026-01-01T09:00:00", Timezone: "Mars/Olympus"},
+		{RRule: "FREQ=FORTNIGHTLY", Dtstart: "2026-01-01T09:00:00"},
+		{RRule: "FREQ=DAILY", Dtstart: "yesterday"},
+		{RRule: "FREQ=DAILY", Dtstart: "2026-01-01T09:00:00", ExDate: []string{"soon"}},
+		{RRule: "FREQ=DAILY", Dtstart: "2026-01-01T09:00:00", Before: "later"},
+	}
+	for _, input := range tests {
+		if _, _, err := ExpandRecurrence(context.Background(), nil, input); err == nil {
+			t.Errorf("expected an error for %+v", input)
+		}
+	}
+}
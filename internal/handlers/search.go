@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/types"
+	"github.com/r0mdau/mcp-time/internal/zones"
+)
+
+const (
+	defaultSearchLimit = 5
+	maxSearchLimit     = 25
+	// suggestionCount is the number of "did you mean" hints added to timezone errors
+	suggestionCount = 3
+)
+
+// SearchTimezones implements the search_timezones MCP tool handler.
+// It resolves city names, countries, abbreviations and misspellings to ranked IANA timezones.
+func SearchTimezones(ctx context.Context, req *mcp.CallToolRequest, input types.SearchTimezonesInput) (
+	*mcp.CallToolResult,
+	types.SearchTimezonesResult,
+	error,
+) {
+	if strings.TrimSpace(input.Query) == "" {
+		return nil, types.SearchTimezonesResult{}, fmt.Errorf("query is required")
+	}
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	result := types.SearchTimezonesResult{Query: input.Query, Candidates: []types.TimezoneCandidate{}}
+	for _, c := range zones.Search(input.Query, limit) {
+		candidate := types.TimezoneCandidate{
+			Timezone: c.Zone,
+			Score:    c.Score,
+			Match:    c.Match,
+			Matched:  c.Matched,
+		}
+		for _, code := range zones.Countries(c.Zone) {
+			if name, ok := zones.CountryName(code); ok {
+				candidate.Countries = append(candidate.Countries, name)
+			}
+		}
+		result.Candidates = append(result.Candidates, candidate)
+	}
+	return nil, result, nil
+}
+
+// didYouMean returns a "did you mean" hint listing timezones close to tz, or "" when none are.
+func didYouMean(tz string) string {
+	suggestions := zones.Suggest(tz, suggestionCount)
+	if len(suggestions) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf(" (did you mean %s?)", strings.Join(quoted, ", "))
+}
+
+func registerSearchTimezones(server *mcp.Server) {
+	searchTimezonesSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"query": map[string]any{
+				"type":        "string",
+				"description": "Free-form place or zone to resolve: a city ('New York', 'Tokyo time'), country name or ISO code ('Germany', 'JP'), abbreviation ('PST', 'CET') or misspelled IANA name ('Europe/Londn').",
+			},
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Maximum number of candidates to return (default %d, max %d).", defaultSearchLimit, maxSearchLimit),
+			},
+		},
+		"required": []string{"query"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "search_timezones",
+		Description: "Resolve city names, countries, abbreviations or misspellings to ranked IANA timezone names",
+		InputSchema: searchTimezonesSchema,
+	}, SearchTimezones)
+}
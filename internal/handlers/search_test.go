@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestSearchTimezones(t *testing.T) {
+	_, out, err := SearchTimezones(context.Background(), nil, types.SearchTimezonesInput{Query: "Tokyo time"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Candidates) == 0 {
+		t.Fatal("expected candidates")
+	}
+	best := out.Candidates[0]
+	if best.Timezone != "Asia/Tokyo" {
+		t.Errorf("expected Asia/Tokyo, got %s", best.Timezone)
+	}
+	if len(best.Countries) == 0 || best.Countries[0] != "Japan" {
+		t.Errorf("expected country Japan, got %v", best.Countries)
+	}
+}
+
+func TestSearchTimezonesLimit(t *testing.T) {
+	_, out, err := SearchTimezones(context.Background(), nil, types.SearchTimezonesInput{Query: "US", Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Candidates) != 2 {
+		t.Errorf("expected 2 candidates, got %d", len(out.Candidates))
+	}
+}
+
+func TestSearchTimezonesNoMatch(t *testing.T) {
+	_, out, err := SearchTimezones(context.Background(), nil, types.SearchTimezonesInput{Query: "xyzzy"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Candidates == nil || len(out.Candidates) != 0 {
+		t.Errorf("expected an empty candidate list, got %#v", out.Candidates)
+	}
+}
+
+func TestSearchTimezonesEmptyQuery(t *testing.T) {
+	if _, _, err := SearchTimezones(context.Background(), nil, types.SearchTimezonesInput{Query: " "}); err == nil {
+		t.Error("expected error for empty query")
+	}
+}
+
+func TestInvalidTimezoneDidYouMean(t *testing.T) {
+	_, _, err := GetCurrentTime(context.Background(), nil, types.GetCurrentTimeInput{Timezone: "Europe/Londn"})
+	if err == nil || !strings.Contains(err.Error(), `did you mean "Europe/London"`) {
+		t.Errorf("expected did you mean hint, got %v", err)
+	}
+
+	input := types.ConvertTimeInput{SourceTimezone: "UTC", Time: "12:00", TargetTimezone: "New York"}
+	_, _, err = ConvertTime(context.Background(), nil, input)
+	if err == nil || !strings.Contains(err.Error(), `did you mean "America/New_York"`) {
+		t.Errorf("expected did you mean hint, got %v", err)
+	}
+
+	_, _, err = GetCurrentTime(context.Background(), nil, types.GetCurrentTimeInput{Timezone: "xyzzy"})
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("expected plain error without hint, got %v", err)
+	}
+}
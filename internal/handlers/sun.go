@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/solar"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// GetSunTimes implements the get_sun_times MCP tool handler.
+// It returns sunrise, sunset, the three twilights and solar noon on a date at a place,
+// computed offline, in the requested timezone.
+func GetSunTimes(ctx context.Context, req *mcp.CallToolRequest, input types.SunTimesInput) (
+	*mcp.CallToolResult,
+	types.SunTimesResult,
+	error,
+) {
+	if input.Latitude < -90 || input.Latitude > 90 {
+		return nil, types.SunTimesResult{}, fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if input.Longitude < -180 || input.Longitude > 180 {
+		return nil, types.SunTimesResult{}, fmt.Errorf("longitude must be between -180 and 180")
+	}
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, types.SunTimesResult{}, fmt.Errorf("invalid timezone: %w%s", err, didYouMean(tz))
+	}
+	date, err := businessDate(input.Date, tz)
+	if err != nil {
+		return nil, types.SunTimesResult{}, fmt.Errorf("invalid date: %w", err)
+	}
+	day := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, loc)
+	lat, lon := input.Latitude, input.Longitude
+
+	result := types.SunTimesResult{
+		Date:          date.Format(time.DateOnly),
+		Latitude:      lat,
+		Longitude:     lon,
+		Timezone:      tz,
+		NoonElevation: math.Round(solar.NoonElevation(day, lat, lon)*100) / 100,
+	}
+	timeResult := func(t time.Time) *types.TimeResult {
+		if t.IsZero() {
+			return nil
+		}
+		r := timeutil.BuildTimeResult(t, tz)
+		return &r
+	}
+	result.SolarNoon = timeResult(solar.Noon(day, lon))
+
+	for _, h := range []struct {
+		horizon    solar.Horizon
+		name       string
+		dawn, dusk **types.TimeResult
+	}{
+		{solar.Sunrise, "sunrise", &result.Sunrise, &result.Sunset},
+		{solar.Civil, "civil twilight", &result.CivilDawn, &result.CivilDusk},
+		{solar.Nautical, "nautical twilight", &result.NauticalDawn, &result.NauticalDusk},
+		{solar.Astronomical, "astronomical twilight", &result.AstronomicalDawn, &result.AstronomicalDusk},
+	} {
+		dawn, dusk, state := solar.Crossing(day, lat, lon, h.horizon)
+		*h.dawn, *h.dusk = timeResult(dawn), timeResult(dusk)
+		switch {
+		case state == solar.AlwaysAbove && h.horizon == solar.Sunrise:
+			result.Polar = "polar_day"
+			result.Notes = append(result.Notes, "polar day: the Sun stays above the horizon all day")
+		case state == solar.AlwaysBelow && h.horizon == solar.Sunrise:
+			result.Polar = "polar_night"
+			result.Notes = append(result.Notes, "polar night: the Sun stays below the horizon all day")
+		// On a polar day the twilights are missing too, which goes without saying
+		case state == solar.AlwaysAbove && result.Polar == "":
+			result.Notes = append(result.Notes, fmt.Sprintf("no %s: the Sun never sinks %g° below the horizon", h.name, float64(h.horizon)-90))
+		case state == solar.AlwaysBelow:
+			result.Notes = append(result.Notes, fmt.Sprintf("no %s: the Sun never rises to %g° below the horizon", h.name, float64(h.horizon)-90))
+		}
+	}
+
+	length := solar.DayLength(day, lat, lon).Round(time.Minute)
+	result.DayLength = duration.Duration{Clock: length}.Humanize()
+	result.DayLengthSeconds = int64(length / time.Second)
+	return nil, result, nil
+}
+
+func registerSunTimes(server *mcp.Server, localTZ string) {
+	sunTimesSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"latitude": map[string]any{
+				"type":        "number",
+				"description": "Latitude in decimal degrees, north positive (e.g., 51.5074 for London)",
+			},
+			"longitude": map[string]any{
+				"type":        "number",
+				"description": "Longitude in decimal degrees, east positive (e.g., -0.1278 for London)",
+			},
+			"date": map[string]any{
+				"type":        "string",
+				"description": "Date in YYYY-MM-DD. Defaults to today in the timezone.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone to report the times in. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+		},
+		"required": []string{"latitude", "longitude", "timezone"},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_sun_times",
+		Description: "Get sunrise, sunset, civil, nautical and astronomical twilight, solar noon and day length at a latitude and longitude on a date, computed offline with the NOAA solar algorithm. Reports polar day and polar night",
+		InputSchema: sunTimesSchema,
+	}, GetSunTimes)
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestGetSunTimes(t *testing.T) {
+	_, out, err := GetSunTimes(context.Background(), nil, types.SunTimesInput{Latitude: 51.5074, Longitude: -0.1278, Date: "2026-06-21", Timezone: "Europe/London"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Sunrise == nil || out.Sunrise.Datetime != "2026-06-21T04:43:00+01:00" || !out.Sunrise.IsDst {
+		t.Errorf("unexpected sunrise: %+v", out.Sunrise)
+	}
+	if out.Sunset == nil || out.Sunset.Datetime != "2026-06-21T21:22:00+01:00" {
+		t.Errorf("unexpected sunset: %+v", out.Sunset)
+	}
+	if out.SolarNoon == nil || out.SolarNoon.Datetime != "2026-06-21T13:02:00+01:00" {
+		t.Errorf("unexpected solar noon: %+v", out.SolarNoon)
+	}
+	if out.CivilDusk == nil || out.NauticalDawn == nil || out.AstronomicalDawn != nil || out.AstronomicalDusk != nil {
+		t.Errorf("unexpected twilights: %+v", out)
+	}
+	if out.DayLength != "16 hours and 38 minutes" || out.DayLengthSeconds != 16*3600+38*60 || out.NoonElevation != 61.93 || out.Polar != "" {
+		t.Errorf("unexpected result: %+v", out)
+	}
+	if len(out.Notes) != 1 || !strings.Contains(out.Notes[0], "no astronomical twilight") {
+		t.Errorf("unexpected notes: %v", out.Notes)
+	}
+}
+
+func TestGetSunTimesPolar(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      string
+		polar     string
+		length    int64
+		civilDawn string
+		notes     int
+	}{
+		{"polar day", "2026-06-21", "polar_day", 86400, "", 1},
+		{"polar night", "2026-12-21", "polar_night", 0, "2026-12-21T09:31:00+01:00", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := GetSunTimes(context.Background(), nil, types.SunTimesInput{Latitude: 69.6492, Longitude: 18.9553, Date: tt.date, Timezone: "Europe/Oslo"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Polar != tt.polar || out.DayLengthSeconds != tt.length || out.Sunrise != nil || out.Sunset != nil || out.SolarNoon == nil {
+				t.Errorf("unexpected result: %+v", out)
+			}
+			civilDawn := ""
+			if out.CivilDawn != nil {
+				civilDawn = out.CivilDawn.Datetime
+			}
+			if civilDawn != tt.civilDawn || len(out.Notes) != tt.notes {
+				t.Errorf("civil dawn = %q, notes = %v", civilDawn, out.Notes)
+			}
+		})
+	}
+}
+
+func TestGetSunTimesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.SunTimesInput
+		want  string
+	}{
+		{"latitude", types.SunTimesInput{Latitude: 91, Timezone: "UTC"}, "latitude must be between"},
+		{"longitude", types.SunTimesInput{Longitude: -181, Timezone: "UTC"}, "longitude must be between"},
+		{"timezone", types.SunTimesInput{Timezone: "Europe/Londn"}, "invalid timezone"},
+		{"date", types.SunTimesInput{Date: "2026-13-01", Timezone: "UTC"}, "invalid date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := GetSunTimes(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/r0mdau/mcp-time/internal/timescale"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+// ConvertTimeScale implements the convert_time_scale MCP tool handler.
+// It converts an instant between UTC and the TAI, GPS, TT and Loran-C time scales using
+// the leap second table.
+func ConvertTimeScale(ctx context.Context, req *mcp.CallToolRequest, input types.ConvertTimeScaleInput) (
+	*mcp.CallToolResult,
+	types.TimeScaleResult,
+	error,
+) {
+	scale, err := timescale.ParseScale(input.Scale)
+	if err != nil {
+		return nil, types.TimeScaleResult{}, err
+	}
+	useGPSWeek := input.GPSWeek != nil || input.GPSSecondsOfWeek != nil
+	if useGPSWeek && (input.Time != "" || scale != timescale.UTC && scale != timescale.GPS) {
+		return nil, types.TimeScaleResult{}, fmt.Errorf("gps_week and gps_seconds_of_week replace time and imply the GPS scale")
+	}
+	tz, now, err := resolveInstant("", input.Timezone)
+	if err != nil {
+		return nil, types.TimeScaleResult{}, fmt.Errorf("invalid timezone: %w", err)
+	}
+	table := timescale.Default()
+
+	// Everything is converted through TAI
+	var tai time.Time
+	switch {
+	case useGPSWeek:
+		var week int
+		var seconds float64
+		if input.GPSWeek != nil {
+			week = *input.GPSWeek
+		}
+		if input.GPSSecondsOfWeek != nil {
+			seconds = *input.GPSSecondsOfWeek
+		}
+		if week < 0 || week > timescale.MaxGPSWeek {
+			return nil, types.TimeScaleResult{}, fmt.Errorf("gps_week must be between 0 and %d", timescale.MaxGPSWeek)
+		}
+		// Out-of-range seconds would silently roll into another week
+		if seconds < 0 || seconds >= 604800 {
+			return nil, types.TimeScaleResult{}, fmt.Errorf("gps_seconds_of_week must be at least 0 and less than 604800")
+		}
+		tai, err = table.ToTAI(timescale.FromGPSWeek(week, seconds), false, timescale.GPS)
+	case input.Time == "":
+		tai, err = table.ToTAI(now, false, timescale.UTC)
+	case scale == timescale.UTC && !strings.Contains(input.Time, ":60"):
+		// UTC readings may carry an offset or be local to the timezone
+		var t time.Time
+		if _, t, err = resolveInstant(input.Time, tz); err != nil {
+			return nil, types.TimeScaleResult{}, fmt.Errorf("invalid time: %w", err)
+		}
+		tai, err = table.ToTAI(t, false, timescale.UTC)
+	default:
+		label, leap, perr := timescale.ParseLabel(input.Time)
+		if perr != nil {
+			return nil, types.TimeScaleResult{}, perr
+		}
+		tai, err = table.ToTAI(label, leap, scale)
+	}
+	if err != nil {
+		return nil, types.TimeScaleResult{}, err
+	}
+
+	utc, leap, err := table.FromTAI(tai, timescale.UTC)
+	if err != nil {
+		return nil, types.TimeScaleResult{}, err
+	}
+	offset, _ := table.Offset(utc)
+	result := types.TimeScaleResult{
+		Time:             timeutil.BuildTimeResult(utc.In(now.Location()), tz),
+		Scales:           []types.TimeResult{},
+		TAIMinusUTC:      offset,
+		LeapSecond:       leap,
+		LeapTableExpires: table.Expires.Format(time.DateOnly),
+		LeapTableExpired: table.Expired(utc),
+	}
+	for _, s := range timescale.Scales {
+		label, labelLeap, _ := table.FromTAI(tai, s)
+		result.Scales = append(result.Scales, types.TimeResult{
+			Timezone:  string(s),
+			Datetime:  timescale.FormatLabel(label, labelLeap),
+			DayOfWeek: label.Weekday().String(),
+		})
+		if s == timescale.GPS {
+			result.GPSWeek, result.GPSSecondsOfWeek = timescale.GPSWeek(label)
+		}
+	}
+	return nil, result, nil
+}
+
+func registerConvertTimeScale(server *mcp.Server, localTZ string) {
+	convertTimeScaleSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"time": map[string]any{
+				"type":        "string",
+				"description": "Reading on the given scale as an ISO 8601 datetime without offset (e.g., '2024-06-10T16:00:37'); UTC readings may carry an offset or a leap second ('2016-12-31T23:59:60'). Defaults to now.",
+			},
+			"scale": map[string]any{
+				"type":        "string",
+				"enum":        timescale.Scales,
+				"description": "Time scale of time: 'UTC' (default), 'TAI' (atomic time), 'GPS' (TAI - 19s), 'TT' (Terrestrial Time, TAI + 32.184s) or 'LORAN' (Loran-C, TAI - 10s).",
+			},
+			"gps_week": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("GPS week number since 1980-01-06, from 0 to %d, given with gps_seconds_of_week instead of time.", timescale.MaxGPSWeek),
+			},
+			"gps_seconds_of_week": map[string]any{
+				"type":        "number",
+				"description": "GPS time of week (TOW) in seconds, from 0 up to 604800 excluded, given with gps_week instead of time.",
+			},
+			"timezone": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("IANA timezone naive UTC readings are read in and the result time is shown in. Use '%s' as local timezone if no timezone provided by the user.", localTZ),
+			},
+		},
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "convert_time_scale",
+		Description: "Convert an instant between UTC, TAI, GPS (including week number and time of week), TT and Loran-C, accounting for leap seconds",
+		InputSchema: convertTimeScaleSchema,
+	}, ConvertTimeScale)
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r0mdau/mcp-time/internal/types"
+)
+
+func TestConvertTimeScale(t *testing.T) {
+	week, tow := 2318, 144018.5
+	tests := []struct {
+		name  string
+		input types.ConvertTimeScaleInput
+		time  string
+		// scales lists the UTC, TAI, GPS, TT and LORAN readings
+		scales []string
+		leap   bool
+	}{
+		{
+			name:   "UTC in a timezone",
+			input:  types.ConvertTimeScaleInput{Time: "2024-06-10T18:00:00.5", Timezone: "Europe/Paris"},
+			time:   "2024-06-10T18:00:00+02:00",
+			scales: []string{"2024-06-10T16:00:00.5", "2024-06-10T16:00:37.5", "2024-06-10T16:00:18.5", "2024-06-10T16:01:09.684", "2024-06-10T16:00:27.5"},
+		},
+		{
+			name:   "GPS week and time of week",
+			input:  types.ConvertTimeScaleInput{GPSWeek: &week, GPSSecondsOfWeek: &tow},
+			time:   "2024-06-10T16:00:00+00:00",
+			scales: []string{"2024-06-10T16:00:00.5", "2024-06-10T16:00:37.5", "2024-06-10T16:00:18.5", "2024-06-10T16:01:09.684", "2024-06-10T16:00:27.5"},
+		},
+		{
+			name:   "TAI inside a leap second",
+			input:  types.ConvertTimeScaleInput{Time: "2017-01-01T00:00:36", Scale: "tai"},
+			time:   "2016-12-31T23:59:59+00:00",
+			scales: []string{"2016-12-31T23:59:60", "2017-01-01T00:00:36", "2017-01-01T00:00:17", "2017-01-01T00:01:08.184", "2017-01-01T00:00:26"},
+			leap:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out, err := ConvertTimeScale(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Time.Datetime != tt.time || out.LeapSecond != tt.leap || out.TAIMinusUTC < 36 {
+				t.Errorf("unexpected result: %+v", out)
+			}
+			if len(out.Scales) != len(tt.scales) {
+				t.Fatalf("expected %d scales, got %+v", len(tt.scales), out.Scales)
+			}
+			for i, want := range tt.scales {
+				if out.Scales[i].Datetime != want {
+					t.Errorf("%s reading = %s, want %s", out.Scales[i].Timezone, out.Scales[i].Datetime, want)
+				}
+			}
+		})
+	}
+
+	_, out, err := ConvertTimeScale(context.Background(), nil, types.ConvertTimeScaleInput{Time: "2024-06-10T16:00:00Z"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.GPSWeek != 2318 || out.GPSSecondsOfWeek != 144018 || out.LeapTableExpires == "" || out.LeapTableExpired {
+		t.Errorf("unexpected GPS week or table status: %+v", out)
+	}
+}
+
+func TestConvertTimeScaleErrors(t *testing.T) {
+	week, negative, huge := 1, -1, 100000000
+	tow, negativeTOW, fullWeek := 0.0, -1.0, 604800.0
+	tests := []struct {
+		name  string
+		input types.ConvertTimeScaleInput
+		want  string
+	}{
+		{"unknown scale", types.ConvertTimeScaleInput{Time: "2024-01-01T00:00:00", Scale: "GLONASS"}, "unknown time scale"},
+		{"week with time", types.ConvertTimeScaleInput{Time: "2024-01-01T00:00:00", GPSWeek: &week}, "gps_week"},
+		{"negative week", types.ConvertTimeScaleInput{GPSWeek: &negative, GPSSecondsOfWeek: &tow}, "gps_week must be between 0 and 15249"},
+		{"huge week", types.ConvertTimeScaleInput{GPSWeek: &huge}, "gps_week must be between 0 and 15249"},
+		{"negative seconds of week", types.ConvertTimeScaleInput{GPSWeek: &week, GPSSecondsOfWeek: &negativeTOW}, "gps_seconds_of_week"},
+		{"seconds past the week", types.ConvertTimeScaleInput{GPSWeek: &week, GPSSecondsOfWeek: &fullWeek}, "less than 604800"},
+		{"not a leap second", types.ConvertTimeScaleInput{Time: "2024-06-30T23:59:60"}, "not a leap second"},
+		{"before 1972", types.ConvertTimeScaleInput{Time: "1960-01-01T00:00:00"}, "1972"},
+		{"bad timezone", types.ConvertTimeScaleInput{Timezone: "Europe/Lisbn"}, "Europe/Lisbon"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ConvertTimeScale(context.Background(), nil, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
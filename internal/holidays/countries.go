@@ -0,0 +1,399 @@
+package holidays
+
+import "time"
+
+// countries holds the public holidays of each country, keyed by ISO 3166 code.
+var countries = map[string]country{
+	"AU": {
+		name: "Australia",
+		from: 1994, // Australia Day kept on 26 January nationwide
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observe: substitute},
+			{name: "Australia Day", date: fixed(time.January, 26), observe: substitute},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Monday", date: easter(1)},
+			{name: "Anzac Day", date: fixed(time.April, 25)},
+			{name: "Queen's Birthday", date: nth(time.June, time.Monday, 2), to: 2022},
+			{name: "King's Birthday", date: nth(time.June, time.Monday, 2), from: 2023},
+			{name: "National Day of Mourning", date: once(2022, time.September, 22)},
+			{name: "Christmas Day", date: fixed(time.December, 25), observe: substitute},
+			{name: "Boxing Day", date: fixed(time.December, 26), observe: substitute},
+		},
+	},
+	"BR": {
+		name: "Brazil",
+		from: 1980, // Our Lady of Aparecida
+		rules: []rule{
+			{name: "New Year's Day", local: "Confraternização Universal", date: fixed(time.January, 1)},
+			{name: "Good Friday", local: "Sexta-feira Santa", date: easter(-2)},
+			{name: "Tiradentes", local: "Tiradentes", date: fixed(time.April, 21)},
+			{name: "Labour Day", local: "Dia do Trabalho", date: fixed(time.May, 1)},
+			{name: "Independence Day", local: "Independência do Brasil", date: fixed(time.September, 7)},
+			{name: "Our Lady of Aparecida", local: "Nossa Senhora Aparecida", date: fixed(time.October, 12)},
+			{name: "All Souls' Day", local: "Finados", date: fixed(time.November, 2)},
+			{name: "Republic Proclamation Day", local: "Proclamação da República", date: fixed(time.November, 15)},
+			{name: "Black Consciousness Day", local: "Dia Nacional de Zumbi e da Consciência Negra", date: fixed(time.November, 20), from: 2024},
+			{name: "Christmas Day", local: "Natal", date: fixed(time.December, 25)},
+		},
+	},
+	"CA": {
+		name: "Canada",
+		from: 1983, // Canada Day, formerly Dominion Day
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observe: substitute},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Victoria Day", date: onOrBefore(time.May, 24, time.Monday)},
+			{name: "Canada Day", date: fixed(time.July, 1), observe: substitute},
+			{name: "Labour Day", date: nth(time.September, time.Monday, 1)},
+			{name: "National Day for Truth and Reconciliation", date: fixed(time.September, 30), observe: substitute, from: 2021},
+			{name: "Thanksgiving", date: nth(time.October, time.Monday, 2)},
+			{name: "Remembrance Day", date: fixed(time.November, 11), observe: substitute},
+			{name: "Christmas Day", date: fixed(time.December, 25), observe: substitute},
+			{name: "Boxing Day", date: fixed(time.December, 26), observe: substitute},
+		},
+	},
+	// Statutory holidays; the State Council moves days off and makeup working days
+	// around them each year by notice, which rules cannot predict.
+	"CN": {
+		name: "China",
+		from: 2000, // the 1999 revision
+		rules: []rule{
+			{name: "New Year's Day", local: "元旦", date: fixed(time.January, 1)},
+			{name: "Spring Festival Eve", local: "除夕", date: shift(chinese(1, 1), -1), from: 2008, to: 2013},
+			{name: "Spring Festival Eve", local: "除夕", date: shift(chinese(1, 1), -1), from: 2025},
+			{name: "Spring Festival", local: "春节", date: chinese(1, 1)},
+			{name: "Spring Festival", local: "春节", date: chinese(1, 2)},
+			{name: "Spring Festival", local: "春节", date: chinese(1, 3), to: 2007},
+			{name: "Spring Festival", local: "春节", date: chinese(1, 3), from: 2014},
+			{name: "Qingming Festival", local: "清明节", date: solarTerm(15, 8), from: 2008},
+			{name: "Labour Day", local: "劳动节", date: fixed(time.May, 1)},
+			{name: "Labour Day", local: "劳动节", date: fixed(time.May, 2), to: 2007},
+			{name: "Labour Day", local: "劳动节", date: fixed(time.May, 2), from: 2025},
+			{name: "Labour Day", local: "劳动节", date: fixed(time.May, 3), to: 2007},
+			{name: "Dragon Boat Festival", local: "端午节", date: chinese(5, 5), from: 2008},
+			{name: "Mid-Autumn Festival", local: "中秋节", date: chinese(8, 15), from: 2008},
+			{name: "National Day", local: "国庆节", date: fixed(time.October, 1)},
+			{name: "National Day", local: "国庆节", date: fixed(time.October, 2)},
+			{name: "National Day", local: "国庆节", date: fixed(time.October, 3)},
+		},
+	},
+	"DE": {
+		name: "Germany",
+		from: 1991, // reunification
+		subdivisions: map[string]string{
+			"BB": "Brandenburg",
+			"BE": "Berlin",
+			"BW": "Baden-Württemberg",
+			"BY": "Bayern",
+			"HB": "Bremen",
+			"HE": "Hessen",
+			"HH": "Hamburg",
+			"MV": "Mecklenburg-Vorpommern",
+			"NI": "Niedersachsen",
+			"NW": "Nordrhein-Westfalen",
+			"RP": "Rheinland-Pfalz",
+			"SH": "Schleswig-Holstein",
+			"SL": "Saarland",
+			"SN": "Sachsen",
+			"ST": "Sachsen-Anhalt",
+			"TH": "Thüringen",
+		},
+		rules: []rule{
+			{name: "New Year's Day", local: "Neujahr", date: fixed(time.January, 1)},
+			{name: "Epiphany", local: "Heilige Drei Könige", date: fixed(time.January, 6), regions: []string{"BW", "BY", "ST"}},
+			{name: "International Women's Day", local: "Internationaler Frauentag", date: fixed(time.March, 8), from: 2019, regions: []string{"BE"}},
+			{name: "International Women's Day", local: "Internationaler Frauentag", date: fixed(time.March, 8), from: 2023, regions: []string{"MV"}},
+			{name: "Good Friday", local: "Karfreitag", date: easter(-2)},
+			{name: "Easter Sunday", local: "Ostersonntag", date: easter(0), regions: []string{"BB"}},
+			{name: "Easter Monday", local: "Ostermontag", date: easter(1)},
+			{name: "Labour Day", local: "Tag der Arbeit", date: fixed(time.May, 1)},
+			{name: "Liberation Day", local: "Tag der Befreiung", date: once(2020, time.May, 8), regions: []string{"BE"}},
+			{name: "Liberation Day", local: "Tag der Befreiung", date: once(2025, time.May, 8), regions: []string{"BE"}},
+			{name: "Ascension Day", local: "Christi Himmelfahrt", date: easter(39)},
+			{name: "Whit Sunday", local: "Pfingstsonntag", date: easter(49), regions: []string{"BB"}},
+			{name: "Whit Monday", local: "Pfingstmontag", date: easter(50)},
+			{name: "Corpus Christi", local: "Fronleichnam", date: easter(60), regions: []string{"BW", "BY", "HE", "NW", "RP", "SL"}},
+			{name: "Assumption Day", local: "Mariä Himmelfahrt", date: fixed(time.August, 15), regions: []string{"SL"}},
+			{name: "World Children's Day", local: "Weltkindertag", date: fixed(time.September, 20), from: 2019, regions: []string{"TH"}},
+			{name: "German Unity Day", local: "Tag der Deutschen Einheit", date: fixed(time.October, 3), from: 1990},
+			{name: "Reformation Day", local: "Reformationstag", date: once(2017, time.October, 31)},
+			{name: "Reformation Day", local: "Reformationstag", date: fixed(time.October, 31), from: 1990, regions: []string{"BB", "MV", "SN", "ST", "TH"}},
+			{name: "Reformation Day", local: "Reformationstag", date: fixed(time.October, 31), from: 2018, regions: []string{"HB", "HH", "NI", "SH"}},
+			{name: "All Saints' Day", local: "Allerheiligen", date: fixed(time.November, 1), regions: []string{"BW", "BY", "NW", "RP", "SL"}},
+			{name: "Repentance and Prayer Day", local: "Buß- und Bettag", date: onOrBefore(time.November, 22, time.Wednesday), to: 1994},
+			{name: "Repentance and Prayer Day", local: "Buß- und Bettag", date: onOrBefore(time.November, 22, time.Wednesday), from: 1995, regions: []string{"SN"}},
+			{name: "Christmas Day", local: "1. Weihnachtstag", date: fixed(time.December, 25)},
+			{name: "St. Stephen's Day", local: "2. Weihnachtstag", date: fixed(time.December, 26)},
+		},
+	},
+	"ES": {
+		name: "Spain",
+		from: 1987, // National Day on 12 October
+		rules: []rule{
+			{name: "New Year's Day", local: "Año Nuevo", date: fixed(time.January, 1)},
+			{name: "Epiphany", local: "Epifanía del Señor", date: fixed(time.January, 6)},
+			{name: "Good Friday", local: "Viernes Santo", date: easter(-2)},
+			{name: "Labour Day", local: "Fiesta del Trabajo", date: fixed(time.May, 1)},
+			{name: "Assumption Day", local: "Asunción de la Virgen", date: fixed(time.August, 15)},
+			{name: "National Day", local: "Fiesta Nacional de España", date: fixed(time.October, 12)},
+			{name: "All Saints' Day", local: "Todos los Santos", date: fixed(time.November, 1)},
+			{name: "Constitution Day", local: "Día de la Constitución Española", date: fixed(time.December, 6)},
+			{name: "Immaculate Conception", local: "Inmaculada Concepción", date: fixed(time.December, 8)},
+			{name: "Christmas Day", local: "Natividad del Señor", date: fixed(time.December, 25)},
+		},
+	},
+	"FR": {
+		name: "France",
+		from: 1982, // Victory in Europe Day restored
+		rules: []rule{
+			{name: "New Year's Day", local: "Jour de l'an", date: fixed(time.January, 1)},
+			{name: "Easter Monday", local: "Lundi de Pâques", date: easter(1)},
+			{name: "Labour Day", local: "Fête du Travail", date: fixed(time.May, 1)},
+			{name: "Victory in Europe Day", local: "Victoire 1945", date: fixed(time.May, 8)},
+			{name: "Ascension Day", local: "Ascension", date: easter(39)},
+			{name: "Whit Monday", local: "Lundi de Pentecôte", date: easter(50)},
+			{name: "Bastille Day", local: "Fête nationale", date: fixed(time.July, 14)},
+			{name: "Assumption Day", local: "Assomption", date: fixed(time.August, 15)},
+			{name: "All Saints' Day", local: "Toussaint", date: fixed(time.November, 1)},
+			{name: "Armistice Day", local: "Armistice 1918", date: fixed(time.November, 11)},
+			{name: "Christmas Day", local: "Noël", date: fixed(time.December, 25)},
+		},
+	},
+	// Without a subdivision, the bank holidays of England and Wales
+	"GB": {
+		name:               "United Kingdom",
+		from:               1978, // Early May bank holiday
+		subdivisions:       map[string]string{"ENG": "England", "NIR": "Northern Ireland", "SCT": "Scotland", "WLS": "Wales"},
+		defaultSubdivision: "ENG",
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observe: substitute},
+			{name: "2nd January", date: fixed(time.January, 2), observe: substitute, regions: []string{"SCT"}},
+			{name: "Saint Patrick's Day", date: fixed(time.March, 17), observe: substitute, regions: []string{"NIR"}},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Monday", date: easter(1), regions: []string{"ENG", "NIR", "WLS"}},
+			{name: "Early May bank holiday", date: except(nth(time.May, time.Monday, 1), map[int]time.Time{
+				1995: date(1995, time.May, 8),
+				2020: date(2020, time.May, 8),
+			}), from: 1978},
+			{name: "Spring bank holiday", date: except(nth(time.May, time.Monday, -1), map[int]time.Time{
+				2002: date(2002, time.June, 4),
+				2012: date(2012, time.June, 4),
+				2022: date(2022, time.June, 2),
+			}), from: 1971},
+			{name: "Battle of the Boyne", date: fixed(time.July, 12), observe: substitute, regions: []string{"NIR"}},
+			{name: "Summer bank holiday", date: nth(time.August, time.Monday, 1), from: 1971, regions: []string{"SCT"}},
+			{name: "Summer bank holiday", date: nth(time.August, time.Monday, -1), from: 1971, regions: []string{"ENG", "NIR", "WLS"}},
+			{name: "Saint Andrew's Day", date: fixed(time.November, 30), observe: substitute, from: 2007, regions: []string{"SCT"}},
+			{name: "Christmas Day", date: fixed(time.December, 25), observe: substitute},
+			{name: "Boxing Day", date: fixed(time.December, 26), observe: substitute},
+			{name: "Millennium Celebrations", date: once(1999, time.December, 31)},
+			{name: "Golden Jubilee", date: once(2002, time.June, 3)},
+			{name: "Royal Wedding", date: once(2011, time.April, 29)},
+			{name: "Diamond Jubilee", date: once(2012, time.June, 5)},
+			{name: "Platinum Jubilee", date: once(2022, time.June, 3)},
+			{name: "State Funeral of Queen Elizabeth II", date: once(2022, time.September, 19)},
+			{name: "Coronation of King Charles III", date: once(2023, time.May, 8)},
+		},
+	},
+	"IE": {
+		name: "Ireland",
+		from: 1994, // May Day
+		rules: []rule{
+			{name: "New Year's Day", local: "Lá Caille", date: fixed(time.January, 1), observe: substitute},
+			{name: "Saint Brigid's Day", local: "Lá Fhéile Bríde", date: stBrigidsDay, from: 2023},
+			{name: "Saint Patrick's Day", local: "Lá Fhéile Pádraig", date: fixed(time.March, 17), observe: substitute},
+			{name: "Day of Remembrance and Recognition", local: "Lá Cuimhneacháin agus Aitheantais", date: once(2022, time.March, 18)},
+			{name: "Easter Monday", local: "Luan Cásca", date: easter(1)},
+			{name: "May Day", local: "Lá Bealtaine", date: nth(time.May, time.Monday, 1)},
+			{name: "June Holiday", local: "Lá Saoire i mí an Mheithimh", date: nth(time.June, time.Monday, 1)},
+			{name: "August Holiday", local: "Lá Saoire i mí Lúnasa", date: nth(time.August, time.Monday, 1)},
+			{name: "October Holiday", local: "Lá Saoire i mí Dheireadh Fómhair", date: nth(time.October, time.Monday, -1)},
+			{name: "Christmas Day", local: "Lá Nollag", date: fixed(time.December, 25), observe: substitute},
+			{name: "Saint Stephen's Day", local: "Lá Fhéile Stiofáin", date: fixed(time.December, 26), observe: substitute},
+		},
+	},
+	"IT": {
+		name: "Italy",
+		from: 2001, // Republic Day back on 2 June
+		rules: []rule{
+			{name: "New Year's Day", local: "Capodanno", date: fixed(time.January, 1)},
+			{name: "Epiphany", local: "Epifania", date: fixed(time.January, 6)},
+			{name: "Easter Sunday", local: "Pasqua", date: easter(0)},
+			{name: "Easter Monday", local: "Lunedì dell'Angelo", date: easter(1)},
+			{name: "Liberation Day", local: "Festa della Liberazione", date: fixed(time.April, 25)},
+			{name: "Labour Day", local: "Festa del Lavoro", date: fixed(time.May, 1)},
+			{name: "Republic Day", local: "Festa della Repubblica", date: fixed(time.June, 2)},
+			{name: "Assumption Day", local: "Ferragosto", date: fixed(time.August, 15)},
+			{name: "Saint Francis of Assisi Day", local: "San Francesco d'Assisi", date: fixed(time.October, 4), from: 2026},
+			{name: "All Saints' Day", local: "Ognissanti", date: fixed(time.November, 1)},
+			{name: "Immaculate Conception", local: "Immacolata Concezione", date: fixed(time.December, 8)},
+			{name: "Christmas Day", local: "Natale", date: fixed(time.December, 25)},
+			{name: "St. Stephen's Day", local: "Santo Stefano", date: fixed(time.December, 26)},
+		},
+	},
+	// From the first full year of the 1948 holidays law; substitute days began on 12 April
+	// 1973 and Citizens' Holidays with the law's 1985 amendment.
+	"JP": {
+		name:                "Japan",
+		from:                1949,
+		to:                  2099, // equinox
+		citizensHolidayFrom: 1986,
+		rules: []rule{
+			{name: "New Year's Day", local: "元日", date: fixed(time.January, 1), observe: sundaySubstitute, observeFrom: 1973},
+			{name: "Coming of Age Day", local: "成人の日", date: fixed(time.January, 15), observe: sundaySubstitute, observeFrom: 1973, to: 1999},
+			{name: "Coming of Age Day", local: "成人の日", date: nth(time.January, time.Monday, 2), from: 2000},
+			{name: "National Foundation Day", local: "建国記念の日", date: fixed(time.February, 11), observe: sundaySubstitute, observeFrom: 1974, from: 1967},
+			{name: "Emperor's Birthday", local: "天皇誕生日", date: fixed(time.February, 23), observe: sundaySubstitute, from: 2020},
+			{name: "Funeral of Emperor Showa", local: "昭和天皇の大喪の礼", date: once(1989, time.February, 24)},
+			{name: "Vernal Equinox Day", local: "春分の日", date: equinox(false), observe: sundaySubstitute, observeFrom: 1973},
+			{name: "Wedding of Crown Prince Akihito", local: "皇太子明仁親王の結婚の儀", date: once(1959, time.April, 10)},
+			{name: "Emperor's Birthday", local: "天皇誕生日", date: fixed(time.April, 29), observe: sundaySubstitute, observeFrom: 1973, to: 1988},
+			{name: "Greenery Day", local: "みどりの日", date: fixed(time.April, 29), observe: sundaySubstitute, from: 1989, to: 2006},
+			{name: "Showa Day", local: "昭和の日", date: fixed(time.April, 29), observe: sundaySubstitute, from: 2007},
+			{name: "Enthronement Day", local: "天皇の即位の日", date: once(2019, time.May, 1)},
+			{name: "Constitution Memorial Day", local: "憲法記念日", date: fixed(time.May, 3), observe: sundaySubstitute, observeFrom: 1973},
+			{name: "Greenery Day", local: "みどりの日", date: fixed(time.May, 4), observe: sundaySubstitute, from: 2007},
+			{name: "Children's Day", local: "こどもの日", date: fixed(time.May, 5), observe: sundaySubstitute, observeFrom: 1973},
+			{name: "Wedding of Crown Prince Naruhito", local: "皇太子徳仁親王の結婚の儀", date: once(1993, time.June, 9)},
+			{name: "Marine Day", local: "海の日", date: fixed(time.July, 20), observe: sundaySubstitute, from: 1996, to: 2002},
+			{name: "Marine Day", local: "海の日", date: except(nth(time.July, time.Monday, 3), map[int]time.Time{
+				2020: date(2020, time.July, 23),
+				2021: date(2021, time.July, 22),
+			}), from: 2003},
+			{name: "Mountain Day", local: "山の日", date: except(fixed(time.August, 11), map[int]time.Time{
+				2020: date(2020, time.August, 10),
+				2021: date(2021, time.August, 8),
+			}), observe: sundaySubstitute, from: 2016},
+			{name: "Respect for the Aged Day", local: "敬老の日", date: fixed(time.September, 15), observe: sundaySubstitute, observeFrom: 1973, from: 1966, to: 2002},
+			{name: "Respect for the Aged Day", local: "敬老の日", date: nth(time.September, time.Monday, 3), from: 2003},
+			{name: "Autumnal Equinox Day", local: "秋分の日", date: equinox(true), observe: sundaySubstitute, observeFrom: 1973},
+			{name: "Health and Sports Day", local: "体育の日", date: fixed(time.October, 10), observe: sundaySubstitute, observeFrom: 1973, from: 1966, to: 1999},
+			{name: "Health and Sports Day", local: "体育の日", date: nth(time.October, time.Monday, 2), from: 2000, to: 2019},
+			{name: "Sports Day", local: "スポーツの日", date: except(nth(time.October, time.Monday, 2), map[int]time.Time{
+				2020: date(2020, time.July, 24),
+				2021: date(2021, time.July, 23),
+			}), from: 2020},
+			{name: "Enthronement Ceremony Day", local: "即位礼正殿の儀の行われる日", date: once(1990, time.November, 12)},
+			{name: "Enthronement Ceremony Day", local: "即位礼正殿の儀の行われる日", date: once(2019, time.October, 22)},
+			{name: "Culture Day", local: "文化の日", date: fixed(time.November, 3), observe: sundaySubstitute, observeFrom: 1973},
+			{name: "Labour Thanksgiving Day", local: "勤労感謝の日", date: fixed(time.November, 23), observe: sundaySubstitute, observeFrom: 1973},
+			{name: "Emperor's Birthday", local: "天皇誕生日", date: fixed(time.December, 23), observe: sundaySubstitute, from: 1989, to: 2018},
+		},
+	},
+	"KR": {
+		name: "South Korea",
+		from: 2008, // Constitution Day no longer a holiday
+		rules: []rule{
+			{name: "New Year's Day", local: "신정", date: fixed(time.January, 1)},
+			{name: "Seollal", local: "설날", date: shift(korean(1, 1), -1), observe: sundayOrShared, observeFrom: 2014},
+			{name: "Seollal", local: "설날", date: korean(1, 1), observe: sundayOrShared, observeFrom: 2014},
+			{name: "Seollal", local: "설날", date: korean(1, 2), observe: sundayOrShared, observeFrom: 2014},
+			{name: "Temporary Holiday", local: "임시공휴일", date: once(2025, time.January, 27)},
+			{name: "Independence Movement Day", local: "삼일절", date: fixed(time.March, 1), observe: weekendOrShared, observeFrom: 2021},
+			{name: "Children's Day", local: "어린이날", date: fixed(time.May, 5), observe: weekendOrShared, observeFrom: 2014},
+			{name: "Buddha's Birthday", local: "부처님오신날", date: korean(4, 8), observe: weekendOrShared, observeFrom: 2023},
+			{name: "Presidential Election Day", local: "대통령 선거일", date: once(2025, time.June, 3)},
+			{name: "Memorial Day", local: "현충일", date: fixed(time.June, 6)},
+			{name: "Liberation Day", local: "광복절", date: fixed(time.August, 15), observe: weekendOrShared, observeFrom: 2021},
+			{name: "Chuseok", local: "추석", date: korean(8, 14), observe: sundayOrShared, observeFrom: 2014},
+			{name: "Chuseok", local: "추석", date: korean(8, 15), observe: sundayOrShared, observeFrom: 2014},
+			{name: "Chuseok", local: "추석", date: korean(8, 16), observe: sundayOrShared, observeFrom: 2014},
+			{name: "Armed Forces Day", local: "국군의 날", date: once(2024, time.October, 1)},
+			{name: "National Foundation Day", local: "개천절", date: fixed(time.October, 3), observe: weekendOrShared, observeFrom: 2021},
+			{name: "Hangul Day", local: "한글날", date: fixed(time.October, 9), observe: weekendOrShared, observeFrom: 2021, from: 2013},
+			{name: "Christmas Day", local: "기독탄신일", date: fixed(time.December, 25), observe: weekendOrShared, observeFrom: 2023},
+		},
+	},
+	"MX": {
+		name: "Mexico",
+		from: 2006, // the Monday holidays
+		rules: []rule{
+			{name: "New Year's Day", local: "Año Nuevo", date: fixed(time.January, 1)},
+			{name: "Constitution Day", local: "Día de la Constitución", date: nth(time.February, time.Monday, 1)},
+			{name: "Benito Juárez's Birthday", local: "Natalicio de Benito Juárez", date: nth(time.March, time.Monday, 3)},
+			{name: "Labour Day", local: "Día del Trabajo", date: fixed(time.May, 1)},
+			{name: "Independence Day", local: "Día de la Independencia", date: fixed(time.September, 16)},
+			{name: "Presidential Inauguration", local: "Transmisión del Poder Ejecutivo Federal", date: inauguration},
+			{name: "Revolution Day", local: "Día de la Revolución", date: nth(time.November, time.Monday, 3)},
+			{name: "Christmas Day", local: "Navidad", date: fixed(time.December, 25)},
+		},
+	},
+	"NL": {
+		name: "Netherlands",
+		from: 1990, // Liberation Day every year
+		rules: []rule{
+			{name: "New Year's Day", local: "Nieuwjaarsdag", date: fixed(time.January, 1)},
+			{name: "Easter Sunday", local: "Eerste Paasdag", date: easter(0)},
+			{name: "Easter Monday", local: "Tweede Paasdag", date: easter(1)},
+			{name: "Queen's Day", local: "Koninginnedag", date: sundayBefore(time.April, 30), from: 1949, to: 2013},
+			{name: "King's Day", local: "Koningsdag", date: sundayBefore(time.April, 27), from: 2014},
+			{name: "Liberation Day", local: "Bevrijdingsdag", date: fixed(time.May, 5)},
+			{name: "Ascension Day", local: "Hemelvaartsdag", date: easter(39)},
+			{name: "Whit Sunday", local: "Eerste Pinksterdag", date: easter(49)},
+			{name: "Whit Monday", local: "Tweede Pinksterdag", date: easter(50)},
+			{name: "Christmas Day", local: "Eerste Kerstdag", date: fixed(time.December, 25)},
+			{name: "Boxing Day", local: "Tweede Kerstdag", date: fixed(time.December, 26)},
+		},
+	},
+	// Federal holidays
+	"US": {
+		name: "United States",
+		from: 1971, // the Uniform Monday Holiday Act
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observe: nearestWeekday},
+			{name: "Martin Luther King Jr. Day", date: nth(time.January, time.Monday, 3), from: 1986},
+			{name: "Washington's Birthday", date: nth(time.February, time.Monday, 3)},
+			{name: "Memorial Day", date: nth(time.May, time.Monday, -1)},
+			{name: "Juneteenth National Independence Day", date: fixed(time.June, 19), observe: nearestWeekday, from: 2021},
+			{name: "Independence Day", date: fixed(time.July, 4), observe: nearestWeekday},
+			{name: "Labor Day", date: nth(time.September, time.Monday, 1)},
+			{name: "Columbus Day", date: nth(time.October, time.Monday, 2)},
+			{name: "Veterans Day", date: nth(time.October, time.Monday, 4), to: 1977},
+			{name: "Veterans Day", date: fixed(time.November, 11), observe: nearestWeekday, from: 1978},
+			{name: "Thanksgiving Day", date: nth(time.November, time.Thursday, 4)},
+			{name: "Christmas Day", date: fixed(time.December, 25), observe: nearestWeekday},
+		},
+	},
+}
+
+// chinese is a day of the Chinese lunisolar calendar, reckoned at UTC+8.
+func chinese(month, day int) dateFunc {
+	return lunar(8, month, day)
+}
+
+// korean is a day of the Korean lunisolar calendar, reckoned at UTC+9.
+func korean(month, day int) dateFunc {
+	return lunar(9, month, day)
+}
+
+// stBrigidsDay is the first Monday of February, or 1 February when that is a Friday.
+func stBrigidsDay(year int) (time.Time, bool) {
+	if d := date(year, time.February, 1); d.Weekday() == time.Friday {
+		return d, true
+	}
+	return nth(time.February, time.Monday, 1)(year)
+}
+
+// sundayBefore is a date that moves to the day before when it falls on a Sunday, as the
+// Dutch King's Day does.
+func sundayBefore(month time.Month, day int) dateFunc {
+	return func(year int) (time.Time, bool) {
+		d := date(year, month, day)
+		if d.Weekday() == time.Sunday {
+			d = d.AddDate(0, 0, -1)
+		}
+		return d, true
+	}
+}
+
+// inauguration is the day a Mexican president takes office, every six years: 1 December
+// until 2018, then 1 October from 2024.
+func inauguration(year int) (time.Time, bool) {
+	switch {
+	case year >= 2024:
+		return date(year, time.October, 1), (year-2024)%6 == 0
+	case year >= 1934:
+		return date(year, time.December, 1), (year-1934)%6 == 0
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,265 @@
+// Package holidays computes public holidays offline from rules: fixed dates, nth
+// weekdays, dates relative to Easter, days of the Chinese and Korean lunisolar calendars,
+// solar terms and one-off days, with each country's way of observing holidays that fall
+// on a weekend. Some countries have subdivisions, such as German states, with holidays of
+// their own.
+package holidays
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Holiday is a public holiday. Date is midnight UTC on the day, whatever the country's
+// timezone.
+type Holiday struct {
+	Date      time.Time
+	Name      string // English name
+	LocalName string // name in the country's language
+	// Observed marks a substitute day off for a holiday falling on a weekend or on
+	// another holiday.
+	Observed bool
+	// Regional marks a holiday kept only in some subdivisions of the country.
+	Regional bool
+}
+
+// country is the holiday calendar of a country.
+type country struct {
+	name  string
+	rules []rule
+	// from and to bound the years the rules are checked for, 0 leaving to open: earlier
+	// years had holidays or observance rules the calendar lacks.
+	from, to int
+	// citizensHolidayFrom is the year from which a weekday between two holidays is a
+	// holiday, as in Japan (国民の休日), or 0 for never.
+	citizensHolidayFrom int
+	// subdivisions maps the ISO 3166-2 subdivision codes, without the country prefix, to
+	// their names.
+	subdivisions map[string]string
+	// defaultSubdivision is used when none is given, for countries whose holidays are
+	// usually those of one subdivision.
+	defaultSubdivision string
+}
+
+// Countries lists the ISO 3166 codes with a holiday calendar.
+func Countries() []string {
+	return slices.Sorted(maps.Keys(countries))
+}
+
+// CountryName returns the English name of a country with a holiday calendar, or "".
+func CountryName(code string) string {
+	return countries[strings.ToUpper(code)].name
+}
+
+// Subdivisions returns the ISO 3166-2 codes (such as "DE-BY") of a country's subdivisions
+// with holidays of their own, mapped to their names.
+func Subdivisions(code string) map[string]string {
+	code = strings.ToUpper(code)
+	out := make(map[string]string)
+	for sub, name := range countries[code].subdivisions {
+		out[code+"-"+sub] = name
+	}
+	return out
+}
+
+// Years returns the first and last years whose holidays For computes for a country or
+// subdivision code, last being 0 when open.
+func Years(code string) (int, int, error) {
+	c, _, err := lookup(code)
+	if err != nil {
+		return 0, 0, err
+	}
+	return c.from, c.to, nil
+}
+
+// For returns the holidays of a country in a year, in date order, including substitute
+// days observed in that year. code is an ISO 3166 country code, or an ISO 3166-2
+// subdivision code such as "DE-BY" for the holidays of a subdivision. It fails for years
+// outside those the country's rules cover.
+func For(code string, year int) ([]Holiday, error) {
+	c, region, err := lookup(code)
+	if err != nil {
+		return nil, err
+	}
+	if year < c.from || c.to != 0 && year > c.to {
+		return nil, fmt.Errorf("%s holidays are only computed %s, not for %d", c.name, c.years(), year)
+	}
+	var out []Holiday
+	// A holiday early in January can be observed in December of the year before
+	for y := year - 1; y <= year+1; y++ {
+		for _, h := range c.holidays(y, region) {
+			if h.Date.Year() == year {
+				out = append(out, h)
+			}
+		}
+	}
+	return out, nil
+}
+
+// lookup returns the calendar and subdivision of a country or subdivision code.
+func lookup(code string) (country, string, error) {
+	countryCode, region, _ := strings.Cut(strings.ToUpper(strings.TrimSpace(code)), "-")
+	c, ok := countries[countryCode]
+	if !ok {
+		return country{}, "", fmt.Errorf("no holiday calendar for country %q: expected one of %s", code, strings.Join(Countries(), ", "))
+	}
+	if region == "" {
+		return c, c.defaultSubdivision, nil
+	}
+	if _, ok := c.subdivisions[region]; !ok {
+		if len(c.subdivisions) == 0 {
+			return country{}, "", fmt.Errorf("no subdivision holidays for %s", c.name)
+		}
+		return country{}, "", fmt.Errorf("unknown subdivision %q of %s: expected one of %s", code, c.name, strings.Join(slices.Sorted(maps.Keys(Subdivisions(countryCode))), ", "))
+	}
+	return c, region, nil
+}
+
+// years describes the years a country's rules cover.
+func (c country) years() string {
+	if c.to == 0 {
+		return fmt.Sprintf("from %d on", c.from)
+	}
+	return fmt.Sprintf("from %d to %d", c.from, c.to)
+}
+
+// Between returns the holidays of a country in [from, to], compared by date.
+func Between(code string, from, to time.Time) ([]Holiday, error) {
+	from, to = Day(from), Day(to)
+	var out []Holiday
+	for year := from.Year(); year <= to.Year(); year++ {
+		list, err := For(code, year)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range list {
+			if !h.Date.Before(from) && !h.Date.After(to) {
+				out = append(out, h)
+			}
+		}
+	}
+	return out, nil
+}
+
+// Day returns midnight UTC on t's date in its own location.
+func Day(t time.Time) time.Time {
+	return date(t.Date())
+}
+
+// holidays computes a year's holidays in a subdivision, or nationwide for "", with their
+// substitute days.
+func (c country) holidays(year int, region string) []Holiday {
+	type entry struct {
+		Holiday
+		observe observance
+	}
+	var entries []entry
+	taken := make(map[time.Time]int)
+	for _, r := range c.rules {
+		if !r.inForce(year, region) {
+			continue
+		}
+		d, ok := r.date(year)
+		// Regional rules can repeat a nationwide one-off, as Reformation Day in 2017
+		if !ok || slices.ContainsFunc(entries, func(e entry) bool { return e.Date.Equal(d) && e.Name == r.name }) {
+			continue
+		}
+		h := Holiday{Date: d, Name: r.name, LocalName: or(r.local, r.name), Regional: len(r.regions) > 0}
+		entries = append(entries, entry{h, r.observance(year)})
+		taken[d]++
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int { return a.Date.Compare(b.Date) })
+
+	var list, observed []Holiday
+	shared := make(map[time.Time]bool)
+	for _, e := range entries {
+		list = append(list, e.Holiday)
+		day, ok := observedDay(e.observe, e.Date, taken, shared)
+		if !ok {
+			continue
+		}
+		taken[day]++
+		o := e.Holiday
+		o.Date, o.Observed = day, true
+		o.Name += " (observed)"
+		observed = append(observed, o)
+	}
+	if c.citizensHolidayFrom != 0 && year >= c.citizensHolidayFrom {
+		observed = append(observed, citizensHolidays(list, taken)...)
+	}
+	list = append(list, observed...)
+	slices.SortStableFunc(list, func(a, b Holiday) int { return a.Date.Compare(b.Date) })
+	return list
+}
+
+// observedDay returns the substitute day of a holiday on d, and false when it needs
+// none. taken counts the holidays on each day; shared records the days whose holidays
+// already share one substitute.
+func observedDay(observe observance, d time.Time, taken map[time.Time]int, shared map[time.Time]bool) (time.Time, bool) {
+	weekday := d.Weekday()
+	switch observe {
+	case nearestWeekday:
+		switch weekday {
+		case time.Saturday:
+			return d.AddDate(0, 0, -1), true
+		case time.Sunday:
+			return d.AddDate(0, 0, 1), true
+		}
+	case substitute:
+		if weekday != time.Saturday && weekday != time.Sunday {
+			return time.Time{}, false
+		}
+		for d = d.AddDate(0, 0, 1); taken[d] > 0 || d.Weekday() == time.Saturday || d.Weekday() == time.Sunday; d = d.AddDate(0, 0, 1) {
+		}
+		return d, true
+	case sundaySubstitute:
+		if weekday != time.Sunday {
+			return time.Time{}, false
+		}
+		for d = d.AddDate(0, 0, 1); taken[d] > 0; d = d.AddDate(0, 0, 1) {
+		}
+		return d, true
+	case sundayOrShared, weekendOrShared:
+		weekend := weekday == time.Sunday || observe == weekendOrShared && weekday == time.Saturday
+		isShared := taken[d] > 1 && !shared[d]
+		if !weekend && !isShared {
+			return time.Time{}, false
+		}
+		if !weekend {
+			shared[d] = true
+		}
+		for d = d.AddDate(0, 0, 1); taken[d] > 0 || d.Weekday() == time.Sunday; d = d.AddDate(0, 0, 1) {
+		}
+		return d, true
+	}
+	return time.Time{}, false
+}
+
+// citizensHolidays returns the days, other than Sundays and days off, that lie between
+// two holidays. list holds the holidays without their substitute days.
+func citizensHolidays(list []Holiday, taken map[time.Time]int) []Holiday {
+	holiday := make(map[time.Time]bool)
+	for _, h := range list {
+		holiday[h.Date] = true
+	}
+	var out []Holiday
+	for _, h := range list {
+		d := h.Date.AddDate(0, 0, 1)
+		if taken[d] > 0 || d.Weekday() == time.Sunday || !holiday[d.AddDate(0, 0, 1)] {
+			continue
+		}
+		taken[d]++
+		out = append(out, Holiday{Date: d, Name: "Citizens' Holiday", LocalName: "国民の休日"})
+	}
+	return out
+}
+
+func or(s, fallback string) string {
+	if s != "" {
+		return s
+	}
+	return fallback
+}
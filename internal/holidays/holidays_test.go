@@ -0,0 +1,390 @@
+package holidays
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	tests := map[int]string{
+		1961: "1961-04-02",
+		2000: "2000-04-23",
+		2008: "2008-03-23",
+		2011: "2011-04-24",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2026: "2026-04-05",
+		2038: "2038-04-25",
+	}
+	for year, want := range tests {
+		if got := Easter(year).Format(time.DateOnly); got != want {
+			t.Errorf("Easter(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+// names returns "date name" lines for a year's holidays.
+func names(t *testing.T, code string, year int) []string {
+	t.Helper()
+	list, err := For(code, year)
+	if err != nil {
+		t.Fatalf("For(%s, %d) error = %v", code, year, err)
+	}
+	var out []string
+	for _, h := range list {
+		out = append(out, h.Date.Format(time.DateOnly)+" "+h.Name)
+	}
+	return out
+}
+
+func TestFor(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		year int
+		want []string
+	}{
+		{
+			name: "US observed on the nearest weekday",
+			code: "us",
+			year: 2026,
+			want: []string{
+				"2026-01-01 New Year's Day",
+				"2026-01-19 Martin Luther King Jr. Day",
+				"2026-02-16 Washington's Birthday",
+				"2026-05-25 Memorial Day",
+				"2026-06-19 Juneteenth National Independence Day",
+				"2026-07-03 Independence Day (observed)",
+				"2026-07-04 Independence Day",
+				"2026-09-07 Labor Day",
+				"2026-10-12 Columbus Day",
+				"2026-11-11 Veterans Day",
+				"2026-11-26 Thanksgiving Day",
+				"2026-12-25 Christmas Day",
+			},
+		},
+		{
+			name: "UK substitutes skip days already taken",
+			code: "GB",
+			year: 2022,
+			want: []string{
+				"2022-01-01 New Year's Day",
+				"2022-01-03 New Year's Day (observed)",
+				"2022-04-15 Good Friday",
+				"2022-04-18 Easter Monday",
+				"2022-05-02 Early May bank holiday",
+				"2022-06-02 Spring bank holiday",
+				"2022-06-03 Platinum Jubilee",
+				"2022-08-29 Summer bank holiday",
+				"2022-09-19 State Funeral of Queen Elizabeth II",
+				"2022-12-25 Christmas Day",
+				"2022-12-26 Boxing Day",
+				"2022-12-27 Christmas Day (observed)",
+			},
+		},
+		{
+			name: "Japan substitute and citizens' holidays",
+			code: "JP",
+			year: 2026,
+			want: []string{
+				"2026-01-01 New Year's Day",
+				"2026-01-12 Coming of Age Day",
+				"2026-02-11 National Foundation Day",
+				"2026-02-23 Emperor's Birthday",
+				"2026-03-20 Vernal Equinox Day",
+				"2026-04-29 Showa Day",
+				"2026-05-03 Constitution Memorial Day",
+				"2026-05-04 Greenery Day",
+				"2026-05-05 Children's Day",
+				"2026-05-06 Constitution Memorial Day (observed)",
+				"2026-07-20 Marine Day",
+				"2026-08-11 Mountain Day",
+				"2026-09-21 Respect for the Aged Day",
+				"2026-09-22 Citizens' Holiday",
+				"2026-09-23 Autumnal Equinox Day",
+				"2026-10-12 Sports Day",
+				"2026-11-03 Culture Day",
+				"2026-11-23 Labour Thanksgiving Day",
+			},
+		},
+		{
+			name: "Germany follows Easter",
+			code: "DE",
+			year: 2026,
+			want: []string{
+				"2026-01-01 New Year's Day",
+				"2026-04-03 Good Friday",
+				"2026-04-06 Easter Monday",
+				"2026-05-01 Labour Day",
+				"2026-05-14 Ascension Day",
+				"2026-05-25 Whit Monday",
+				"2026-10-03 German Unity Day",
+				"2026-12-25 Christmas Day",
+				"2026-12-26 St. Stephen's Day",
+			},
+		},
+		{
+			name: "Bavaria adds its own holidays",
+			code: "de-by",
+			year: 2026,
+			want: []string{
+				"2026-01-01 New Year's Day",
+				"2026-01-06 Epiphany",
+				"2026-04-03 Good Friday",
+				"2026-04-06 Easter Monday",
+				"2026-05-01 Labour Day",
+				"2026-05-14 Ascension Day",
+				"2026-05-25 Whit Monday",
+				"2026-06-04 Corpus Christi",
+				"2026-10-03 German Unity Day",
+				"2026-11-01 All Saints' Day",
+				"2026-12-25 Christmas Day",
+				"2026-12-26 St. Stephen's Day",
+			},
+		},
+		{
+			name: "Scotland substitutes 1 and 2 January",
+			code: "GB-SCT",
+			year: 2022,
+			want: []string{
+				"2022-01-01 New Year's Day",
+				"2022-01-02 2nd January",
+				"2022-01-03 New Year's Day (observed)",
+				"2022-01-04 2nd January (observed)",
+				"2022-04-15 Good Friday",
+				"2022-05-02 Early May bank holiday",
+				"2022-06-02 Spring bank holiday",
+				"2022-06-03 Platinum Jubilee",
+				"2022-08-01 Summer bank holiday",
+				"2022-09-19 State Funeral of Queen Elizabeth II",
+				"2022-11-30 Saint Andrew's Day",
+				"2022-12-25 Christmas Day",
+				"2022-12-26 Boxing Day",
+				"2022-12-27 Christmas Day (observed)",
+			},
+		},
+		{
+			name: "China's lunar festivals and solar term",
+			code: "CN",
+			year: 2026,
+			want: []string{
+				"2026-01-01 New Year's Day",
+				"2026-02-16 Spring Festival Eve",
+				"2026-02-17 Spring Festival",
+				"2026-02-18 Spring Festival",
+				"2026-02-19 Spring Festival",
+				"2026-04-05 Qingming Festival",
+				"2026-05-01 Labour Day",
+				"2026-05-02 Labour Day",
+				"2026-06-19 Dragon Boat Festival",
+				"2026-09-25 Mid-Autumn Festival",
+				"2026-10-01 National Day",
+				"2026-10-02 National Day",
+				"2026-10-03 National Day",
+			},
+		},
+		{
+			name: "Korea substitutes weekends and shared days",
+			code: "KR",
+			year: 2025,
+			want: []string{
+				"2025-01-01 New Year's Day",
+				"2025-01-27 Temporary Holiday",
+				"2025-01-28 Seollal",
+				"2025-01-29 Seollal",
+				"2025-01-30 Seollal",
+				"2025-03-01 Independence Movement Day",
+				"2025-03-03 Independence Movement Day (observed)",
+				"2025-05-05 Children's Day",
+				"2025-05-05 Buddha's Birthday",
+				"2025-05-06 Children's Day (observed)",
+				"2025-06-03 Presidential Election Day",
+				"2025-06-06 Memorial Day",
+				"2025-08-15 Liberation Day",
+				"2025-10-03 National Foundation Day",
+				"2025-10-05 Chuseok",
+				"2025-10-06 Chuseok",
+				"2025-10-07 Chuseok",
+				"2025-10-08 Chuseok (observed)",
+				"2025-10-09 Hangul Day",
+				"2025-12-25 Christmas Day",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := names(t, tt.code, tt.year)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("For(%s, %d) =\n%s\nwant\n%s", tt.code, tt.year, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestForSpecialDates(t *testing.T) {
+	tests := []struct {
+		code string
+		year int
+		want string
+	}{
+		// New Year's Day 2022 fell on a Saturday and was observed in 2021
+		{"US", 2021, "2021-12-31 New Year's Day (observed)"},
+		{"GB", 2020, "2020-05-08 Early May bank holiday"},
+		{"JP", 2019, "2019-04-30 Citizens' Holiday"},
+		{"JP", 2021, "2021-07-23 Sports Day"},
+		{"JP", 2021, "2021-08-09 Mountain Day (observed)"},
+		{"NL", 2025, "2025-04-26 King's Day"},
+		{"IE", 2026, "2026-02-02 Saint Brigid's Day"},
+		{"IE", 2030, "2030-02-01 Saint Brigid's Day"},
+		{"CA", 2026, "2026-05-18 Victoria Day"},
+		{"MX", 2030, "2030-10-01 Presidential Inauguration"},
+		{"AU", 2026, "2026-01-26 Australia Day"},
+		{"IT", 2026, "2026-10-04 Saint Francis of Assisi Day"},
+		{"GB-NIR", 2026, "2026-07-13 Battle of the Boyne (observed)"},
+		// Chuseok shared its first day with National Foundation Day
+		{"KR", 2017, "2017-10-06 Chuseok (observed)"},
+		{"DE-SN", 2026, "2026-11-18 Repentance and Prayer Day"},
+		{"DE", 1994, "1994-11-16 Repentance and Prayer Day"},
+		{"DE-HH", 2018, "2018-10-31 Reformation Day"},
+		// Before the 1970s reforms
+		{"JP", 1950, "1950-03-21 Vernal Equinox Day"},
+		{"JP", 1950, "1950-04-29 Emperor's Birthday"},
+		{"JP", 1973, "1973-04-30 Emperor's Birthday (observed)"},
+		{"JP", 1988, "1988-05-04 Citizens' Holiday"},
+		{"JP", 1995, "1995-10-10 Health and Sports Day"},
+		{"US", 1975, "1975-10-27 Veterans Day"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code+" "+tt.want, func(t *testing.T) {
+			got := names(t, tt.code, tt.year)
+			for _, line := range got {
+				if line == tt.want {
+					return
+				}
+			}
+			t.Errorf("For(%s, %d) has no %q:\n%s", tt.code, tt.year, tt.want, strings.Join(got, "\n"))
+		})
+	}
+}
+
+func TestForBeforeReforms(t *testing.T) {
+	tests := []struct {
+		code   string
+		year   int
+		absent string
+	}{
+		// Substitute days began in April 1973 and Citizens' Holidays in 1986
+		{"JP", 1950, "1950-01-02 New Year's Day (observed)"},
+		{"JP", 1973, "1973-02-12 National Foundation Day (observed)"},
+		{"JP", 1985, "1985-05-04 Citizens' Holiday"},
+		{"US", 1975, "1975-11-11 Veterans Day"},
+	}
+	for _, tt := range tests {
+		if slices.Contains(names(t, tt.code, tt.year), tt.absent) {
+			t.Errorf("For(%s, %d) has %q", tt.code, tt.year, tt.absent)
+		}
+	}
+}
+
+func TestForYears(t *testing.T) {
+	tests := []struct {
+		code string
+		year int
+		want string
+	}{
+		{"US", 1970, "United States holidays are only computed from 1971 on, not for 1970"},
+		{"JP", 1948, "Japan holidays are only computed from 1949 to 2099, not for 1948"},
+		{"JP", 2100, "from 1949 to 2099"},
+		{"DE-BY", 1990, "Germany holidays are only computed from 1991 on"},
+	}
+	for _, tt := range tests {
+		if _, err := For(tt.code, tt.year); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("For(%s, %d) error = %v, want %q", tt.code, tt.year, err, tt.want)
+		}
+	}
+	if from, to, err := Years("jp"); from != 1949 || to != 2099 || err != nil {
+		t.Errorf("Years(jp) = %d, %d, %v", from, to, err)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	list, err := Between("FR", time.Date(2026, time.December, 20, 23, 0, 0, 0, time.UTC), time.Date(2027, time.January, 1, 8, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Between() error = %v", err)
+	}
+	if len(list) != 2 || list[0].LocalName != "Noël" || list[1].Date != date(2027, time.January, 1) {
+		t.Errorf("Between() = %+v, want Noël and Jour de l'an", list)
+	}
+}
+
+func TestForUnknownCountry(t *testing.T) {
+	_, err := For("ZZ", 2026)
+	if err == nil || !strings.Contains(err.Error(), `no holiday calendar for country "ZZ"`) {
+		t.Errorf("expected an unknown country error, got %v", err)
+	}
+	if CountryName("jp") != "Japan" || CountryName("ZZ") != "" {
+		t.Errorf("unexpected CountryName results")
+	}
+}
+
+func TestLunar(t *testing.T) {
+	// Chinese New Year, and Seollal where the Korean meridian moves the new moon a day
+	tests := []struct {
+		year    int
+		chinese string
+		korean  string
+	}{
+		{1985, "1985-02-20", "1985-02-20"},
+		{1988, "1988-02-17", "1988-02-18"},
+		{1997, "1997-02-07", "1997-02-08"},
+		{2020, "2020-01-25", "2020-01-25"},
+		{2023, "2023-01-22", "2023-01-22"},
+		{2027, "2027-02-06", "2027-02-07"},
+		{2033, "2033-01-31", "2033-01-31"},
+		{2034, "2034-02-19", "2034-02-19"},
+	}
+	for _, tt := range tests {
+		cny, _ := chinese(1, 1)(tt.year)
+		seollal, _ := korean(1, 1)(tt.year)
+		if cny.Format(time.DateOnly) != tt.chinese || seollal.Format(time.DateOnly) != tt.korean {
+			t.Errorf("new year %d = %s and %s, want %s and %s", tt.year, cny.Format(time.DateOnly), seollal.Format(time.DateOnly), tt.chinese, tt.korean)
+		}
+	}
+
+	// Mid-Autumn Festival 2025 follows a leap sixth month
+	if d, _ := chinese(8, 15)(2025); d != date(2025, time.October, 6) {
+		t.Errorf("Mid-Autumn Festival 2025 = %s, want 2025-10-06", d.Format(time.DateOnly))
+	}
+	if _, ok := chinese(11, 1)(2025); ok {
+		t.Errorf("expected months after the 10th to be unsupported")
+	}
+}
+
+func TestSubdivisions(t *testing.T) {
+	subdivisions := Subdivisions("gb")
+	if len(subdivisions) != 4 || subdivisions["GB-SCT"] != "Scotland" {
+		t.Errorf("Subdivisions(gb) = %v", subdivisions)
+	}
+	if len(Subdivisions("DE")) != 16 || len(Subdivisions("FR")) != 0 {
+		t.Errorf("unexpected subdivision counts")
+	}
+
+	tests := map[string]string{
+		"DE-XX":  `unknown subdivision "DE-XX" of Germany: expected one of DE-BB`,
+		"FR-IDF": "no subdivision holidays for France",
+	}
+	for code, want := range tests {
+		if _, err := For(code, 2026); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("For(%s) error = %v, want %q", code, err, want)
+		}
+	}
+
+	list, _ := For("DE-BY", 2026)
+	for _, h := range list {
+		if h.Regional != (h.Name == "Epiphany" || h.Name == "Corpus Christi" || h.Name == "All Saints' Day") {
+			t.Errorf("%s has Regional = %v", h.Name, h.Regional)
+		}
+	}
+}
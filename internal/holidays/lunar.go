@@ -0,0 +1,240 @@
+package holidays
+
+import (
+	"math"
+	"time"
+)
+
+// The Chinese lunisolar calendar, and the Korean one derived from it, start each month on
+// the day of a new moon and number the months by the solar terms: the month holding the
+// winter solstice is the 11th, and in a year of 13 months the first month without a
+// major solar term (中气) is a leap month repeating the number of the month before. The
+// days depend on the meridian the calendar uses: UTC+8 in China and UTC+9 in Korea.
+// New moons follow Meeus, Astronomical Algorithms, chapter 49, and the Sun's longitude
+// the low-accuracy series of chapter 25, both well within a minute or so of the exact
+// values over the years this package is used for.
+
+const (
+	j2000          = 2451545.0    // Julian Ephemeris Day of 2000-01-01 12:00 TT
+	unixEpochJD    = 2440587.5    // Julian Day of 1970-01-01 00:00 UTC
+	synodicMonth   = 29.530588861 // mean days from new moon to new moon
+	tropicalYear   = 365.242189
+	firstNewMoonJD = 2451550.09766 // new moon 0 of chapter 49, 2000-01-06
+)
+
+// lunar is day of month (not a leap month) in the lunisolar year that starts in a
+// Gregorian year, on the calendar of the meridian offsetHours east of Greenwich. Only the
+// months 1 to 10 are supported, as they always fall in the Gregorian year they start in.
+func lunar(offsetHours, month, day int) dateFunc {
+	return func(year int) (time.Time, bool) {
+		start, ok := lunarMonth(year, month, offsetHours)
+		if !ok {
+			return time.Time{}, false
+		}
+		return start.AddDate(0, 0, day-1), true
+	}
+}
+
+// shift moves the date of a holiday by a number of days, as for the eve of a festival.
+func shift(f dateFunc, days int) dateFunc {
+	return func(year int) (time.Time, bool) {
+		d, ok := f(year)
+		return d.AddDate(0, 0, days), ok
+	}
+}
+
+// solarTerm is the day the Sun reaches an apparent ecliptic longitude, in degrees, on the
+// meridian offsetHours east of Greenwich: Qingming (清明) is solarTerm(15, 8).
+func solarTerm(longitude float64, offsetHours int) dateFunc {
+	return func(year int) (time.Time, bool) {
+		// The Sun is at longitude 0 (the March equinox) around 20 March
+		guess := julianDay(date(year, time.March, 20)) + longitude/360*tropicalYear
+		return localDay(sunReaches(longitude, guess), offsetHours), true
+	}
+}
+
+// lunarMonth returns the first day of a month 1 to 10 of the lunisolar year starting in
+// year.
+func lunarMonth(year, month, offsetHours int) (time.Time, bool) {
+	if month < 1 || month > 10 {
+		return time.Time{}, false
+	}
+	// The 11th months holding the solstices of the year before and of this year bound
+	// the months 12 to 10 in between
+	first := newMoonOnOrBefore(localDay(sunReaches(270, julianDay(date(year-1, time.December, 21))), offsetHours), offsetHours)
+	last := newMoonOnOrBefore(localDay(sunReaches(270, julianDay(date(year, time.December, 21))), offsetHours), offsetHours)
+
+	starts := make([]time.Time, 0, last-first+1)
+	for k := first; k <= last; k++ {
+		starts = append(starts, localDay(newMoon(k), offsetHours))
+	}
+	leap := -1
+	if len(starts) == 14 {
+		for i := 1; i < len(starts)-1; i++ {
+			if !hasMajorTerm(starts[i], starts[i+1], offsetHours) {
+				leap = i
+				break
+			}
+		}
+	}
+
+	number := 11
+	for i := 1; i < len(starts)-1; i++ {
+		if i == leap {
+			continue
+		}
+		number = number%12 + 1
+		if number == month {
+			return starts[i], true
+		}
+	}
+	return time.Time{}, false
+}
+
+// hasMajorTerm reports whether the Sun crosses a multiple of 30° of longitude, a major
+// solar term, between the local midnights starting the days from and to.
+func hasMajorTerm(from, to time.Time, offsetHours int) bool {
+	at := func(d time.Time) int {
+		jd := julianDay(d) - float64(offsetHours)/24
+		return int(sunLongitude(jd+deltaT(jd)) / 30)
+	}
+	return at(from) != at(to)
+}
+
+// newMoonOnOrBefore returns the number of the last new moon falling on or before a local
+// day.
+func newMoonOnOrBefore(day time.Time, offsetHours int) int {
+	k := int(math.Floor((julianDay(day)-firstNewMoonJD)/synodicMonth)) + 1
+	for localDay(newMoon(k), offsetHours).After(day) {
+		k--
+	}
+	return k
+}
+
+// localDay returns the date, as midnight UTC, of a Julian Day in UTC on the meridian
+// offsetHours east of Greenwich.
+func localDay(jd float64, offsetHours int) time.Time {
+	seconds := (jd - unixEpochJD + float64(offsetHours)/24) * 86400
+	return Day(time.Unix(int64(math.Floor(seconds)), 0).UTC())
+}
+
+// julianDay returns the Julian Day of a time.
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/86400 + unixEpochJD
+}
+
+// sunReaches returns the Julian Day in UTC when the Sun's apparent longitude reaches a
+// value, searching from a guess within a few weeks of it.
+func sunReaches(longitude, guess float64) float64 {
+	jd := guess
+	for range 10 {
+		diff := math.Mod(longitude-sunLongitude(jd+deltaT(jd))+540, 360) - 180
+		jd += diff / 360 * tropicalYear
+		if math.Abs(diff) < 1e-7 {
+			break
+		}
+	}
+	return jd
+}
+
+// sunLongitude returns the Sun's apparent ecliptic longitude in degrees, in [0, 360), at
+// a Julian Ephemeris Day.
+func sunLongitude(jde float64) float64 {
+	t := (jde - j2000) / 36525
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := radians(357.52911 + 35999.05029*t - 0.0001537*t*t)
+	c := (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m) +
+		(0.019993-0.000101*t)*math.Sin(2*m) +
+		0.000289*math.Sin(3*m)
+	omega := radians(125.04 - 1934.136*t)
+	return math.Mod(l0+c-0.00569-0.00478*math.Sin(omega)+360*100, 360)
+}
+
+// newMoon returns the Julian Day in UTC of new moon k, counted from the new moon of
+// 2000-01-06.
+func newMoon(k int) float64 {
+	kf := float64(k)
+	t := kf / 1236.85
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+	jde := firstNewMoonJD + synodicMonth*kf + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := radians(2.5534 + 29.10535670*kf - 0.0000014*t2 - 0.00000011*t3)
+	mp := radians(201.5643 + 385.81693528*kf + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4)
+	f := radians(160.7108 + 390.67050284*kf - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4)
+	omega := radians(124.7746 - 1.56375588*kf + 0.0020672*t2 + 0.00000215*t3)
+
+	jde += -0.40720*math.Sin(mp) +
+		0.17241*e*math.Sin(m) +
+		0.01608*math.Sin(2*mp) +
+		0.01039*math.Sin(2*f) +
+		0.00739*e*math.Sin(mp-m) -
+		0.00514*e*math.Sin(mp+m) +
+		0.00208*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) -
+		0.00057*math.Sin(mp+2*f) +
+		0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) +
+		0.00042*e*math.Sin(m+2*f) +
+		0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) -
+		0.00017*math.Sin(omega) -
+		0.00007*math.Sin(mp+2*m) +
+		0.00004*math.Sin(2*mp-2*f) +
+		0.00004*math.Sin(3*m) +
+		0.00003*math.Sin(mp+m-2*f) +
+		0.00003*math.Sin(2*mp+2*f) -
+		0.00003*math.Sin(mp+m+2*f) +
+		0.00003*math.Sin(mp-m+2*f) -
+		0.00002*math.Sin(mp-m-2*f) -
+		0.00002*math.Sin(3*mp+m) +
+		0.00002*math.Sin(4*mp)
+
+	// Planetary arguments
+	for i, a := range [14][3]float64{
+		{299.77, 0.107408, 0.000325},
+		{251.88, 0.016321, 0.000165},
+		{251.83, 26.651886, 0.000164},
+		{349.42, 36.412478, 0.000126},
+		{84.66, 18.206239, 0.000110},
+		{141.74, 53.303771, 0.000062},
+		{207.14, 2.453732, 0.000060},
+		{154.84, 7.306860, 0.000056},
+		{34.52, 27.261239, 0.000047},
+		{207.19, 0.121824, 0.000042},
+		{291.34, 1.844379, 0.000040},
+		{161.72, 24.198154, 0.000037},
+		{239.56, 25.513099, 0.000035},
+		{331.55, 3.592518, 0.000023},
+	} {
+		arg := a[0] + a[1]*kf
+		if i == 0 {
+			arg -= 0.009173 * t2
+		}
+		jde += a[2] * math.Sin(radians(arg))
+	}
+	return jde - deltaT(jde)
+}
+
+// deltaT returns TT - UT in days, from the polynomials of Espenak and Meeus.
+func deltaT(jd float64) float64 {
+	y := 2000 + (jd-j2000)/365.25
+	var seconds float64
+	switch t := y - 2000; {
+	case y < 1986:
+		u := (y - 1820) / 100
+		seconds = -20 + 32*u*u
+	case y < 2005:
+		seconds = 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		seconds = 62.92 + 0.32217*t + 0.005589*t*t
+	default:
+		u := (y - 1820) / 100
+		seconds = -20 + 32*u*u - 0.5628*(2150-y)
+	}
+	return seconds / 86400
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,161 @@
+package holidays
+
+import (
+	"slices"
+	"time"
+)
+
+// dateFunc returns the date of a holiday in a year, and false when it does not fall
+// in that year.
+type dateFunc func(year int) (time.Time, bool)
+
+// observance moves a holiday that falls on a weekend to a day off.
+type observance int
+
+const (
+	// none keeps the holiday on its date, even on a weekend
+	none observance = iota
+	// nearestWeekday observes a Saturday holiday on the Friday before and a Sunday
+	// holiday on the Monday after, as US federal holidays are.
+	nearestWeekday
+	// substitute observes a Saturday or Sunday holiday on the next weekday that is not
+	// already a holiday, as UK bank holidays are.
+	substitute
+	// sundaySubstitute observes a Sunday holiday on the next day that is not already a
+	// holiday, as Japanese substitute holidays (振替休日) are.
+	sundaySubstitute
+	// sundayOrShared observes a holiday falling on a Sunday or on another holiday on the
+	// next day that is neither a Sunday nor a holiday, as Korea does for Seollal and
+	// Chuseok (대체공휴일).
+	sundayOrShared
+	// weekendOrShared is sundayOrShared for Saturdays too, as for the other Korean
+	// holidays with substitutes.
+	weekendOrShared
+)
+
+// rule defines a holiday: its English and local names, how its date is found, what
+// happens when it falls on a weekend (from observeFrom on, when set), the years it is in
+// force (0 for unbounded) and the subdivisions keeping it (all when empty).
+type rule struct {
+	name        string
+	local       string
+	date        dateFunc
+	observe     observance
+	observeFrom int
+	from        int
+	to          int
+	regions     []string
+}
+
+func (r rule) inForce(year int, region string) bool {
+	if len(r.regions) > 0 && !slices.Contains(r.regions, region) {
+		return false
+	}
+	return (r.from == 0 || year >= r.from) && (r.to == 0 || year <= r.to)
+}
+
+func (r rule) observance(year int) observance {
+	if year < r.observeFrom {
+		return none
+	}
+	return r.observe
+}
+
+// date returns midnight UTC on a date, the representation of days in this package.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// fixed is a holiday on the same date every year.
+func fixed(month time.Month, day int) dateFunc {
+	return func(year int) (time.Time, bool) {
+		return date(year, month, day), true
+	}
+}
+
+// nth is the nth weekday of a month, counting from the end when n is negative: nth(time.May,
+// time.Monday, -1) is the last Monday of May.
+func nth(month time.Month, weekday time.Weekday, n int) dateFunc {
+	return func(year int) (time.Time, bool) {
+		if n > 0 {
+			first := date(year, month, 1)
+			shift := (int(weekday) - int(first.Weekday()) + 7) % 7
+			return first.AddDate(0, 0, shift+7*(n-1)), true
+		}
+		last := date(year, month+1, 0)
+		shift := (int(last.Weekday()) - int(weekday) + 7) % 7
+		return last.AddDate(0, 0, -shift+7*(n+1)), true
+	}
+}
+
+// onOrBefore is the last given weekday on or before a date, such as Canada's Victoria
+// Day, the Monday on or before 24 May.
+func onOrBefore(month time.Month, day int, weekday time.Weekday) dateFunc {
+	return func(year int) (time.Time, bool) {
+		d := date(year, month, day)
+		return d.AddDate(0, 0, -((int(d.Weekday()) - int(weekday) + 7) % 7)), true
+	}
+}
+
+// easter is a holiday offset days from Western Easter Sunday.
+func easter(offset int) dateFunc {
+	return func(year int) (time.Time, bool) {
+		return Easter(year).AddDate(0, 0, offset), true
+	}
+}
+
+// once is a one-off holiday, such as a royal jubilee.
+func once(year int, month time.Month, day int) dateFunc {
+	return func(y int) (time.Time, bool) {
+		return date(year, month, day), y == year
+	}
+}
+
+// except moves a holiday to another date in the given years, or cancels it in years
+// mapped to the zero time.
+func except(f dateFunc, moved map[int]time.Time) dateFunc {
+	return func(year int) (time.Time, bool) {
+		if d, ok := moved[year]; ok {
+			return d, !d.IsZero()
+		}
+		return f(year)
+	}
+}
+
+// Easter returns Western (Gregorian) Easter Sunday, computed with the anonymous
+// Gregorian algorithm (Meeus/Jones/Butcher).
+func Easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
+
+// equinox is Japan's Vernal or Autumnal Equinox Day, from the approximations the
+// National Astronomical Observatory of Japan publishes for 1900 to 2099.
+func equinox(autumnal bool) dateFunc {
+	return func(year int) (time.Time, bool) {
+		if year < 1900 || year > 2099 {
+			return time.Time{}, false
+		}
+		// The 1900 to 1979 approximation counts leap years from 1983
+		vernal, autumn, leapDays := 20.8431, 23.2488, (year-1980)/4
+		if year < 1980 {
+			vernal, autumn, leapDays = 20.8357, 23.2588, (year-1983)/4
+		}
+		base, month := vernal, time.March
+		if autumnal {
+			base, month = autumn, time.September
+		}
+		day := int(base+0.242194*float64(year-1980)) - leapDays
+		return date(year, month, day), true
+	}
+}
@@ -0,0 +1,178 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/recurrence"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// Event is a VEVENT with its start and end resolved to instants.
+type Event struct {
+	UID      string
+	Summary  string
+	Location string
+	// AllDay is set for events whose DTSTART is a DATE. They start at midnight in the
+	// floating timezone.
+	AllDay bool
+	// Timezone names the zone Start and End are expressed in: the TZID, "UTC" for UTC
+	// times, or the floating timezone.
+	Timezone string
+	Start    timezone.LocalTime
+	End      timezone.LocalTime
+	// Recurrence lists the RRULE values of a recurring event.
+	Recurrence []string
+}
+
+// Calendar resolves the TZIDs of a parsed document: its own VTIMEZONE definitions
+// first, then IANA names.
+type Calendar struct {
+	root      *Component
+	locations map[string]*time.Location
+	// Custom lists the TZIDs defined by VTIMEZONE components.
+	Custom []string
+}
+
+// NewCalendar loads the VTIMEZONE definitions of a parsed document.
+func NewCalendar(root *Component) (*Calendar, error) {
+	cal := &Calendar{root: root, locations: make(map[string]*time.Location)}
+	var err error
+	root.Walk(func(c *Component) {
+		if c.Name != "VTIMEZONE" || err != nil {
+			return
+		}
+		var loc *time.Location
+		if loc, err = LoadVTimezone(c); err == nil {
+			cal.locations[loc.String()] = loc
+			cal.Custom = append(cal.Custom, loc.String())
+		}
+	})
+	return cal, err
+}
+
+// Location returns the location of a TZID. Names without a VTIMEZONE are read as IANA
+// names, ignoring a prefix such as "/mozilla.org/20050126_1/" before the zone.
+func (cal *Calendar) Location(tzid string) (*time.Location, error) {
+	if loc, ok := cal.locations[tzid]; ok {
+		return loc, nil
+	}
+	name := strings.Trim(tzid, "/")
+	for {
+		if now, err := timezone.GetNowInLocation(name); err == nil {
+			return now.Location(), nil
+		}
+		_, rest, ok := strings.Cut(name, "/")
+		if !ok || !strings.Contains(rest, "/") && rest != "UTC" {
+			return nil, fmt.Errorf("unknown TZID %q: no VTIMEZONE defines it and it is not an IANA timezone", tzid)
+		}
+		name = rest
+	}
+}
+
+// Events returns the document's VEVENTs. Floating times are read in floating.
+func (cal *Calendar) Events(floating *time.Location) ([]Event, error) {
+	var events []Event
+	var err error
+	n := 0
+	cal.root.Walk(func(c *Component) {
+		if c.Name != "VEVENT" || err != nil {
+			return
+		}
+		n++
+		var e Event
+		if e, err = cal.event(c, floating); err != nil {
+			err = fmt.Errorf("%s: %w", eventName(c, n), err)
+			return
+		}
+		events = append(events, e)
+	})
+	return events, err
+}
+
+// eventName identifies the nth VEVENT c in errors: by its UID, else its SUMMARY, else
+// its position.
+func eventName(c *Component, n int) string {
+	if uid := c.Text("UID"); uid != "" {
+		return fmt.Sprintf("event %q", uid)
+	}
+	if summary := c.Text("SUMMARY"); summary != "" {
+		return fmt.Sprintf("event %q", summary)
+	}
+	return fmt.Sprintf("event %d", n)
+}
+
+func (cal *Calendar) event(c *Component, floating *time.Location) (Event, error) {
+	e := Event{UID: c.Text("UID"), Summary: c.Text("SUMMARY"), Location: c.Text("LOCATION")}
+	for _, p := range c.All("RRULE") {
+		e.Recurrence = append(e.Recurrence, p.Value)
+	}
+
+	dtstart := c.Property("DTSTART")
+	if dtstart == nil {
+		return Event{}, fmt.Errorf("no DTSTART")
+	}
+	var err error
+	e.Timezone, e.Start, e.AllDay, err = cal.resolve(dtstart, floating)
+	if err != nil {
+		return Event{}, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+
+	switch dtend, dur := c.Property("DTEND"), c.Property("DURATION"); {
+	case dtend != nil:
+		if _, e.End, _, err = cal.resolve(dtend, floating); err != nil {
+			return Event{}, fmt.Errorf("invalid DTEND: %w", err)
+		}
+		e.End.Time = e.End.Time.In(e.Start.Time.Location())
+	case dur != nil:
+		d, err := duration.Parse(dur.Value)
+		if err != nil {
+			return Event{}, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		end, err := d.AddTo(e.Start.Time, duration.ModeCalendar)
+		if err != nil {
+			return Event{}, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		e.End = timezone.LocalTime{Time: end}
+	case e.AllDay:
+		// An all-day event without an end lasts the day
+		end, _ := duration.Duration{Days: 1}.AddTo(e.Start.Time, duration.ModeCalendar)
+		e.End = timezone.LocalTime{Time: end}
+	default:
+		e.End = timezone.LocalTime{Time: e.Start.Time}
+	}
+	if e.End.Time.Before(e.Start.Time) {
+		return Event{}, fmt.Errorf("ends before it starts")
+	}
+	return e, nil
+}
+
+// resolve reads a DATE or DATE-TIME property, returning the name of its zone and its
+// resolution on that zone's wall clock.
+func (cal *Calendar) resolve(p *Property, floating *time.Location) (string, timezone.LocalTime, bool, error) {
+	value := strings.TrimSpace(p.Value)
+	loc, tz := floating, floating.String()
+	if tzid := p.Params["TZID"]; tzid != "" && !strings.HasSuffix(value, "Z") {
+		var err error
+		if loc, err = cal.Location(tzid); err != nil {
+			return "", timezone.LocalTime{}, false, err
+		}
+		tz = tzid
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, _, err := recurrence.ParseDateTime(value, time.UTC)
+		return "UTC", timezone.LocalTime{Time: t}, false, err
+	}
+
+	wall, dateOnly, err := recurrence.ParseDateTime(value, time.UTC)
+	if err != nil {
+		return "", timezone.LocalTime{}, false, err
+	}
+	if dateOnly {
+		loc, tz = floating, floating.String()
+	}
+	local, err := timezone.ResolveLocalTime(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc, timezone.DisambiguateCompatible)
+	return tz, local, dateOnly, err
+}
@@ -0,0 +1,165 @@
+// Package ical reads and writes the parts of iCalendar (RFC 5545) that carry time:
+// VEVENT start and end times and the VTIMEZONE definitions they refer to.
+package ical
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Property is a content line such as "DTSTART;TZID=Europe/Paris:20260105T090000".
+type Property struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// Component is a BEGIN/END block such as VCALENDAR, VEVENT or VTIMEZONE.
+type Component struct {
+	Name       string
+	Properties []Property
+	Components []*Component
+}
+
+// Property returns the first property with the given name, or nil.
+func (c *Component) Property(name string) *Property {
+	for i := range c.Properties {
+		if c.Properties[i].Name == name {
+			return &c.Properties[i]
+		}
+	}
+	return nil
+}
+
+// All returns the properties with the given name.
+func (c *Component) All(name string) []Property {
+	var out []Property
+	for _, p := range c.Properties {
+		if p.Name == name {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// Text returns the unescaped value of a TEXT property, or "" when it is absent.
+func (c *Component) Text(name string) string {
+	p := c.Property(name)
+	if p == nil {
+		return ""
+	}
+	return unescapeText(p.Value)
+}
+
+// Walk calls fn for c and every component nested in it.
+func (c *Component) Walk(fn func(*Component)) {
+	fn(c)
+	for _, child := range c.Components {
+		child.Walk(fn)
+	}
+}
+
+// Parse parses an iCalendar document. The result is the outermost component, normally
+// VCALENDAR; bare VEVENT and VTIMEZONE blocks are wrapped in one.
+func Parse(text string) (*Component, error) {
+	root := &Component{Name: "VCALENDAR"}
+	stack := []*Component{root}
+	for n, line := range unfold(text) {
+		p, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+		top := stack[len(stack)-1]
+		switch p.Name {
+		case "BEGIN":
+			name := strings.ToUpper(p.Value)
+			if name == "VCALENDAR" && len(stack) == 1 && len(root.Properties) == 0 && len(root.Components) == 0 {
+				continue
+			}
+			c := &Component{Name: name}
+			top.Components = append(top.Components, c)
+			stack = append(stack, c)
+		case "END":
+			name := strings.ToUpper(p.Value)
+			if len(stack) == 1 {
+				if name == "VCALENDAR" {
+					continue
+				}
+				return nil, fmt.Errorf("line %d: END:%s without BEGIN", n+1, name)
+			}
+			if top.Name != name {
+				return nil, fmt.Errorf("line %d: END:%s inside %s", n+1, name, top.Name)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			top.Properties = append(top.Properties, p)
+		}
+	}
+	if len(stack) > 1 {
+		return nil, fmt.Errorf("%s is not closed with END:%s", stack[len(stack)-1].Name, stack[len(stack)-1].Name)
+	}
+	return root, nil
+}
+
+// unfold splits text into content lines, joining lines folded with a leading space or
+// tab.
+func unfold(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		// Trailing spaces are kept: they belong to the value when the next line continues it
+		if line = strings.TrimRight(line, "\r"); strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseLine splits a content line into name, parameters and value. Parameter values may
+// be quoted, and quoted values may contain ':' and ';'.
+func parseLine(line string) (Property, error) {
+	p := Property{Params: make(map[string]string)}
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return p, fmt.Errorf("invalid content line %q: expected NAME[;PARAM=VALUE]:VALUE", line)
+	}
+	p.Name = strings.ToUpper(line[:i])
+	for line[i] == ';' {
+		rest := line[i+1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return p, fmt.Errorf("invalid parameter in %q", line)
+		}
+		key := strings.ToUpper(rest[:eq])
+		j := eq + 1
+		var value strings.Builder
+		quoted := false
+		for ; j < len(rest); j++ {
+			c := rest[j]
+			if c == '"' {
+				quoted = !quoted
+				continue
+			}
+			if !quoted && (c == ';' || c == ':') {
+				break
+			}
+			value.WriteByte(c)
+		}
+		if j == len(rest) {
+			return p, fmt.Errorf("invalid content line %q: missing ':' before the value", line)
+		}
+		p.Params[key] = value.String()
+		i += 1 + j
+	}
+	p.Value = line[i+1:]
+	return p, nil
+}
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";")
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,353 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+
+	_ "time/tzdata"
+)
+
+const outlookCalendar = `BEGIN:VCALENDAR
+PRODID:-//Microsoft Corporation//Outlook 16.0 MIMEDIR//EN
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:W. Europe Standard Time
+BEGIN:STANDARD
+DTSTART:16011028T030000
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:16010325T020000
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:040000008200E00074C5B7101A82E008
+SUMMARY:Quarterly review\, Berlin
+LOCATION:Room 4
+DTSTART;TZID="W. Europe Standard Time":20260714T150000
+DTEND;TZID="W. Europe Standard Time":20260714T163000
+RRULE:FREQ=MONTHLY;INTERVAL=3;COUNT=4
+END:VEVENT
+END:VCALENDAR
+`
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestParse(t *testing.T) {
+	root, err := Parse("BEGIN:VEVENT\r\nSUMMARY:Long\r\n  title\r\nDTSTART;TZID=\"Europe/Paris\";X-A=\"a:b;c\":20260105T090000\r\nEND:VEVENT\r\n")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if root.Name != "VCALENDAR" || len(root.Components) != 1 {
+		t.Fatalf("expected a VCALENDAR wrapping one component, got %+v", root)
+	}
+	event := root.Components[0]
+	if got := event.Text("SUMMARY"); got != "Long title" {
+		t.Errorf("SUMMARY = %q, want %q", got, "Long title")
+	}
+	p := event.Property("DTSTART")
+	if p == nil || p.Params["TZID"] != "Europe/Paris" || p.Params["X-A"] != "a:b;c" || p.Value != "20260105T090000" {
+		t.Errorf("DTSTART = %+v", p)
+	}
+
+	for _, text := range []string{
+		"BEGIN:VEVENT\nEND:VTODO",
+		"BEGIN:VEVENT",
+		"END:VEVENT",
+		"DTSTART;TZID=Europe/Paris",
+	} {
+		if _, err := Parse(text); err == nil {
+			t.Errorf("Parse(%q) expected error", text)
+		}
+	}
+}
+
+func TestLoadVTimezone(t *testing.T) {
+	root, err := Parse(outlookCalendar)
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	loc, err := LoadVTimezone(root.Components[0])
+	if err != nil {
+		t.Fatalf("LoadVTimezone returned error: %v", err)
+	}
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	for _, at := range []time.Time{
+		time.Date(1990, time.February, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, time.March, 29, 0, 59, 59, 0, time.UTC),
+		time.Date(2026, time.March, 29, 1, 0, 0, 0, time.UTC),
+		time.Date(2026, time.October, 25, 0, 59, 59, 0, time.UTC),
+		time.Date(2026, time.October, 25, 1, 0, 0, 0, time.UTC),
+		time.Date(2150, time.July, 1, 12, 0, 0, 0, time.UTC),
+	} {
+		_, got := at.In(loc).Zone()
+		_, want := at.In(berlin).Zone()
+		if got != want {
+			t.Errorf("offset at %s = %d, want %d", at, got, want)
+		}
+	}
+	if loc.String() != "W. Europe Standard Time" {
+		t.Errorf("location name = %q", loc.String())
+	}
+}
+
+func TestEvents(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+
+	t.Run("custom VTIMEZONE", func(t *testing.T) {
+		events, custom := mustEvents(t, outlookCalendar, time.UTC)
+		if len(custom) != 1 || custom[0] != "W. Europe Standard Time" {
+			t.Errorf("custom timezones = %v", custom)
+		}
+		e := events[0]
+		if e.Summary != "Quarterly review, Berlin" || e.Location != "Room 4" || e.Timezone != "W. Europe Standard Time" {
+			t.Errorf("event = %+v", e)
+		}
+		if want := time.Date(2026, time.July, 14, 13, 0, 0, 0, time.UTC); !e.Start.Time.Equal(want) {
+			t.Errorf("start = %s, want %s", e.Start.Time, want)
+		}
+		if got := e.End.Time.Sub(e.Start.Time); got != 90*time.Minute {
+			t.Errorf("length = %s, want 1h30m", got)
+		}
+		if len(e.Recurrence) != 1 || e.Recurrence[0] != "FREQ=MONTHLY;INTERVAL=3;COUNT=4" {
+			t.Errorf("recurrence = %v", e.Recurrence)
+		}
+	})
+
+	tests := []struct {
+		name      string
+		event     string
+		timezone  string
+		start     time.Time
+		end       time.Time
+		allDay    bool
+		ambiguous bool
+	}{
+		{
+			name:     "IANA TZID",
+			event:    "DTSTART;TZID=America/New_York:20260310T090000\nDURATION:PT45M",
+			timezone: "America/New_York",
+			start:    time.Date(2026, time.March, 10, 13, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, time.March, 10, 13, 45, 0, 0, time.UTC),
+		},
+		{
+			name:     "prefixed TZID",
+			event:    "DTSTART;TZID=/mozilla.org/20050126_1/Asia/Tokyo:20260310T090000\nDTEND:20260310T010000Z",
+			timezone: "/mozilla.org/20050126_1/Asia/Tokyo",
+			start:    time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, time.March, 10, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "UTC",
+			event:    "DTSTART:20260310T090000Z",
+			timezone: "UTC",
+			start:    time.Date(2026, time.March, 10, 9, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, time.March, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "floating all-day",
+			event:    "DTSTART;VALUE=DATE:20260714",
+			timezone: "Europe/Paris",
+			start:    time.Date(2026, time.July, 13, 22, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, time.July, 14, 22, 0, 0, 0, time.UTC),
+			allDay:   true,
+		},
+		{
+			name:      "ambiguous wall time",
+			event:     "DTSTART:20261025T023000\nDURATION:PT1H",
+			timezone:  "Europe/Paris",
+			start:     time.Date(2026, time.October, 25, 0, 30, 0, 0, time.UTC),
+			end:       time.Date(2026, time.October, 25, 1, 30, 0, 0, time.UTC),
+			ambiguous: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, _ := mustEvents(t, "BEGIN:VEVENT\n"+tt.event+"\nEND:VEVENT", paris)
+			e := events[0]
+			if e.Timezone != tt.timezone {
+				t.Errorf("timezone = %q, want %q", e.Timezone, tt.timezone)
+			}
+			if !e.Start.Time.Equal(tt.start) || !e.End.Time.Equal(tt.end) {
+				t.Errorf("event = %s - %s, want %s - %s", e.Start.Time, e.End.Time, tt.start, tt.end)
+			}
+			if e.AllDay != tt.allDay || e.Start.Ambiguous != tt.ambiguous {
+				t.Errorf("allDay = %v, ambiguous = %v", e.AllDay, e.Start.Ambiguous)
+			}
+		})
+	}
+}
+
+func TestEventsErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		event string
+		want  string
+	}{
+		{"no start", "SUMMARY:x", `event "x": no DTSTART`},
+		{"named by UID", "UID:42@example.com\nSUMMARY:x", `event "42@example.com": no DTSTART`},
+		{"unnamed", "LOCATION:Room 4", "event 1: no DTSTART"},
+		{"unknown TZID", "DTSTART;TZID=Mars/Olympus:20260310T090000", "unknown TZID"},
+		{"bad duration", "DTSTART:20260310T090000Z\nDURATION:soon", "invalid DURATION"},
+		{"ends first", "DTSTART:20260310T090000Z\nDTEND:20260310T080000Z", "ends before it starts"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := Parse("BEGIN:VEVENT\n" + tt.event + "\nEND:VEVENT")
+			if err != nil {
+				t.Fatalf("Parse returned error: %v", err)
+			}
+			cal, err := NewCalendar(root)
+			if err != nil {
+				t.Fatalf("NewCalendar returned error: %v", err)
+			}
+			_, err = cal.Events(time.UTC)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func mustEvents(t *testing.T, text string, floating *time.Location) ([]Event, []string) {
+	t.Helper()
+	root, err := Parse(text)
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	cal, err := NewCalendar(root)
+	if err != nil {
+		t.Fatalf("NewCalendar returned error: %v", err)
+	}
+	events, err := cal.Events(floating)
+	if err != nil {
+		t.Fatalf("Events returned error: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected one event, got %d", len(events))
+	}
+	return events, cal.Custom
+}
+
+func TestGenerateVTimezone(t *testing.T) {
+	tests := []struct {
+		zone        string
+		first, last int
+		// regular zones keep the right offsets after the generated years
+		regular bool
+	}{
+		{"Europe/Paris", 2026, 2026, true},
+		{"America/New_York", 2005, 2008, true},
+		{"Australia/Lord_Howe", 2026, 2027, true},
+		{"Pacific/Chatham", 2026, 2026, true},
+		{"Asia/Tokyo", 2026, 2026, true},
+		{"UTC", 2026, 2026, true},
+		{"Africa/Casablanca", 2026, 2027, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc := mustLoadLocation(t, tt.zone)
+			root, err := Parse(GenerateVTimezone(tt.zone, loc, tt.first, tt.last).String())
+			if err != nil {
+				t.Fatalf("Parse returned error: %v", err)
+			}
+			got, err := LoadVTimezone(root.Components[0])
+			if err != nil {
+				t.Fatalf("LoadVTimezone returned error: %v", err)
+			}
+			end := tt.last + 1
+			if tt.regular {
+				end = tt.last + 15
+			}
+			for at := time.Date(tt.first, time.January, 1, 0, 0, 0, 0, loc); at.Year() < end; at = at.Add(time.Hour) {
+				_, want := at.In(loc).Zone()
+				if _, off := at.In(got).Zone(); off != want {
+					t.Fatalf("offset at %s = %d, want %d", at.In(loc), off, want)
+				}
+			}
+		})
+	}
+
+	vtz := GenerateVTimezone("America/New_York", mustLoadLocation(t, "America/New_York"), 2005, 2008).String()
+	for _, want := range []string{
+		"RRULE:FREQ=YEARLY;UNTIL=20060402T070000Z;BYMONTH=4;BYDAY=1SU\r\n",
+		"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU\r\n",
+		"TZOFFSETFROM:-0500\r\n",
+	} {
+		if !strings.Contains(vtz, want) {
+			t.Errorf("VTIMEZONE does not contain %q:\n%s", want, vtz)
+		}
+	}
+}
+
+func TestNewDocument(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	start := time.Date(2026, time.March, 27, 23, 30, 0, 0, paris)
+	stamp := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	events := []Event{{
+		UID:        "review@example.com",
+		Summary:    "Review; part 2, with a long title that needs folding across more than one content line é",
+		Timezone:   "Europe/Paris",
+		Start:      timezone.LocalTime{Time: start},
+		End:        timezone.LocalTime{Time: start.Add(2 * time.Hour)},
+		Recurrence: []string{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=14"},
+	}}
+	doc, err := NewDocument(events, stamp)
+	if err != nil {
+		t.Fatalf("NewDocument returned error: %v", err)
+	}
+	text := doc.String()
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line longer than %d octets: %q", maxLineOctets, line)
+		}
+	}
+	for _, want := range []string{
+		"DTSTART;TZID=Europe/Paris:20260327T233000\r\n",
+		"DTSTAMP:20260301T120000Z\r\n",
+		"RRULE:FREQ=MONTHLY;COUNT=14;BYDAY=-1FR\r\n",
+		"PRODID:" + ProdID + "\r\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("document does not contain %q:\n%s", want, text)
+		}
+	}
+
+	// The document reads back with its own VTIMEZONE in place of the IANA zone
+	parsed, custom := mustEvents(t, text, time.UTC)
+	e := parsed[0]
+	if len(custom) != 1 || e.Summary != events[0].Summary || e.UID != events[0].UID {
+		t.Errorf("round trip = %+v, custom timezones %v", e, custom)
+	}
+	if !e.Start.Time.Equal(start) || !e.End.Time.Equal(start.Add(2*time.Hour)) {
+		t.Errorf("round trip = %s - %s, want %s", e.Start.Time, e.End.Time, start)
+	}
+
+	for _, tt := range []struct {
+		event Event
+		want  string
+	}{
+		{Event{Summary: "x"}, `event "x" has no UID`},
+		{Event{}, "event 1 has no UID"},
+	} {
+		if _, err := NewDocument([]Event{tt.event}, stamp); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("expected error containing %q, got %v", tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package ical
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/recurrence"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// Bounds on the transitions generated from open-ended VTIMEZONE rules.
+const (
+	lastGeneratedYear = 2200
+	maxTransitions    = 5000
+)
+
+// zoneType is a UTC offset with its DST flag and abbreviation, as in a TZif file.
+type zoneType struct {
+	offset int
+	dst    bool
+	name   string
+}
+
+type transition struct {
+	at   time.Time
+	kind zoneType
+}
+
+// LoadVTimezone builds a location from a VTIMEZONE component, expanding the onsets of
+// its STANDARD and DAYLIGHT observances up to the year 2200. The location is named after
+// the TZID.
+func LoadVTimezone(c *Component) (*time.Location, error) {
+	tzid := ""
+	if p := c.Property("TZID"); p != nil {
+		tzid = p.Value
+	}
+	if tzid == "" {
+		return nil, fmt.Errorf("VTIMEZONE has no TZID")
+	}
+	end := time.Date(lastGeneratedYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var transitions []transition
+	var initial zoneType
+	var earliest time.Time
+	for _, obs := range c.Components {
+		if obs.Name != "STANDARD" && obs.Name != "DAYLIGHT" {
+			continue
+		}
+		from, err := observanceOffset(obs, "TZOFFSETFROM")
+		if err != nil {
+			return nil, fmt.Errorf("VTIMEZONE %q: %w", tzid, err)
+		}
+		to, err := observanceOffset(obs, "TZOFFSETTO")
+		if err != nil {
+			return nil, fmt.Errorf("VTIMEZONE %q: %w", tzid, err)
+		}
+		onsets, err := observanceOnsets(obs, from, end)
+		if err != nil {
+			return nil, fmt.Errorf("VTIMEZONE %q %s: %w", tzid, obs.Name, err)
+		}
+		kind := zoneType{offset: to, dst: obs.Name == "DAYLIGHT", name: obs.Text("TZNAME")}
+		for _, wall := range onsets {
+			at := wall.Add(-time.Duration(from) * time.Second)
+			transitions = append(transitions, transition{at: at, kind: kind})
+			if earliest.IsZero() || at.Before(earliest) {
+				earliest, initial = at, zoneType{offset: from}
+			}
+		}
+	}
+	if len(transitions) == 0 {
+		return nil, fmt.Errorf("VTIMEZONE %q has no STANDARD or DAYLIGHT observance", tzid)
+	}
+
+	slices.SortStableFunc(transitions, func(a, b transition) int { return a.at.Compare(b.at) })
+	transitions = slices.CompactFunc(transitions, func(a, b transition) bool { return a.at.Equal(b.at) })
+	// Before the first onset the zone is at its TZOFFSETFROM, named as the observance
+	// with that offset
+	for _, t := range transitions {
+		if t.kind.offset == initial.offset && !t.kind.dst {
+			initial.name = t.kind.name
+			break
+		}
+	}
+	return time.LoadLocationFromTZData(tzid, tzif(initial, transitions))
+}
+
+// observanceOffset parses a UTC offset property such as "+0100" or "-053000".
+func observanceOffset(obs *Component, name string) (int, error) {
+	p := obs.Property(name)
+	if p == nil {
+		return 0, fmt.Errorf("%s has no %s", obs.Name, name)
+	}
+	v := p.Value
+	if len(v) != 5 && len(v) != 7 || (v[0] != '+' && v[0] != '-') {
+		return 0, fmt.Errorf("invalid %s %q: expected +HHMM or +HHMMSS", name, v)
+	}
+	seconds := 0
+	for i, unit := range []int{3600, 60, 1}[:(len(v)-1)/2] {
+		n, err := strconv.Atoi(v[1+2*i : 3+2*i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q: expected +HHMM or +HHMMSS", name, v)
+		}
+		seconds += n * unit
+	}
+	if v[0] == '-' {
+		seconds = -seconds
+	}
+	return seconds, nil
+}
+
+// observanceOnsets returns the wall-clock onsets of an observance before end, held in
+// UTC. UNTIL, given in UTC, is moved onto the same wall clock using the offset the
+// onsets occur in.
+func observanceOnsets(obs *Component, from int, end time.Time) ([]time.Time, error) {
+	p := obs.Property("DTSTART")
+	if p == nil {
+		return nil, fmt.Errorf("no DTSTART")
+	}
+	start, _, err := recurrence.ParseDateTime(p.Value, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+	set := &recurrence.Set{Start: start}
+	for _, rp := range obs.All("RRULE") {
+		r, err := recurrence.ParseRule(rp.Value)
+		if err != nil {
+			return nil, err
+		}
+		if r.UntilUTC {
+			r.Until, r.UntilUTC = r.Until.Add(time.Duration(from)*time.Second), false
+		}
+		set.Rules = append(set.Rules, r)
+	}
+	for _, rp := range obs.All("RDATE") {
+		for _, v := range strings.Split(rp.Value, ",") {
+			t, _, err := recurrence.ParseDateTime(v, time.UTC)
+			if err != nil {
+				return nil, err
+			}
+			set.RDates = append(set.RDates, t)
+		}
+	}
+	onsets, _ := set.Occurrences(time.Time{}, end, maxTransitions)
+	return onsets, nil
+}
+
+// tzif encodes transitions as TZif version 2 data for time.LoadLocationFromTZData. The
+// version 1 block, which only holds 32-bit times, is left empty.
+func tzif(initial zoneType, transitions []transition) []byte {
+	kinds := []zoneType{initial}
+	indices := make([]byte, len(transitions))
+	for i, t := range transitions {
+		k := slices.Index(kinds, t.kind)
+		if k < 0 {
+			k = len(kinds)
+			kinds = append(kinds, t.kind)
+		}
+		indices[i] = byte(k)
+	}
+	var chars []byte
+	nameIndex := make([]byte, len(kinds))
+	for i, k := range kinds {
+		name := k.name
+		if name == "" {
+			name = strings.ReplaceAll(timeutil.FormatUTCOffset(k.offset), ":", "")
+		}
+		nameIndex[i] = byte(len(chars))
+		chars = append(append(chars, name...), 0)
+	}
+
+	var b bytes.Buffer
+	header := func(timecnt, typecnt, charcnt int) {
+		b.WriteString("TZif2")
+		b.Write(make([]byte, 15))
+		for _, n := range []int{0, 0, 0, timecnt, typecnt, charcnt} {
+			_ = binary.Write(&b, binary.BigEndian, uint32(n))
+		}
+	}
+	header(0, 0, 0)
+	header(len(transitions), len(kinds), len(chars))
+	for _, t := range transitions {
+		_ = binary.Write(&b, binary.BigEndian, t.at.Unix())
+	}
+	b.Write(indices)
+	for i, k := range kinds {
+		_ = binary.Write(&b, binary.BigEndian, int32(k.offset))
+		isDST := byte(0)
+		if k.dst {
+			isDST = 1
+		}
+		b.Write([]byte{isDST, nameIndex[i]})
+	}
+	b.Write(chars)
+	return b.Bytes()
+}
+
+// observance is a run of transitions with the same offsets and abbreviation, one a year
+// on the same rule, written as one STANDARD or DAYLIGHT component.
+type observance struct {
+	kind   zoneType
+	from   int
+	onsets []time.Time // wall-clock onsets in the from offset, held in UTC
+	// positions holds the weekday positions in the month every onset satisfies: bit n
+	// for the nth weekday, bit 0 for the last.
+	positions uint
+	open      bool // the zone keeps following the rule after the generated years
+}
+
+// GenerateVTimezone returns a VTIMEZONE named tzid describing loc from the offset in
+// force at the start of year first to the end of year last. Transitions that follow a
+// yearly rule are written as an RRULE, left open-ended when the zone still follows it
+// after last so that later occurrences keep the right offset.
+func GenerateVTimezone(tzid string, loc *time.Location, first, last int) *Component {
+	start := time.Date(first, time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(last+1, time.January, 1, 0, 0, 0, 0, loc)
+	vtz := &Component{Name: "VTIMEZONE", Properties: []Property{{Name: "TZID", Value: tzid}}}
+
+	transitions := timezone.PreviousTransitions(start, 1)
+	for cur := start; len(transitions) < maxTransitions; {
+		next := timezone.NextTransitions(cur, 1)
+		if len(next) == 0 || !next[0].At.Before(end) {
+			break
+		}
+		transitions = append(transitions, next[0])
+		cur = next[0].At
+	}
+	if len(transitions) == 0 {
+		// The zone never changed: one observance with a fixed offset
+		name, offset := start.Zone()
+		vtz.Components = append(vtz.Components, observanceComponent(&observance{
+			kind:   zoneType{offset: offset, name: name},
+			from:   offset,
+			onsets: []time.Time{time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		}))
+		return vtz
+	}
+
+	var observances []*observance
+	latest := make(map[zoneType]*observance)
+	for _, tr := range transitions {
+		kind, wall := transitionKind(tr)
+		if o := latest[kind]; o != nil && o.from == tr.OffsetBefore && o.follows(wall) {
+			o.onsets = append(o.onsets, wall)
+			o.positions &= weekdayPositions(wall)
+			continue
+		}
+		o := &observance{kind: kind, from: tr.OffsetBefore, onsets: []time.Time{wall}, positions: weekdayPositions(wall)}
+		observances = append(observances, o)
+		latest[kind] = o
+	}
+	// A run stays open when the zone's next transition of the same kind follows it
+	for _, tr := range timezone.NextTransitions(end.Add(-time.Nanosecond), 4) {
+		kind, wall := transitionKind(tr)
+		if o := latest[kind]; o != nil && o.from == tr.OffsetBefore && o.follows(wall) {
+			o.open = true
+			o.positions &= weekdayPositions(wall)
+		}
+		delete(latest, kind)
+	}
+
+	for _, o := range observances {
+		vtz.Components = append(vtz.Components, observanceComponent(o))
+	}
+	return vtz
+}
+
+// transitionKind returns the zone type a transition enters and its wall-clock onset.
+func transitionKind(tr timezone.Transition) (zoneType, time.Time) {
+	kind := zoneType{offset: tr.OffsetAfter, dst: tr.At.IsDST(), name: tr.AbbreviationAfter}
+	wall := tr.At.UTC().Add(time.Duration(tr.OffsetBefore) * time.Second)
+	return kind, wall
+}
+
+// follows reports whether wall is the next yearly onset of the run: a year after its
+// last one, in the same month, on the same weekday position and at the same time.
+func (o *observance) follows(wall time.Time) bool {
+	prev := o.onsets[len(o.onsets)-1]
+	return wall.Year() == prev.Year()+1 && wall.Month() == prev.Month() &&
+		wall.Weekday() == prev.Weekday() && wall.Sub(wall.Truncate(24*time.Hour)) == prev.Sub(prev.Truncate(24*time.Hour)) &&
+		o.positions&weekdayPositions(wall) != 0
+}
+
+// weekdayPositions returns the positions of the day among the same weekdays of its
+// month: bit n for the nth, bit 0 when it is the last.
+func weekdayPositions(wall time.Time) uint {
+	positions := uint(1) << ((wall.Day()-1)/7 + 1)
+	if wall.Day()+7 > duration.DaysIn(wall.Year(), wall.Month()) {
+		positions |= 1
+	}
+	return positions
+}
+
+func observanceComponent(o *observance) *Component {
+	name := "STANDARD"
+	if o.kind.dst {
+		name = "DAYLIGHT"
+	}
+	c := &Component{Name: name, Properties: []Property{
+		{Name: "DTSTART", Value: o.onsets[0].Format("20060102T150405")},
+		{Name: "TZOFFSETFROM", Value: formatOffset(o.from)},
+		{Name: "TZOFFSETTO", Value: formatOffset(o.kind.offset)},
+	}}
+	if o.kind.name != "" {
+		c.Properties = append(c.Properties, Property{Name: "TZNAME", Value: o.kind.name})
+	}
+	if len(o.onsets) > 1 || o.open {
+		// The last weekday of the month is preferred when both positions fit
+		n := -1
+		if o.positions&1 == 0 {
+			n = bits.TrailingZeros(o.positions)
+		}
+		first := o.onsets[0]
+		rule := &recurrence.Rule{
+			Freq:    recurrence.Yearly,
+			ByMonth: []int{int(first.Month())},
+			ByDay:   []recurrence.WeekdayNum{{Weekday: first.Weekday(), N: n}},
+			Wkst:    time.Monday,
+		}
+		if !o.open {
+			last := o.onsets[len(o.onsets)-1]
+			rule.Until, rule.UntilUTC = last.Add(-time.Duration(o.from)*time.Second), true
+		}
+		c.Properties = append(c.Properties, Property{Name: "RRULE", Value: rule.String()})
+	}
+	return c
+}
+
+// formatOffset formats a UTC offset in seconds as "+0100", or "+013045" when it has
+// seconds.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, offset/3600, offset%3600/60, offset%60)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset%3600/60)
+}
@@ -0,0 +1,182 @@
+package ical
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/recurrence"
+)
+
+const (
+	// ProdID identifies the documents written by this package.
+	ProdID = "-//mcp-time//mcp-time//EN"
+	// maxLineOctets is the longest content line RFC 5545 allows before folding.
+	maxLineOctets = 75
+	// maxCoveredYears bounds the years a generated VTIMEZONE spells out for one event.
+	// Open-ended rules continue past them.
+	maxCoveredYears = 50
+)
+
+// NewDocument returns a VCALENDAR holding events and a generated VTIMEZONE for each
+// TZID they use, covering the years from an event's start to its last occurrence.
+// Events are written in the location of their start, named by their Timezone; UTC
+// times are written with a "Z" suffix and all-day events as dates. stamp is the
+// DTSTAMP.
+func NewDocument(events []Event, stamp time.Time) (*Component, error) {
+	doc := &Component{Name: "VCALENDAR", Properties: []Property{
+		{Name: "VERSION", Value: "2.0"},
+		{Name: "PRODID", Value: ProdID},
+		{Name: "CALSCALE", Value: "GREGORIAN"},
+	}}
+
+	type span struct {
+		loc         *time.Location
+		first, last int
+	}
+	var tzids []string
+	spans := make(map[string]*span)
+	var vevents []*Component
+	for i, e := range events {
+		if e.UID == "" && e.Summary == "" {
+			return nil, fmt.Errorf("event %d has no UID", i+1)
+		}
+		if e.UID == "" {
+			return nil, fmt.Errorf("event %q has no UID", e.Summary)
+		}
+		c := &Component{Name: "VEVENT", Properties: []Property{
+			{Name: "UID", Value: e.UID},
+			{Name: "DTSTAMP", Value: stamp.UTC().Format("20060102T150405Z")},
+		}}
+		start, end := e.Start.Time, e.End.Time.In(e.Start.Time.Location())
+		switch {
+		case e.AllDay:
+			c.Properties = append(c.Properties,
+				Property{Name: "DTSTART", Params: map[string]string{"VALUE": "DATE"}, Value: start.Format("20060102")},
+				Property{Name: "DTEND", Params: map[string]string{"VALUE": "DATE"}, Value: end.Format("20060102")})
+		case e.Timezone == "UTC" || start.Location() == time.UTC:
+			c.Properties = append(c.Properties,
+				Property{Name: "DTSTART", Value: start.UTC().Format("20060102T150405Z")},
+				Property{Name: "DTEND", Value: end.UTC().Format("20060102T150405Z")})
+		default:
+			tzid := map[string]string{"TZID": e.Timezone}
+			c.Properties = append(c.Properties,
+				Property{Name: "DTSTART", Params: tzid, Value: start.Format("20060102T150405")},
+				Property{Name: "DTEND", Params: tzid, Value: end.Format("20060102T150405")})
+			last, err := lastYear(e)
+			if err != nil {
+				return nil, err
+			}
+			s := spans[e.Timezone]
+			if s == nil {
+				s = &span{loc: start.Location(), first: start.Year(), last: last}
+				spans[e.Timezone] = s
+				tzids = append(tzids, e.Timezone)
+			}
+			s.first, s.last = min(s.first, start.Year()), max(s.last, last)
+		}
+		for _, p := range []Property{{Name: "SUMMARY", Value: e.Summary}, {Name: "LOCATION", Value: e.Location}} {
+			if p.Value != "" {
+				p.Value = escapeText(p.Value)
+				c.Properties = append(c.Properties, p)
+			}
+		}
+		for _, rrule := range e.Recurrence {
+			r, err := recurrence.ParseRule(rrule)
+			if err != nil {
+				return nil, err
+			}
+			c.Properties = append(c.Properties, Property{Name: "RRULE", Value: r.String()})
+		}
+		vevents = append(vevents, c)
+	}
+
+	for _, tzid := range tzids {
+		s := spans[tzid]
+		doc.Components = append(doc.Components, GenerateVTimezone(tzid, s.loc, s.first, s.last))
+	}
+	doc.Components = append(doc.Components, vevents...)
+	return doc, nil
+}
+
+// lastYear returns the year the event's last occurrence ends in, at most
+// maxCoveredYears after its start. Open-ended rules count as ending in the first year.
+func lastYear(e Event) (int, error) {
+	start, end := e.Start.Time, e.End.Time.In(e.Start.Time.Location())
+	last := end.Year()
+	limit := start.Year() + maxCoveredYears
+	for _, rrule := range e.Recurrence {
+		r, err := recurrence.ParseRule(rrule)
+		if err != nil {
+			return 0, err
+		}
+		if r.Count == 0 && r.Until.IsZero() {
+			continue
+		}
+		for t := range r.Occurrences(start) {
+			if t.Year() > limit {
+				break
+			}
+			last = max(last, t.Add(end.Sub(start)).Year())
+		}
+	}
+	return min(last, limit), nil
+}
+
+// String encodes c as iCalendar text with CRLF line endings, folding lines longer
+// than 75 octets.
+func (c *Component) String() string {
+	var b strings.Builder
+	c.write(&b)
+	return b.String()
+}
+
+func (c *Component) write(b *strings.Builder) {
+	writeLine(b, "BEGIN:"+c.Name)
+	for _, p := range c.Properties {
+		var line strings.Builder
+		line.WriteString(p.Name)
+		keys := make([]string, 0, len(p.Params))
+		for k := range p.Params {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			v := p.Params[k]
+			if strings.ContainsAny(v, ":;,") {
+				v = `"` + v + `"`
+			}
+			line.WriteString(";" + k + "=" + v)
+		}
+		line.WriteString(":" + p.Value)
+		writeLine(b, line.String())
+	}
+	for _, child := range c.Components {
+		child.write(b)
+	}
+	writeLine(b, "END:"+c.Name)
+}
+
+// writeLine writes a content line, folded so that no line exceeds 75 octets and no
+// UTF-8 sequence is split.
+func writeLine(b *strings.Builder, line string) {
+	width := maxLineOctets
+	for len(line) > width {
+		cut := width
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space
+		width = maxLineOctets - 1
+	}
+	b.WriteString(line + "\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,212 @@
+// Package locale localizes times with embedded CLDR data: month and day names, the
+// full, long, medium and short date and time formats, and the display names of
+// timezones, which CLDR groups into metazones such as "Europe_Central".
+package locale
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/timefmt"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// The CLDR extracts are embedded so that localization works offline. metazones.json maps
+// each metazone to its zones; the other files hold one locale each.
+//
+//go:embed data/*.json
+var dataFS embed.FS
+
+// Style is the length of a localized date and time.
+type Style string
+
+// CLDR format lengths.
+const (
+	Full   Style = "full"
+	Long   Style = "long"
+	Medium Style = "medium"
+	Short  Style = "short"
+)
+
+// Styles lists the format lengths, longest first.
+var Styles = []Style{Full, Long, Medium, Short}
+
+// Locale is the CLDR data of a language.
+type Locale struct {
+	Tag string
+	// Names holds the month, day and day period names, and the zone display names.
+	Names   timefmt.Names
+	formats map[Style]*timefmt.Formatter
+	// gmtFormat and gmtZeroFormat name zones without a display name by their offset
+	gmtFormat     string
+	gmtZeroFormat string
+	// zones maps metazones, and zones named apart from their metazone, to their standard
+	// and daylight names
+	zones map[string][]string
+}
+
+// localeData is the layout of a locale file.
+type localeData struct {
+	Months          [12]string          `json:"months"`
+	MonthsAbbr      [12]string          `json:"months_abbr"`
+	Days            [7]string           `json:"days"`
+	DaysAbbr        [7]string           `json:"days_abbr"`
+	AM              string              `json:"am"`
+	PM              string              `json:"pm"`
+	DateFormats     map[Style]string    `json:"date_formats"`
+	TimeFormats     map[Style]string    `json:"time_formats"`
+	DateTimeFormats map[Style]string    `json:"datetime_formats"`
+	GMTFormat       string              `json:"gmt_format"`
+	GMTZeroFormat   string              `json:"gmt_zero_format"`
+	Zones           map[string][]string `json:"zones"`
+}
+
+var (
+	loadOnce sync.Once
+	locales  map[string]*Locale
+	// metazones maps zone names to their metazone
+	metazones map[string]string
+)
+
+// load reads the embedded data. The files ship with the binary, so a file that does not
+// load is a build error and panics.
+func load() {
+	loadOnce.Do(func() {
+		var groups map[string][]string
+		if err := readJSON("metazones.json", &groups); err != nil {
+			panic(err)
+		}
+		metazones = make(map[string]string)
+		for metazone, names := range groups {
+			for _, name := range names {
+				metazones[name] = metazone
+			}
+		}
+
+		entries, err := dataFS.ReadDir("data")
+		if err != nil {
+			panic(err)
+		}
+		locales = make(map[string]*Locale)
+		for _, entry := range entries {
+			if entry.Name() == "metazones.json" {
+				continue
+			}
+			tag := strings.TrimSuffix(entry.Name(), ".json")
+			l, err := newLocale(tag)
+			if err != nil {
+				panic(fmt.Sprintf("locale %s: %v", tag, err))
+			}
+			locales[tag] = l
+		}
+	})
+}
+
+func readJSON(name string, v any) error {
+	b, err := dataFS.ReadFile(path.Join("data", name))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func newLocale(tag string) (*Locale, error) {
+	var d localeData
+	if err := readJSON(tag+".json", &d); err != nil {
+		return nil, err
+	}
+	l := &Locale{
+		Tag: tag,
+		Names: timefmt.Names{
+			Months:      d.Months,
+			ShortMonths: d.MonthsAbbr,
+			Days:        d.Days,
+			ShortDays:   d.DaysAbbr,
+			AM:          d.AM,
+			PM:          d.PM,
+		},
+		formats:       make(map[Style]*timefmt.Formatter),
+		gmtFormat:     d.GMTFormat,
+		gmtZeroFormat: d.GMTZeroFormat,
+		zones:         d.Zones,
+	}
+	l.Names.ZoneName = l.ZoneName
+	for _, style := range Styles {
+		// {1} is the date and {0} the time, as in CLDR dateTimeFormats
+		pattern := strings.NewReplacer("{1}", d.DateFormats[style], "{0}", d.TimeFormats[style]).Replace(d.DateTimeFormats[style])
+		f, err := timefmt.Compile(pattern, timefmt.Java)
+		if err != nil {
+			return nil, fmt.Errorf("%s format: %w", style, err)
+		}
+		l.formats[style] = f
+	}
+	return l, nil
+}
+
+// Tags lists the supported locales.
+func Tags() []string {
+	load()
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// Lookup returns the locale of a BCP 47 tag such as "fr", "pt-BR" or "ja_JP". Regional
+// variants fall back to their language.
+func Lookup(tag string) (*Locale, error) {
+	load()
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if l, ok := locales[normalized]; ok {
+		return l, nil
+	}
+	language, _, _ := strings.Cut(normalized, "-")
+	if l, ok := locales[language]; ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("unsupported locale %q: expected one of %s", tag, strings.Join(Tags(), ", "))
+}
+
+// Format formats t in the locale's date and time format of the given length.
+func (l *Locale) Format(t time.Time, style Style) string {
+	return l.formats[style].FormatNames(t, &l.Names)
+}
+
+// ZoneName returns the display name of t's zone, such as "heure d’été d’Europe
+// centrale", choosing the daylight name during DST. Zones without a name are named by
+// their offset, as in "GMT+05:45".
+func (l *Locale) ZoneName(t time.Time) string {
+	zone := t.Location().String()
+	names, ok := l.zones[zone]
+	if !ok {
+		names, ok = l.zones[metazones[zone]]
+	}
+	if ok && len(names) > 0 {
+		if len(names) > 1 && timezone.IsDST(t) {
+			return names[1]
+		}
+		return names[0]
+	}
+	if _, offset := t.Zone(); offset == 0 {
+		return l.gmtZeroFormat
+	}
+	return strings.Replace(l.gmtFormat, "{0}", t.Format("-07:00"), 1)
+}
+
+// DayName returns the name of t's weekday.
+func (l *Locale) DayName(t time.Time) string {
+	return l.Names.Days[t.Weekday()]
+}
+
+// MonthName returns the name of t's month.
+func (l *Locale) MonthName(t time.Time) string {
+	return l.Names.Months[t.Month()-1]
+}
@@ -0,0 +1,125 @@
+package locale
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestFormat(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	winter := time.Date(2026, time.February, 9, 14, 5, 7, 0, paris)
+	summer := time.Date(2026, time.July, 14, 9, 30, 0, 0, paris)
+
+	tests := []struct {
+		tag   string
+		t     time.Time
+		style Style
+		want  string
+	}{
+		{"fr", winter, Full, "lundi 9 février 2026 à 14:05:07 heure normale d’Europe centrale"},
+		{"fr", summer, Long, "14 juillet 2026 à 09:30:00 CEST"},
+		{"fr", winter, Short, "09/02/2026 14:05"},
+		{"en", winter, Full, "Monday, February 9, 2026 at 2:05:07 PM Central European Standard Time"},
+		{"en", summer, Medium, "Jul 14, 2026, 9:30:00 AM"},
+		{"en", winter, Short, "2/9/26, 2:05 PM"},
+		{"de", summer, Full, "Dienstag, 14. Juli 2026 um 09:30:00 Mitteleuropäische Sommerzeit"},
+		{"es", winter, Full, "lunes, 9 de febrero de 2026, 14:05:07 (hora estándar de Europa central)"},
+		{"pt-BR", summer, Full, "terça-feira, 14 de julho de 2026 09:30:00 Horário de Verão da Europa Central"},
+		{"ja", winter, Full, "2026年2月9日月曜日 14時05分07秒 中央ヨーロッパ標準時"},
+		{"ja_JP", summer, Short, "2026/07/14 9:30"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag+" "+string(tt.style), func(t *testing.T) {
+			l, err := Lookup(tt.tag)
+			if err != nil {
+				t.Fatalf("Lookup() error = %v", err)
+			}
+			if got := l.Format(tt.t, tt.style); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZoneName(t *testing.T) {
+	tests := []struct {
+		tag  string
+		zone string
+		t    time.Time
+		want string
+	}{
+		{"fr", "Europe/Paris", time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC), "heure normale d’Europe centrale"},
+		{"fr", "Europe/Paris", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), "heure d’été d’Europe centrale"},
+		{"en", "Europe/London", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), "British Summer Time"},
+		{"ja", "America/New_York", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), "アメリカ東部夏時間"},
+		{"pt", "America/Sao_Paulo", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), "Horário Padrão de Brasília"},
+		{"de", "UTC", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), "Koordinierte Weltzeit"},
+		{"en", "Asia/Kathmandu", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), "GMT+05:45"},
+		{"fr", "Atlantic/Azores", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), "UTC"},
+		// Casablanca is on +01 in both January and July, and on +00 during Ramadan
+		{"en", "Africa/Casablanca", time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC), "Western European Summer Time"},
+		{"en", "Africa/Casablanca", time.Date(2026, time.February, 25, 12, 0, 0, 0, time.UTC), "Western European Standard Time"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag+" "+tt.zone, func(t *testing.T) {
+			l, err := Lookup(tt.tag)
+			if err != nil {
+				t.Fatalf("Lookup() error = %v", err)
+			}
+			if got := l.ZoneName(tt.t.In(mustLoadLocation(t, tt.zone))); got != tt.want {
+				t.Errorf("ZoneName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	l, err := Lookup("PT")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	at := time.Date(2026, time.March, 4, 0, 0, 0, 0, time.UTC)
+	if l.DayName(at) != "quarta-feira" || l.MonthName(at) != "março" {
+		t.Errorf("got %q, %q, want quarta-feira, março", l.DayName(at), l.MonthName(at))
+	}
+}
+
+func TestLookupErrors(t *testing.T) {
+	_, err := Lookup("tlh")
+	if err == nil || !strings.Contains(err.Error(), "unsupported locale") || !strings.Contains(err.Error(), "fr") {
+		t.Errorf("expected an unsupported locale error listing the locales, got %v", err)
+	}
+}
+
+func TestEveryLocaleNamesEveryMetazone(t *testing.T) {
+	load()
+	used := make(map[string]bool)
+	for _, metazone := range metazones {
+		used[metazone] = true
+	}
+	for _, tag := range Tags() {
+		l, _ := Lookup(tag)
+		for metazone := range used {
+			if len(l.zones[metazone]) == 0 {
+				t.Errorf("locale %s has no name for metazone %s", tag, metazone)
+			}
+		}
+		for _, s := range append(l.Names.Months[:], l.Names.Days[:]...) {
+			if s == "" {
+				t.Errorf("locale %s has an empty month or day name", tag)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package meeting
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// Participant is an attendee with a daily working-hours window in their own timezone.
+type Participant struct {
+	Location *time.Location
+	// WorkStart and WorkEnd are wall-clock offsets from local midnight. A WorkEnd not after
+	// WorkStart describes an overnight window ending on the next day.
+	WorkStart time.Duration
+	WorkEnd   time.Duration
+	// WorkDays marks the weekdays on which the window starts, indexed by time.Weekday.
+	WorkDays [7]bool
+}
+
+// Slot is a meeting time that falls within every participant's working hours.
+type Slot struct {
+	Start time.Time
+	End   time.Time
+	// Score ranks how comfortably the slot sits inside everyone's day: 1 when it is centred
+	// in each working window, approaching 0 at the window edges.
+	Score float64
+}
+
+// Find returns the slots of the given length starting in [from, to) that every participant
+// can attend, best score first. Candidate starts are aligned to multiples of step in UTC.
+func Find(participants []Participant, from, to time.Time, length, step time.Duration) []Slot {
+	if len(participants) == 0 || length <= 0 || step <= 0 {
+		return nil
+	}
+
+	var slots []Slot
+	for start := from.UTC().Truncate(step); start.Before(to); start = start.Add(step) {
+		if start.Before(from) {
+			continue
+		}
+		end := start.Add(length)
+		total := 0.0
+		available := true
+		for _, p := range participants {
+			comfort, ok := p.fits(start, end)
+			if !ok {
+				available = false
+				break
+			}
+			total += comfort
+		}
+		if available {
+			score := math.Round(total/float64(len(participants))*100) / 100
+			slots = append(slots, Slot{Start: start, End: end, Score: score})
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool { return slots[i].Score > slots[j].Score })
+	return slots
+}
+
+// fits reports whether [start, end) lies within one of p's working windows and how
+// close to the window's centre it sits.
+func (p Participant) fits(start, end time.Time) (float64, bool) {
+	local := start.In(p.Location)
+	// An overnight window may have started on the previous local day
+	for _, dayOffset := range []int{0, -1} {
+		day := local.AddDate(0, 0, dayOffset)
+		if !p.WorkDays[day.Weekday()] {
+			continue
+		}
+		windowStart, windowEnd := p.window(day)
+		if start.Before(windowStart) || end.After(windowEnd) {
+			continue
+		}
+		half := windowEnd.Sub(windowStart).Seconds() / 2
+		centre := windowStart.Add(time.Duration(half * float64(time.Second)))
+		mid := start.Add(end.Sub(start) / 2)
+		return 1 - math.Abs(mid.Sub(centre).Seconds())/half, true
+	}
+	return 0, false
+}
+
+// window returns the working window starting on day's local date. Edges falling in a DST
+// gap or overlap are resolved with the compatible policy.
+func (p Participant) window(day time.Time) (time.Time, time.Time) {
+	endDay := day
+	if p.WorkEnd <= p.WorkStart {
+		endDay = day.AddDate(0, 0, 1)
+	}
+	return wallClock(day, p.WorkStart, p.Location), wallClock(endDay, p.WorkEnd, p.Location)
+}
+
+func wallClock(day time.Time, offset time.Duration, loc *time.Location) time.Time {
+	y, m, d := day.Date()
+	minutes := int(offset / time.Minute)
+	// 24:00 is midnight of the next day
+	if minutes >= 24*60 {
+		y, m, d = time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC).Date()
+		minutes -= 24 * 60
+	}
+	local, _ := timezone.ResolveLocalTime(y, m, d, minutes/60, minutes%60, 0, 0, loc, timezone.DisambiguateCompatible)
+	return local.Time
+}
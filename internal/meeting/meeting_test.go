@@ -0,0 +1,147 @@
+package meeting
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %s: %v", name, err)
+	}
+	return loc
+}
+
+var weekdays = [7]bool{time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true}
+
+func officeHours(loc *time.Location) Participant {
+	return Participant{Location: loc, WorkStart: 9 * time.Hour, WorkEnd: 17 * time.Hour, WorkDays: weekdays}
+}
+
+// earliestPerDay returns the earliest slot start for each UTC date.
+func earliestPerDay(slots []Slot) map[string]time.Time {
+	earliest := make(map[string]time.Time)
+	for _, s := range slots {
+		day := s.Start.Format("2006-01-02")
+		if cur, ok := earliest[day]; !ok || s.Start.Before(cur) {
+			earliest[day] = s.Start
+		}
+	}
+	return earliest
+}
+
+func TestFindAcrossDifferentDSTSchedules(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	ny := mustLoadLocation(t, "America/New_York")
+	participants := []Participant{officeHours(berlin), officeHours(ny)}
+
+	// New York moves to EDT on 2026-03-08, Berlin only on 2026-03-29
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	slots := Find(participants, from, to, 30*time.Minute, 30*time.Minute)
+	if len(slots) == 0 {
+		t.Fatal("expected slots")
+	}
+
+	earliest := earliestPerDay(slots)
+	// Before the US change the overlap is 14:00-16:00 UTC, after it 13:00-16:00 UTC
+	if got := earliest["2026-03-03"].Format("15:04"); got != "14:00" {
+		t.Errorf("earliest slot on 2026-03-03 = %s, want 14:00", got)
+	}
+	if got := earliest["2026-03-10"].Format("15:04"); got != "13:00" {
+		t.Errorf("earliest slot on 2026-03-10 = %s, want 13:00", got)
+	}
+	for _, s := range slots {
+		if wd := s.Start.In(berlin).Weekday(); wd == time.Saturday || wd == time.Sunday {
+			t.Errorf("slot on a weekend: %v", s.Start)
+		}
+		if s.End.Sub(s.Start) != 30*time.Minute {
+			t.Errorf("slot has wrong length: %v", s)
+		}
+		if s.End.UTC().Hour() > 16 || (s.End.UTC().Hour() == 16 && s.End.UTC().Minute() > 0) {
+			t.Errorf("slot ends after Berlin closes: %v", s.End.UTC())
+		}
+	}
+	for i := 1; i < len(slots); i++ {
+		if slots[i].Score > slots[i-1].Score {
+			t.Fatalf("slots not ranked by score at %d", i)
+		}
+	}
+}
+
+func TestFindNoOverlap(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	la := mustLoadLocation(t, "America/Los_Angeles")
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	if slots := Find([]Participant{officeHours(berlin), officeHours(la)}, from, to, time.Hour, 15*time.Minute); len(slots) != 0 {
+		t.Errorf("expected no common slot, got %d", len(slots))
+	}
+}
+
+func TestFindOvernightWindow(t *testing.T) {
+	night := Participant{
+		Location:  time.UTC,
+		WorkStart: 22 * time.Hour,
+		WorkEnd:   6 * time.Hour,
+		WorkDays:  [7]bool{time.Monday: true},
+	}
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC) // Monday
+	to := time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)
+	slots := Find([]Participant{night}, from, to, time.Hour, time.Hour)
+	if len(slots) != 8 {
+		t.Fatalf("expected 8 hourly slots in the Monday night shift, got %d", len(slots))
+	}
+	for _, s := range slots {
+		if s.Start.Before(time.Date(2026, 1, 5, 22, 0, 0, 0, time.UTC)) || s.End.After(time.Date(2026, 1, 6, 6, 0, 0, 0, time.UTC)) {
+			t.Errorf("slot outside the night shift: %v", s.Start)
+		}
+	}
+	// The best slots are centred on 02:00
+	if best := slots[0].Start.Format("15:04"); best != "01:00" && best != "02:00" {
+		t.Errorf("best slot starts at %s", best)
+	}
+}
+
+func TestFindWindowEndingAtMidnight(t *testing.T) {
+	evening := Participant{Location: time.UTC, WorkStart: 20 * time.Hour, WorkEnd: 24 * time.Hour, WorkDays: [7]bool{time.Monday: true}}
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC) // Monday
+	to := time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)
+	slots := Find([]Participant{evening}, from, to, time.Hour, time.Hour)
+	if len(slots) != 4 {
+		t.Fatalf("expected 4 hourly slots from 20:00 to midnight, got %d", len(slots))
+	}
+	for _, s := range slots {
+		if s.Start.Before(time.Date(2026, 1, 5, 20, 0, 0, 0, time.UTC)) || s.End.After(time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("slot outside 20:00 to midnight on Monday: %v", s.Start)
+		}
+	}
+}
+
+func TestFindFractionalOffsetAlignment(t *testing.T) {
+	kathmandu := mustLoadLocation(t, "Asia/Kathmandu")
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+	slots := Find([]Participant{officeHours(kathmandu)}, from, to, 30*time.Minute, 15*time.Minute)
+	if len(slots) == 0 {
+		t.Fatal("expected slots")
+	}
+	for _, s := range slots {
+		if s.Start.In(kathmandu).Hour() < 9 {
+			t.Errorf("slot before 09:00 local: %v", s.Start.In(kathmandu))
+		}
+	}
+}
+
+func TestFindInvalidArguments(t *testing.T) {
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	if got := Find(nil, from, to, time.Hour, time.Hour); got != nil {
+		t.Error("expected nil without participants")
+	}
+	if got := Find([]Participant{officeHours(time.UTC)}, from, to, 0, time.Hour); got != nil {
+		t.Error("expected nil for zero length")
+	}
+}
@@ -0,0 +1,591 @@
+package naturaltime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/duration"
+)
+
+func init() {
+	Register(English{})
+}
+
+// English parses English phrases such as "next Tuesday at 3pm Paris time", "tomorrow
+// morning", "in two weeks", "an hour and a half ago", "end of day Friday", "29 March
+// 2027 at noon" or "start of next month". A bare hour from 1 to 7 without am or pm, as
+// in "at 3", is read as afternoon. Text that is not a date or time is taken as the
+// timezone the phrase is expressed in.
+type English struct{}
+
+// Code implements Language.
+func (English) Code() string { return "en" }
+
+var (
+	enWeekdays = map[string]time.Weekday{
+		"monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday,
+		"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+		"friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+		"sunday": time.Sunday, "sun": time.Sunday,
+	}
+	enMonths = map[string]time.Month{
+		"january": time.January, "jan": time.January,
+		"february": time.February, "feb": time.February,
+		"march": time.March, "mar": time.March,
+		"april": time.April, "apr": time.April,
+		"may":  time.May,
+		"june": time.June, "jun": time.June,
+		"july": time.July, "jul": time.July,
+		"august": time.August, "aug": time.August,
+		"september": time.September, "sep": time.September, "sept": time.September,
+		"october": time.October, "oct": time.October,
+		"november": time.November, "nov": time.November,
+		"december": time.December, "dec": time.December,
+	}
+	enUnits = map[string]string{
+		"second": Second, "seconds": Second, "sec": Second, "secs": Second,
+		"minute": Minute, "minutes": Minute, "min": Minute, "mins": Minute,
+		"hour": Hour, "hours": Hour, "hr": Hour, "hrs": Hour,
+		"day": Day, "days": Day,
+		"week": Week, "weeks": Week, "wk": Week, "wks": Week,
+		"month": Month, "months": Month,
+		"year": Year, "years": Year, "yr": Year, "yrs": Year,
+	}
+	enNumbers = map[string]float64{
+		"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+		"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+		"fifteen": 15, "twenty": 20, "thirty": 30, "forty": 40, "forty-five": 45,
+		"fifty": 50, "sixty": 60, "ninety": 90,
+	}
+	// enRelative maps the words qualifying a weekday or period onto an offset
+	enRelative = map[string]int{
+		"this": 0, "coming": 0, "next": 1, "following": 1, "last": -1, "previous": -1, "past": -1,
+	}
+	// enDayParts maps parts of the day onto their default time
+	enDayParts = map[string]Clock{
+		"morning":   {Hour: 9},
+		"afternoon": {Hour: 15},
+		"evening":   {Hour: 18},
+		"night":     {Hour: 21},
+	}
+	// enFillers are skipped when they are not part of a recognized expression
+	enFillers = map[string]bool{
+		"at": true, "on": true, "in": true, "the": true, "of": true, "and": true, "by": true,
+		"around": true, "about": true, "time": true, "timezone": true,
+	}
+)
+
+var enClockPattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(?::(\d{2}))?(am|pm)?$`)
+
+type enToken struct {
+	raw  string // original spelling, kept for timezone names
+	word string // lowercased form used for matching
+}
+
+// enParser accumulates an Expression while matching tokens left to right.
+type enParser struct {
+	tokens []enToken
+	e      Expression
+	// The clock is finalized once the whole phrase is read, since a later part of the
+	// day ("at 7 in the evening") or a missing am/pm changes how its hour is read.
+	clock    *Clock
+	meridiem bool
+	bareHour bool
+	dayPart  string
+}
+
+// Parse implements Language.
+func (English) Parse(phrase string) (Expression, error) {
+	p := &enParser{tokens: tokenizeEnglish(phrase)}
+	matchers := []func(int) (int, error){
+		p.matchNow, p.matchRelativeDay, p.matchEdge, p.matchPeriod, p.matchWeekday,
+		p.matchDate, p.matchShift, p.matchClock, p.matchDayPart,
+	}
+
+	// Unrecognized runs of tokens are collected as the timezone name
+	var runs [][]enToken
+	inRun := false
+	for i := 0; i < len(p.tokens); {
+		matched := 0
+		for _, match := range matchers {
+			n, err := match(i)
+			if err != nil {
+				return Expression{}, err
+			}
+			if n > 0 {
+				matched = n
+				break
+			}
+		}
+		if matched > 0 {
+			i += matched
+			inRun = false
+			continue
+		}
+		if !inRun {
+			runs = append(runs, nil)
+			inRun = true
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], p.tokens[i])
+		i++
+	}
+
+	var zones []string
+	for _, run := range runs {
+		// Trim fillers such as "in Paris" or "Paris time"
+		for len(run) > 0 && enFillers[run[0].word] {
+			run = run[1:]
+		}
+		for len(run) > 0 && enFillers[run[len(run)-1].word] {
+			run = run[:len(run)-1]
+		}
+		if len(run) == 0 {
+			continue
+		}
+		raw := make([]string, len(run))
+		for i, tok := range run {
+			raw[i] = tok.raw
+		}
+		zones = append(zones, strings.Join(raw, " "))
+	}
+	switch len(zones) {
+	case 0:
+	case 1:
+		p.e.Zone = zones[0]
+	default:
+		return Expression{}, fmt.Errorf("could not understand %q", strings.Join(zones, `" and "`))
+	}
+
+	if err := p.finishClock(); err != nil {
+		return Expression{}, err
+	}
+	return p.e, nil
+}
+
+// tokenizeEnglish splits a phrase on spaces and punctuation, keeping clock times,
+// ISO dates, offsets and IANA names whole.
+func tokenizeEnglish(phrase string) []enToken {
+	fields := strings.FieldsFunc(phrase, func(r rune) bool {
+		switch r {
+		case ',', ';', '!', '?', '(', ')', '"':
+			return true
+		}
+		return r == ' ' || r == '\t' || r == '\n'
+	})
+	tokens := make([]enToken, 0, len(fields))
+	for _, f := range fields {
+		word := strings.ToLower(f)
+		switch word {
+		case "a.m.", "a.m":
+			word = "am"
+		case "p.m.", "p.m":
+			word = "pm"
+		default:
+			word = strings.TrimSuffix(word, ".")
+		}
+		tokens = append(tokens, enToken{raw: strings.TrimSuffix(f, "."), word: word})
+	}
+	return tokens
+}
+
+func (p *enParser) word(i int) string {
+	if i < len(p.tokens) {
+		return p.tokens[i].word
+	}
+	return ""
+}
+
+func (p *enParser) setDays(days int) error {
+	if p.e.Days != nil && *p.e.Days != days {
+		return fmt.Errorf("conflicting days in phrase")
+	}
+	p.e.Days = &days
+	return nil
+}
+
+func (p *enParser) setPeriod(period string, offset int, edge string) error {
+	if p.e.Period != "" {
+		return fmt.Errorf("conflicting periods in phrase")
+	}
+	p.e.Period, p.e.PeriodOffset, p.e.Edge = period, offset, edge
+	return nil
+}
+
+// matchNow matches "now" and "right now", which leave the reference time unchanged.
+func (p *enParser) matchNow(i int) (int, error) {
+	switch {
+	case p.word(i) == "now":
+		return 1, nil
+	case p.word(i) == "right" && p.word(i+1) == "now":
+		return 2, nil
+	}
+	return 0, nil
+}
+
+// matchRelativeDay matches "today", "tomorrow", "yesterday", "the day after tomorrow"
+// and "the day before yesterday".
+func (p *enParser) matchRelativeDay(i int) (int, error) {
+	j := i
+	if p.word(j) == "the" {
+		j++
+	}
+	days, n := 0, 0
+	switch w := p.word(j); {
+	case w == "today" && j == i:
+		days, n = 0, 1
+	case (w == "tomorrow" || w == "tmrw") && j == i:
+		days, n = 1, 1
+	case w == "yesterday" && j == i:
+		days, n = -1, 1
+	case w == "day" && p.word(j+1) == "after" && p.word(j+2) == "tomorrow":
+		days, n = 2, j-i+3
+	case w == "day" && p.word(j+1) == "before" && p.word(j+2) == "yesterday":
+		days, n = -2, j-i+3
+	default:
+		return 0, nil
+	}
+	return n, p.setDays(days)
+}
+
+// matchEdge matches "end of day", "EOD", "close of business", "start of the week",
+// "end of next month" and the like.
+func (p *enParser) matchEdge(i int) (int, error) {
+	switch p.word(i) {
+	case "eod", "cob":
+		return 1, p.setPeriod(Day, 0, EdgeEnd)
+	case "eow":
+		return 1, p.setPeriod(Week, 0, EdgeEnd)
+	case "eom":
+		return 1, p.setPeriod(Month, 0, EdgeEnd)
+	case "eoy":
+		return 1, p.setPeriod(Year, 0, EdgeEnd)
+	}
+	if (p.word(i) == "close" || p.word(i) == "end") && p.word(i+1) == "of" && p.word(i+2) == "business" {
+		return 3, p.setPeriod(Day, 0, EdgeEnd)
+	}
+
+	var edge string
+	switch p.word(i) {
+	case "end":
+		edge = EdgeEnd
+	case "start", "beginning":
+		edge = EdgeStart
+	default:
+		return 0, nil
+	}
+	if p.word(i+1) != "of" {
+		return 0, nil
+	}
+	j, offset := i+2, 0
+	if p.word(j) == "the" {
+		j++
+	} else if o, ok := enRelative[p.word(j)]; ok {
+		offset = o
+		j++
+	}
+	if p.word(j) == "business" || p.word(j) == "working" || p.word(j) == "work" {
+		j++
+	}
+	period, ok := enUnits[p.word(j)]
+	if !ok || (period != Day && period != Week && period != Month && period != Year) {
+		return 0, nil
+	}
+	return j - i + 1, p.setPeriod(period, offset, edge)
+}
+
+// matchPeriod matches "this week", "next month", "last year" and the like.
+func (p *enParser) matchPeriod(i int) (int, error) {
+	offset, ok := enRelative[p.word(i)]
+	if !ok {
+		return 0, nil
+	}
+	period, ok := enUnits[p.word(i+1)]
+	if !ok || (period != Week && period != Month && period != Year) {
+		return 0, nil
+	}
+	return 2, p.setPeriod(period, offset, "")
+}
+
+// matchWeekday matches a weekday optionally qualified as in "next Tuesday".
+func (p *enParser) matchWeekday(i int) (int, error) {
+	j, relative := i, 0
+	if r, ok := enRelative[p.word(j)]; ok {
+		relative = r
+		j++
+	}
+	day, ok := enWeekdays[p.word(j)]
+	if !ok {
+		return 0, nil
+	}
+	if p.e.Weekday != nil {
+		return 0, fmt.Errorf("conflicting weekdays in phrase")
+	}
+	p.e.Weekday = &WeekdayRef{Day: day, Relative: relative}
+	return j - i + 1, nil
+}
+
+// matchDate matches "2026-03-29", "March 29th", "March 29 2027" and "29th of March 2027".
+func (p *enParser) matchDate(i int) (int, error) {
+	var date Date
+	n := 0
+	if t, err := time.Parse("2006-01-02", p.word(i)); err == nil {
+		date, n = Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}, 1
+	} else if month, ok := enMonths[p.word(i)]; ok {
+		day, ok := enDayOfMonth(p.word(i + 1))
+		if !ok {
+			return 0, nil
+		}
+		date, n = Date{Month: month, Day: day}, 2
+	} else if day, ok := enDayOfMonth(p.word(i)); ok {
+		j := i + 1
+		if p.word(j) == "of" {
+			j++
+		}
+		month, ok := enMonths[p.word(j)]
+		if !ok {
+			return 0, nil
+		}
+		date, n = Date{Month: month, Day: day}, j-i+1
+	} else {
+		return 0, nil
+	}
+	if date.Year == 0 {
+		if year, err := strconv.Atoi(p.word(i + n)); err == nil && len(p.word(i+n)) == 4 {
+			date.Year = year
+			n++
+		}
+	}
+	if p.e.Date != nil {
+		return 0, fmt.Errorf("conflicting dates in phrase")
+	}
+	p.e.Date = &date
+	return n, nil
+}
+
+// enDayOfMonth parses "29", "29th", "1st", "2nd" or "3rd".
+func enDayOfMonth(word string) (int, bool) {
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		word = strings.TrimSuffix(word, suffix)
+	}
+	day, err := strconv.Atoi(word)
+	if err != nil || day < 1 || day > 31 || len(word) > 2 {
+		return 0, false
+	}
+	return day, true
+}
+
+// matchShift matches "in two weeks", "3 hours ago", "an hour and a half from now",
+// "in 2 days and 4 hours" and the like.
+func (p *enParser) matchShift(i int) (int, error) {
+	j, sign := i, 0
+	if p.word(j) == "in" || p.word(j) == "within" {
+		sign = 1
+		j++
+	}
+	var shift duration.Duration
+	n, err := p.quantities(j, &shift)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	j += n
+	switch {
+	case sign == 1:
+	case p.word(j) == "ago" || p.word(j) == "earlier" || p.word(j) == "before":
+		sign = -1
+		j++
+	case p.word(j) == "later" || p.word(j) == "hence":
+		sign = 1
+		j++
+	case p.word(j) == "from" && (p.word(j+1) == "now" || p.word(j+1) == "today"):
+		sign = 1
+		j += 2
+	default:
+		return 0, nil
+	}
+	if p.e.Shift != (duration.Duration{}) {
+		return 0, fmt.Errorf("conflicting offsets in phrase")
+	}
+	shift.Negative = sign < 0
+	p.e.Shift = shift
+	return j - i, nil
+}
+
+// quantities matches one or more "<quantity> <unit>" pairs joined by "and", adding
+// them to d. It returns the number of tokens consumed.
+func (p *enParser) quantities(i int, d *duration.Duration) (int, error) {
+	j := i
+	for {
+		k := j
+		if k > i && p.word(k) == "and" {
+			k++
+		}
+		qty, n := p.quantity(k)
+		if n == 0 {
+			break
+		}
+		unit, ok := enUnits[p.word(k+n)]
+		if !ok {
+			break
+		}
+		k += n + 1
+		// "an hour and a half"
+		if p.word(k) == "and" && p.word(k+1) == "a" && p.word(k+2) == "half" {
+			qty += 0.5
+			k += 3
+		}
+		if err := AddQuantity(d, qty, unit); err != nil {
+			return 0, err
+		}
+		j = k
+	}
+	return j - i, nil
+}
+
+// quantity matches "3", "1.5", "two", "a", "half a" or "a couple of".
+func (p *enParser) quantity(i int) (float64, int) {
+	switch {
+	case p.word(i) == "half" && (p.word(i+1) == "a" || p.word(i+1) == "an"):
+		return 0.5, 2
+	case p.word(i) == "a" && p.word(i+1) == "couple" && p.word(i+2) == "of":
+		return 2, 3
+	}
+	if qty, ok := enNumbers[p.word(i)]; ok {
+		return qty, 1
+	}
+	if w := p.word(i); w != "" && w[0] >= '0' && w[0] <= '9' {
+		if qty, err := strconv.ParseFloat(w, 64); err == nil {
+			return qty, 1
+		}
+	}
+	return 0, 0
+}
+
+// matchClock matches "3pm", "3:30 pm", "15:00", "at 3", "9 o'clock", "noon" and "midnight".
+func (p *enParser) matchClock(i int) (int, error) {
+	j, at := i, false
+	if p.word(j) == "at" || p.word(j) == "@" {
+		at = true
+		j++
+	}
+	var clock Clock
+	meridiem, bareHour := false, false
+	switch p.word(j) {
+	case "noon", "midday":
+		clock, meridiem = Clock{Hour: 12}, true
+		j++
+	case "midnight":
+		clock, meridiem = Clock{}, true
+		j++
+	default:
+		m := enClockPattern.FindStringSubmatch(p.word(j))
+		if m == nil {
+			return 0, nil
+		}
+		j++
+		suffix := m[4]
+		if suffix == "" && (p.word(j) == "am" || p.word(j) == "pm") {
+			suffix = p.word(j)
+			j++
+		}
+		oclock := false
+		if p.word(j) == "o'clock" || p.word(j) == "oclock" {
+			oclock = true
+			j++
+		}
+		if suffix == "" && m[2] == "" && !at && !oclock {
+			return 0, nil
+		}
+		clock.Hour, _ = strconv.Atoi(m[1])
+		clock.Minute, _ = strconv.Atoi(m[2])
+		clock.Second, _ = strconv.Atoi(m[3])
+		if suffix != "" {
+			if clock.Hour < 1 || clock.Hour > 12 {
+				return 0, fmt.Errorf("invalid time %q: hours run from 1 to 12 with am or pm", p.tokens[i].raw)
+			}
+			clock.Hour %= 12
+			if suffix == "pm" {
+				clock.Hour += 12
+			}
+			meridiem = true
+		}
+		bareHour = len(m[1]) == 1 && suffix == ""
+	}
+	if p.clock != nil {
+		return 0, fmt.Errorf("conflicting times of day in phrase")
+	}
+	p.clock, p.meridiem, p.bareHour = &clock, meridiem, bareHour
+	return j - i, nil
+}
+
+// matchDayPart matches "morning", "this afternoon", "in the evening", "at night",
+// "tonight" and "last night".
+func (p *enParser) matchDayPart(i int) (int, error) {
+	j := i
+	days := -1 // unset
+	switch {
+	case p.word(j) == "tonight":
+		return 1, p.setDayPart("night", 0)
+	case p.word(j) == "this":
+		days = 0
+		j++
+	case p.word(j) == "last" && p.word(j+1) == "night":
+		return 2, p.setDayPart("night", -1)
+	case p.word(j) == "in" && p.word(j+1) == "the":
+		j += 2
+	case p.word(j) == "at" && p.word(j+1) == "night":
+		j++
+	}
+	if _, ok := enDayParts[p.word(j)]; !ok {
+		return 0, nil
+	}
+	return j - i + 1, p.setDayPart(p.word(j), days)
+}
+
+// setDayPart records a part of the day, and the relative day it implies unless days is -1.
+func (p *enParser) setDayPart(part string, days int) error {
+	if p.dayPart != "" {
+		return fmt.Errorf("conflicting parts of the day in phrase")
+	}
+	p.dayPart = part
+	if days != -1 && p.e.Days == nil && p.e.Date == nil {
+		return p.setDays(days)
+	}
+	return nil
+}
+
+// finishClock sets the expression's clock from the matched time of day and part of day.
+func (p *enParser) finishClock() error {
+	if p.clock == nil {
+		if p.dayPart != "" {
+			clock := enDayParts[p.dayPart]
+			p.e.Clock = &clock
+		}
+		return nil
+	}
+	clock := *p.clock
+	if !p.meridiem && clock.Hour < 12 {
+		switch p.dayPart {
+		case "afternoon", "evening":
+			clock.Hour += 12
+		case "night":
+			if clock.Hour >= 6 {
+				clock.Hour += 12
+			}
+		case "":
+			if p.bareHour && clock.Hour >= 1 && clock.Hour <= 7 {
+				clock.Hour += 12
+			}
+		}
+	}
+	if clock.Hour > 23 || clock.Minute > 59 || clock.Second > 59 {
+		return fmt.Errorf("invalid time of day %02d:%02d", clock.Hour, clock.Minute)
+	}
+	p.e.Clock = &clock
+	return nil
+}
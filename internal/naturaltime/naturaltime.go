@@ -0,0 +1,483 @@
+// Package naturaltime resolves natural-language date and time phrases such as
+// "next Tuesday at 3pm Paris time", "tomorrow morning" or "in two weeks" against a
+// reference time. Each Language turns phrases into a language-neutral Expression,
+// which Resolve evaluates, so supporting another language only takes a new Language.
+package naturaltime
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/timeutil"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+	"github.com/r0mdau/mcp-time/internal/zones"
+)
+
+// Units of calendar periods and quantities. They double as the granularity of a Result.
+const (
+	Second = "second"
+	Minute = "minute"
+	Hour   = "hour"
+	Day    = "day"
+	Week   = "week"
+	Month  = "month"
+	Year   = "year"
+)
+
+// Edges of a period selected by Expression.Edge.
+const (
+	EdgeStart = "start"
+	EdgeEnd   = "end"
+)
+
+// DefaultLanguage is used when Parse is given no language.
+const DefaultLanguage = "en"
+
+// Business hours used for the start and end of a period, e.g. "end of day" is 17:00
+// and "start of next week" is Monday at 09:00.
+var (
+	startOfBusiness = Clock{Hour: 9}
+	closeOfBusiness = Clock{Hour: 17}
+)
+
+// minZoneScore is the lowest zones.Search score accepted for a zone named in a phrase.
+const minZoneScore = 0.8
+
+// Date is a calendar date. A zero Year means the reference year.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// Clock is a wall-clock time of day.
+type Clock struct {
+	Hour, Minute, Second int
+}
+
+// WeekdayRef selects a day of the week relative to a reference date.
+type WeekdayRef struct {
+	Day time.Weekday
+	// Relative is 0 for the upcoming occurrence including the reference date ("Tuesday"),
+	// 1 for the first one after it ("next Tuesday") and -1 for the last one before it.
+	Relative int
+}
+
+// Expression is the language-neutral reading of a phrase. Zero fields are unspecified.
+type Expression struct {
+	// Date is an absolute calendar date, e.g. "March 29".
+	Date *Date
+	// Days moves the reference date by whole days, e.g. 1 for "tomorrow".
+	Days *int
+	// Period and PeriodOffset select the period containing the reference date moved by
+	// PeriodOffset periods, e.g. Month and 1 for "next month". The result is the start
+	// of the period unless Edge or Weekday narrow it down.
+	Period       string
+	PeriodOffset int
+	// Edge selects the first or last day of Period at business hours. Working weeks end
+	// on Friday.
+	Edge string
+	// Weekday selects a day of the week relative to the reference date, or within the
+	// week when Period is Week.
+	Weekday *WeekdayRef
+	// Clock is an explicit time of day.
+	Clock *Clock
+	// Shift is applied last, e.g. "in two weeks" or "3 hours ago".
+	Shift duration.Duration
+	// Zone is the timezone the phrase is expressed in, e.g. "Paris time" or "PST".
+	// Empty means the reference timezone.
+	Zone string
+}
+
+// Language parses phrases of one natural language.
+type Language interface {
+	// Code is the ISO 639-1 code of the language, e.g. "en".
+	Code() string
+	Parse(phrase string) (Expression, error)
+}
+
+var languages = make(map[string]Language)
+
+// Register makes a language available to Parse. It is meant to be called from init
+// functions and is not safe for concurrent use.
+func Register(l Language) {
+	languages[strings.ToLower(l.Code())] = l
+}
+
+// Languages returns the codes of the registered languages, sorted.
+func Languages() []string {
+	codes := make([]string, 0, len(languages))
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// Result is a resolved phrase.
+type Result struct {
+	// Time is in the timezone named by the phrase, or in the reference timezone.
+	Time time.Time
+	// Granularity is the precision the phrase implies, e.g. Day for "tomorrow".
+	Granularity string
+	// Interpretation describes in English how the phrase was read.
+	Interpretation string
+	// Nonexistent and Ambiguous flag a wall-clock time that falls in a DST gap, moved
+	// forward by the length of the gap, or in an overlap, read as the earlier instant.
+	// Candidates then holds both instants of an ambiguous time, earliest first.
+	Nonexistent bool
+	Ambiguous   bool
+	Candidates  []time.Time
+}
+
+// Parse resolves phrase in the given language (DefaultLanguage when empty) relative to
+// now, whose location is the reference timezone. ISO 8601 datetimes are accepted in
+// every language.
+func Parse(phrase, lang string, now time.Time) (Result, error) {
+	phrase = strings.TrimSpace(phrase)
+	if phrase == "" {
+		return Result{}, fmt.Errorf("phrase is empty")
+	}
+	if lang == "" {
+		lang = DefaultLanguage
+	}
+	l, ok := languages[strings.ToLower(lang)]
+	if !ok {
+		return Result{}, fmt.Errorf("unsupported language %q: expected one of %s", lang, strings.Join(Languages(), ", "))
+	}
+
+	if t, hasOffset, err := timezone.ParseDateTime(phrase); err == nil {
+		if hasOffset {
+			t = t.In(now.Location())
+		} else {
+			local, err := timezone.ResolveLocalTime(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, now.Location(), timezone.DisambiguateCompatible)
+			if err != nil {
+				return Result{}, err
+			}
+			return Result{
+				Time:           local.Time,
+				Granularity:    Second,
+				Interpretation: "ISO 8601 datetime" + dstNote(local, t.Hour(), t.Minute()) + ": " + describeTime(local.Time, Second),
+				Nonexistent:    local.Nonexistent,
+				Ambiguous:      local.Ambiguous,
+				Candidates:     local.Candidates,
+			}, nil
+		}
+		return Result{Time: t, Granularity: Second, Interpretation: "ISO 8601 datetime: " + describeTime(t, Second)}, nil
+	}
+
+	expr, err := l.Parse(phrase)
+	if err != nil {
+		return Result{}, err
+	}
+	return Resolve(expr, now)
+}
+
+// Resolve evaluates e relative to now. Relative parts are taken from now's wall clock
+// in the expression's zone. Wall-clock times in a DST gap or overlap are resolved with
+// the compatible disambiguation policy, and flagged in the result.
+func Resolve(e Expression, now time.Time) (Result, error) {
+	loc := now.Location()
+	if e.Zone != "" {
+		var err error
+		if loc, err = LoadZone(e.Zone); err != nil {
+			return Result{}, err
+		}
+	}
+	now = now.In(loc)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	granularity := Second
+
+	if e.Date != nil {
+		year := e.Date.Year
+		if year == 0 {
+			year = now.Year()
+		}
+		day = time.Date(year, e.Date.Month, e.Date.Day, 0, 0, 0, 0, time.UTC)
+		if day.Day() != e.Date.Day || e.Date.Day < 1 {
+			return Result{}, fmt.Errorf("invalid date: %s has no day %d", e.Date.Month, e.Date.Day)
+		}
+		granularity = Day
+	}
+	if e.Days != nil {
+		day = day.AddDate(0, 0, *e.Days)
+		granularity = Day
+	}
+	weekdayBase := day
+	if e.Period != "" {
+		start, last, err := periodBounds(day, e.Period, e.PeriodOffset)
+		if err != nil {
+			return Result{}, err
+		}
+		day, weekdayBase = start, start
+		if e.Edge == EdgeEnd {
+			day = last
+		}
+		granularity = e.Period
+	}
+	if e.Weekday != nil {
+		if e.Period != Week {
+			weekdayBase = day
+		}
+		day = weekdayFrom(weekdayBase, *e.Weekday)
+		granularity = Day
+	}
+
+	hour, min, sec := now.Clock()
+	switch {
+	case e.Clock != nil:
+		if e.Clock.Hour < 0 || e.Clock.Hour > 23 || e.Clock.Minute < 0 || e.Clock.Minute > 59 || e.Clock.Second < 0 || e.Clock.Second > 59 {
+			return Result{}, fmt.Errorf("invalid time of day %02d:%02d:%02d", e.Clock.Hour, e.Clock.Minute, e.Clock.Second)
+		}
+		hour, min, sec = e.Clock.Hour, e.Clock.Minute, e.Clock.Second
+		granularity = Minute
+		if sec != 0 {
+			granularity = Second
+		}
+	case e.Edge == EdgeStart:
+		hour, min, sec = startOfBusiness.Hour, startOfBusiness.Minute, startOfBusiness.Second
+		granularity = Minute
+	case e.Edge == EdgeEnd:
+		hour, min, sec = closeOfBusiness.Hour, closeOfBusiness.Minute, closeOfBusiness.Second
+		granularity = Minute
+	case granularity != Second:
+		hour, min, sec = 0, 0, 0
+	}
+
+	// Now's own wall clock is no DST puzzle: keep its offset in an overlap
+	local := timezone.LocalTime{Time: now.Truncate(time.Second)}
+	if y, m, d := now.Date(); day != time.Date(y, m, d, 0, 0, 0, 0, time.UTC) || granularity != Second || e.Clock != nil {
+		var err error
+		if local, err = timezone.ResolveLocalTime(day.Year(), day.Month(), day.Day(), hour, min, sec, 0, loc, timezone.DisambiguateCompatible); err != nil {
+			return Result{}, err
+		}
+	}
+	t := local.Time
+	if e.Shift != (duration.Duration{}) {
+		var err error
+		if t, err = e.Shift.AddTo(t, duration.ModeCalendar); err != nil {
+			return Result{}, err
+		}
+	}
+
+	parts := e.describe(loc)
+	if len(parts) == 0 {
+		parts = []string{"now"}
+	}
+	return Result{
+		Time:           t,
+		Granularity:    granularity,
+		Interpretation: strings.Join(parts, ", ") + dstNote(local, hour, min) + ": " + describeTime(t, granularity),
+		Nonexistent:    local.Nonexistent,
+		Ambiguous:      local.Ambiguous,
+		Candidates:     local.Candidates,
+	}, nil
+}
+
+// dstNote tells how a wall-clock time in a DST gap or overlap was resolved, or is "".
+func dstNote(local timezone.LocalTime, hour, min int) string {
+	switch {
+	case local.Nonexistent:
+		return fmt.Sprintf(" (%02d:%02d is skipped by a DST change, moved forward to %s)", hour, min, local.Time.Format("15:04"))
+	case local.Ambiguous:
+		return fmt.Sprintf(" (%02d:%02d occurs twice at a DST change, the earlier is taken)", hour, min)
+	}
+	return ""
+}
+
+// LoadZone resolves a timezone named in a phrase: an IANA name, a UTC offset such as
+// "UTC+2", or anything zones.Search matches confidently, e.g. "Paris" or "PST".
+func LoadZone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name != "" && name != "Local" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc, nil
+		}
+	}
+	if upper := strings.ToUpper(name); strings.HasPrefix(upper, "UTC") || strings.HasPrefix(upper, "GMT") {
+		if offset, err := timeutil.ParseUTCOffset(name); err == nil {
+			if offset == 0 {
+				return time.UTC, nil
+			}
+			return time.FixedZone("UTC"+timeutil.FormatUTCOffset(offset), offset), nil
+		}
+	}
+	if c := zones.Search(name, 1); len(c) > 0 && c[0].Score >= minZoneScore {
+		return time.LoadLocation(c[0].Zone)
+	}
+	return nil, fmt.Errorf("could not understand %q as a date, time or timezone", name)
+}
+
+// AddQuantity adds qty of unit to d. Fractions of weeks, days and clock units are
+// converted to smaller units; fractions of years must be whole months and months must
+// be whole.
+func AddQuantity(d *duration.Duration, qty float64, unit string) error {
+	whole, frac := math.Modf(qty)
+	switch unit {
+	case Year:
+		months := frac * 12
+		if months != math.Trunc(months) {
+			return fmt.Errorf("%v years is not a whole number of months", qty)
+		}
+		d.Years += int(whole)
+		d.Months += int(months)
+	case Month:
+		if frac != 0 {
+			return fmt.Errorf("fractional months have no fixed length")
+		}
+		d.Months += int(whole)
+	case Week:
+		d.Weeks += int(whole)
+		return AddQuantity(d, frac*7, Day)
+	case Day:
+		d.Days += int(whole)
+		d.Clock += time.Duration(math.Round(frac * float64(24*time.Hour)))
+	case Hour:
+		d.Clock += time.Duration(math.Round(qty * float64(time.Hour)))
+	case Minute:
+		d.Clock += time.Duration(math.Round(qty * float64(time.Minute)))
+	case Second:
+		d.Clock += time.Duration(math.Round(qty * float64(time.Second)))
+	default:
+		return fmt.Errorf("unknown unit %q", unit)
+	}
+	return nil
+}
+
+// periodBounds returns the first and last day of the period containing day, moved by
+// offset periods. Weeks run from Monday to the end of the working week on Friday.
+func periodBounds(day time.Time, period string, offset int) (time.Time, time.Time, error) {
+	switch period {
+	case Day:
+		day = day.AddDate(0, 0, offset)
+		return day, day, nil
+	case Week:
+		monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7+7*offset)
+		return monday, monday.AddDate(0, 0, 4), nil
+	case Month:
+		first := time.Date(day.Year(), day.Month()+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+		return first, first.AddDate(0, 1, -1), nil
+	case Year:
+		first := time.Date(day.Year()+offset, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return first, first.AddDate(1, 0, -1), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("unknown period %q", period)
+}
+
+// weekdayFrom returns the date of ref's weekday relative to base.
+func weekdayFrom(base time.Time, ref WeekdayRef) time.Time {
+	ahead := (int(ref.Day) - int(base.Weekday()) + 7) % 7
+	switch {
+	case ref.Relative > 0 && ahead == 0:
+		ahead = 7
+	case ref.Relative < 0:
+		ahead -= 7
+	}
+	return base.AddDate(0, 0, ahead)
+}
+
+// describe lists the recognized parts of e in English.
+func (e Expression) describe(loc *time.Location) []string {
+	var parts []string
+	if e.Date != nil {
+		date := fmt.Sprintf("%d %s", e.Date.Day, e.Date.Month)
+		if e.Date.Year != 0 {
+			date += fmt.Sprintf(" %d", e.Date.Year)
+		}
+		parts = append(parts, date)
+	}
+	if e.Days != nil {
+		parts = append(parts, relativeName(Day, *e.Days))
+	}
+	if e.Period != "" {
+		name := relativeName(e.Period, e.PeriodOffset)
+		if e.Period == Day && e.PeriodOffset == 0 {
+			name = "the day"
+		}
+		switch e.Edge {
+		case EdgeStart:
+			name = "start of " + name
+		case EdgeEnd:
+			name = "end of " + name
+		}
+		parts = append(parts, name)
+	}
+	if e.Weekday != nil {
+		name := e.Weekday.Day.String()
+		switch {
+		case e.Weekday.Relative > 0:
+			name = "next " + name
+		case e.Weekday.Relative < 0:
+			name = "last " + name
+		}
+		parts = append(parts, name)
+	}
+	switch {
+	case e.Clock != nil:
+		parts = append(parts, "at "+formatClock(*e.Clock))
+	case e.Edge == EdgeStart:
+		parts = append(parts, "at "+formatClock(startOfBusiness)+" (start of business)")
+	case e.Edge == EdgeEnd:
+		parts = append(parts, "at "+formatClock(closeOfBusiness)+" (close of business)")
+	}
+	if e.Shift != (duration.Duration{}) {
+		if e.Shift.Negative {
+			parts = append(parts, e.Shift.Humanize()+" ago")
+		} else {
+			parts = append(parts, "in "+e.Shift.Humanize())
+		}
+	}
+	if e.Zone != "" {
+		zone := "in " + loc.String()
+		if !strings.EqualFold(e.Zone, loc.String()) {
+			zone += fmt.Sprintf(" (from %q)", e.Zone)
+		}
+		parts = append(parts, zone)
+	}
+	return parts
+}
+
+// relativeName names the period offset periods away, e.g. "next week" or "3 days ago".
+func relativeName(period string, offset int) string {
+	switch {
+	case period == Day && offset == 0:
+		return "today"
+	case period == Day && offset == 1:
+		return "tomorrow"
+	case period == Day && offset == -1:
+		return "yesterday"
+	case offset == 0:
+		return "this " + period
+	case offset == 1:
+		return "next " + period
+	case offset == -1:
+		return "last " + period
+	case offset > 0:
+		return fmt.Sprintf("in %d %ss", offset, period)
+	default:
+		return fmt.Sprintf("%d %ss ago", -offset, period)
+	}
+}
+
+func formatClock(c Clock) string {
+	if c.Second != 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", c.Hour, c.Minute, c.Second)
+	}
+	return fmt.Sprintf("%02d:%02d", c.Hour, c.Minute)
+}
+
+// describeTime formats t for an interpretation, without the time of day for dates.
+func describeTime(t time.Time, granularity string) string {
+	switch granularity {
+	case Second:
+		return t.Format("Monday 2 January 2006 15:04:05 MST")
+	case Minute, Hour:
+		return t.Format("Monday 2 January 2006 15:04 MST")
+	default:
+		return t.Format("Monday 2 January 2006 MST")
+	}
+}
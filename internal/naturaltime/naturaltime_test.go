@@ -0,0 +1,227 @@
+package naturaltime
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func TestParseEnglish(t *testing.T) {
+	// Wednesday 14 October 2026, 10:20:30 in New York (16:20:30 in Paris)
+	now := time.Date(2026, 10, 14, 10, 20, 30, 0, mustLoadLocation(t, "America/New_York"))
+
+	tests := []struct {
+		phrase      string
+		want        string
+		granularity string
+	}{
+		{"now", "2026-10-14T10:20:30-04:00", Second},
+		{"tomorrow", "2026-10-15T00:00:00-04:00", Day},
+		{"tomorrow morning", "2026-10-15T09:00:00-04:00", Minute},
+		{"the day after tomorrow", "2026-10-16T00:00:00-04:00", Day},
+		{"next Tuesday at 3pm Paris time", "2026-10-20T15:00:00+02:00", Minute},
+		{"Tuesday next week", "2026-10-20T00:00:00-04:00", Day},
+		{"last Friday", "2026-10-09T00:00:00-04:00", Day},
+		{"wednesday", "2026-10-14T00:00:00-04:00", Day},
+		{"next wednesday", "2026-10-21T00:00:00-04:00", Day},
+		{"in two weeks", "2026-10-28T10:20:30-04:00", Second},
+		{"3 hours ago", "2026-10-14T07:20:30-04:00", Second},
+		{"an hour and a half from now", "2026-10-14T11:50:30-04:00", Second},
+		{"in 2 days and 4 hours", "2026-10-16T14:20:30-04:00", Second},
+		{"end of day Friday", "2026-10-16T17:00:00-04:00", Minute},
+		{"EOD", "2026-10-14T17:00:00-04:00", Minute},
+		{"end of the week", "2026-10-16T17:00:00-04:00", Minute},
+		{"end of month", "2026-10-31T17:00:00-04:00", Minute},
+		{"start of next month", "2026-11-01T09:00:00-05:00", Minute},
+		{"next month", "2026-11-01T00:00:00-04:00", Month},
+		{"March 29th, 2027 at noon", "2027-03-29T12:00:00-04:00", Minute},
+		{"29 March", "2026-03-29T00:00:00-04:00", Day},
+		{"2026-12-01 at 8:15 am", "2026-12-01T08:15:00-05:00", Minute},
+		{"tonight at 9", "2026-10-14T21:00:00-04:00", Minute},
+		{"at 7:30 in the morning", "2026-10-14T07:30:00-04:00", Minute},
+		{"yesterday at 3", "2026-10-13T15:00:00-04:00", Minute},
+		{"noon tomorrow in Tokyo", "2026-10-15T12:00:00+09:00", Minute},
+		{"5pm UTC+2", "2026-10-14T17:00:00+02:00", Minute},
+		{"15:45:10 Europe/London", "2026-10-14T15:45:10+01:00", Second},
+		{"2026-12-01T08:00:00", "2026-12-01T08:00:00-05:00", Second},
+		{"2026-12-01T08:00:00Z", "2026-12-01T03:00:00-05:00", Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			got, err := Parse(tt.phrase, "", now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s := timezone.FormatISOSeconds(got.Time); s != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s (%s)", tt.phrase, s, tt.want, got.Interpretation)
+			}
+			if got.Granularity != tt.granularity {
+				t.Errorf("granularity = %s, want %s", got.Granularity, tt.granularity)
+			}
+			if got.Interpretation == "" {
+				t.Error("expected an interpretation")
+			}
+		})
+	}
+}
+
+func TestParseInterpretation(t *testing.T) {
+	now := time.Date(2026, 10, 14, 10, 20, 30, 0, time.UTC)
+	got, err := Parse("next Tuesday at 3pm Paris time", "en", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `next Tuesday, at 15:00, in Europe/Paris (from "Paris"): Tuesday 20 October 2026 15:00 CEST`
+	if got.Interpretation != want {
+		t.Errorf("interpretation = %q, want %q", got.Interpretation, want)
+	}
+}
+
+func TestParseDST(t *testing.T) {
+	now := time.Date(2026, 2, 1, 10, 0, 0, 0, mustLoadLocation(t, "America/New_York"))
+
+	tests := []struct {
+		phrase      string
+		want        string
+		nonexistent bool
+		ambiguous   bool
+		note        string
+	}{
+		{"March 8 at 2:30am", "2026-03-08T03:30:00-04:00", true, false, "02:30 is skipped by a DST change, moved forward to 03:30"},
+		{"2026-03-08T02:30:00", "2026-03-08T03:30:00-04:00", true, false, "02:30 is skipped by a DST change"},
+		{"November 1 at 1:30am", "2026-11-01T01:30:00-04:00", false, true, "01:30 occurs twice at a DST change, the earlier is taken"},
+		{"March 8 at 4am", "2026-03-08T04:00:00-04:00", false, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			got, err := Parse(tt.phrase, "", now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s := timezone.FormatISOSeconds(got.Time); s != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.phrase, s, tt.want)
+			}
+			if got.Nonexistent != tt.nonexistent || got.Ambiguous != tt.ambiguous {
+				t.Errorf("nonexistent, ambiguous = %v, %v, want %v, %v", got.Nonexistent, got.Ambiguous, tt.nonexistent, tt.ambiguous)
+			}
+			if tt.ambiguous && len(got.Candidates) != 2 {
+				t.Errorf("expected 2 candidates, got %d", len(got.Candidates))
+			}
+			if tt.note != "" && !strings.Contains(got.Interpretation, tt.note) {
+				t.Errorf("interpretation %q does not contain %q", got.Interpretation, tt.note)
+			}
+			if tt.note == "" && strings.Contains(got.Interpretation, "DST") {
+				t.Errorf("unexpected DST note: %q", got.Interpretation)
+			}
+		})
+	}
+}
+
+func TestParseNowInOverlap(t *testing.T) {
+	// 01:30 EST, the second 01:30 of 1 November 2026 in New York
+	now := time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC).In(mustLoadLocation(t, "America/New_York"))
+	got, err := Parse("now", "", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s := timezone.FormatISOSeconds(got.Time); s != "2026-11-01T01:30:00-05:00" {
+		t.Errorf("Parse(\"now\") = %s, want 2026-11-01T01:30:00-05:00", s)
+	}
+	if got.Ambiguous || got.Nonexistent {
+		t.Errorf("unexpected DST flags: %+v", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	now := time.Date(2026, 10, 14, 10, 20, 30, 0, time.UTC)
+	tests := []struct {
+		phrase string
+		lang   string
+		errMsg string
+	}{
+		{"", "", "phrase is empty"},
+		{"tomorrow", "xx", "unsupported language"},
+		{"blah blah", "", "could not understand"},
+		{"tomorrow foo at 3pm bar", "", "could not understand"},
+		{"tomorrow yesterday", "", "conflicting days"},
+		{"3pm at noon", "", "conflicting times"},
+		{"in half a month", "", "fractional months"},
+		{"13pm", "", "hours run from 1 to 12"},
+		{"at 25:00", "", "invalid time of day"},
+		{"February 30", "", "invalid date"},
+		{"in 1000000000000 years", "", "outside the years 0001 to 9999"},
+		{"8000 years from now", "", "outside the years 0001 to 9999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			_, err := Parse(tt.phrase, tt.lang, now)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("Parse(%q) error = %v, want to contain %q", tt.phrase, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+// pirate is a toy language checking that languages plug into Parse.
+type pirate struct{}
+
+func (pirate) Code() string { return "x-pirate" }
+
+func (pirate) Parse(phrase string) (Expression, error) {
+	days := 1
+	return Expression{Days: &days, Clock: &Clock{Hour: 6}}, nil
+}
+
+func TestRegisterLanguage(t *testing.T) {
+	Register(pirate{})
+	defer delete(languages, "x-pirate")
+
+	now := time.Date(2026, 10, 14, 10, 20, 30, 0, time.UTC)
+	got, err := Parse("on the morrow at dawn", "x-pirate", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s := timezone.FormatISOSeconds(got.Time); s != "2026-10-15T06:00:00+00:00" {
+		t.Errorf("Parse = %s, want 2026-10-15T06:00:00+00:00", s)
+	}
+}
+
+func TestLoadZone(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Europe/Paris", "Europe/Paris"},
+		{"europe/paris", "Europe/Paris"},
+		{"New York", "America/New_York"},
+		{"UTC", "UTC"},
+		{"GMT-3:30", "UTC-03:30"},
+	}
+	for _, tt := range tests {
+		loc, err := LoadZone(tt.name)
+		if err != nil {
+			t.Errorf("LoadZone(%q) unexpected error: %v", tt.name, err)
+			continue
+		}
+		if loc.String() != tt.want {
+			t.Errorf("LoadZone(%q) = %s, want %s", tt.name, loc, tt.want)
+		}
+	}
+	if _, err := LoadZone("Atlantis"); err == nil {
+		t.Error("expected error for unknown zone")
+	}
+}
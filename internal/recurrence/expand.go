@@ -0,0 +1,353 @@
+package recurrence
+
+import (
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/duration"
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// Bounds on rule expansion, so that rules that never or rarely match terminate.
+const (
+	maxYear       = 9999
+	maxIterations = 2_000_000
+)
+
+// expander evaluates a rule against a DTSTART, with the defaults RFC 5545 derives from
+// DTSTART applied.
+type expander struct {
+	rule  *Rule
+	start time.Time // DTSTART wall clock, in UTC
+	loc   *time.Location
+
+	byMonth    []int
+	byMonthDay []int
+	byDay      []WeekdayNum
+	hours      []int
+	minutes    []int
+	seconds    []int
+}
+
+func newExpander(r *Rule, start time.Time) *expander {
+	e := &expander{
+		rule:       r,
+		start:      naive(start),
+		loc:        start.Location(),
+		byMonth:    r.ByMonth,
+		byMonthDay: r.ByMonthDay,
+		byDay:      r.ByDay,
+		hours:      r.ByHour,
+		minutes:    r.ByMinute,
+		seconds:    r.BySecond,
+	}
+	// Without day rule parts the day comes from DTSTART
+	if len(r.ByWeekNo) == 0 && len(r.ByYearDay) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		switch r.Freq {
+		case Yearly:
+			if len(e.byMonth) == 0 {
+				e.byMonth = []int{int(e.start.Month())}
+			}
+			e.byMonthDay = []int{e.start.Day()}
+		case Monthly:
+			e.byMonthDay = []int{e.start.Day()}
+		case Weekly:
+			e.byDay = []WeekdayNum{{Weekday: e.start.Weekday()}}
+		}
+	}
+	if len(e.hours) == 0 {
+		e.hours = []int{e.start.Hour()}
+	}
+	if len(e.minutes) == 0 {
+		e.minutes = []int{e.start.Minute()}
+	}
+	if len(e.seconds) == 0 {
+		e.seconds = []int{e.start.Second()}
+	}
+	return e
+}
+
+// Occurrences returns the occurrences of a rule in order. DTSTART is always the first
+// and counts towards COUNT. Wall-clock times that fall in a DST gap are moved forward by
+// the length of the gap for daily and longer frequencies, as RFC 5545 specifies, and
+// skipped for shorter ones; times that occur twice resolve to the first instant.
+func (r *Rule) Occurrences(start time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		e := newExpander(r, start)
+		if !yield(start) {
+			return
+		}
+		emitted := 1
+		last := start
+		for period, i := 0, 0; i < maxIterations; period, i = period+1, i+1 {
+			candidates, skip := e.period(period)
+			if candidates == nil && skip < 0 {
+				return
+			}
+			period += skip
+
+			// A time moved forward out of a gap can pass later candidates of the period
+			var instants []time.Time
+			done := false
+			for _, wall := range candidates {
+				if !r.Until.IsZero() && !r.UntilUTC && wall.After(r.Until) {
+					done = true
+					break
+				}
+				if !wall.After(e.start) {
+					continue
+				}
+				local, err := timezone.ResolveLocalTime(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, e.loc, timezone.DisambiguateCompatible)
+				if err != nil || (local.Nonexistent && r.Freq < Daily) {
+					continue
+				}
+				instants = append(instants, local.Time)
+			}
+			slices.SortFunc(instants, func(a, b time.Time) int { return a.Compare(b) })
+			for _, t := range instants {
+				if !t.After(last) {
+					continue
+				}
+				if r.UntilUTC && t.After(r.Until) {
+					return
+				}
+				if !yield(t) {
+					return
+				}
+				last = t
+				if emitted++; r.Count > 0 && emitted >= r.Count {
+					return
+				}
+			}
+			if done {
+				return
+			}
+		}
+	}
+}
+
+// period returns the candidate wall-clock times of the nth period, sorted and reduced by
+// BYSETPOS. For periods shorter than a day, skip is the number of following periods
+// that can be passed over because their day or hour does not match. A nil result with
+// a negative skip means the periods have run past year 9999.
+func (e *expander) period(n int) ([]time.Time, int) {
+	r := e.rule
+	step := n * r.Interval
+	var days []time.Time
+	var times []time.Time
+	switch r.Freq {
+	case Yearly:
+		year := e.start.Year() + step
+		if year > maxYear {
+			return nil, -1
+		}
+		from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(1, 0, 0)
+		if len(r.ByWeekNo) > 0 {
+			from, to = e.weekYearStart(year), e.weekYearStart(year+1)
+		}
+		days = e.matchingDays(from, to)
+	case Monthly:
+		first := time.Date(e.start.Year(), e.start.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		if first.Year() > maxYear {
+			return nil, -1
+		}
+		days = e.matchingDays(first, first.AddDate(0, 1, 0))
+	case Weekly:
+		offset := (int(e.start.Weekday()) - int(r.Wkst) + 7) % 7
+		first := startOfDay(e.start).AddDate(0, 0, 7*step-offset)
+		if first.Year() > maxYear {
+			return nil, -1
+		}
+		days = e.matchingDays(first, first.AddDate(0, 0, 7))
+	case Daily:
+		day := startOfDay(e.start).AddDate(0, 0, step)
+		if day.Year() > maxYear {
+			return nil, -1
+		}
+		days = e.matchingDays(day, day.AddDate(0, 0, 1))
+	default:
+		return e.subDailyPeriod(step)
+	}
+
+	for _, day := range days {
+		for _, h := range e.hours {
+			for _, m := range e.minutes {
+				for _, s := range e.seconds {
+					times = append(times, day.Add(time.Duration(h)*time.Hour+time.Duration(m)*time.Minute+time.Duration(s)*time.Second))
+				}
+			}
+		}
+	}
+	slices.SortFunc(times, func(a, b time.Time) int { return a.Compare(b) })
+	return e.applySetPos(slices.Compact(times)), 0
+}
+
+// subDailyPeriod handles HOURLY, MINUTELY and SECONDLY periods, whose BYHOUR, BYMINUTE
+// and BYSECOND parts above the frequency limit rather than expand.
+func (e *expander) subDailyPeriod(step int) ([]time.Time, int) {
+	r := e.rule
+	unit := map[Frequency]time.Duration{Hourly: time.Hour, Minutely: time.Minute, Secondly: time.Second}[r.Freq]
+	at := e.start.Truncate(unit).Add(time.Duration(step) * unit)
+	if at.Year() > maxYear {
+		return nil, -1
+	}
+	// skipTo returns how many further periods start before next
+	skipTo := func(next time.Time) int {
+		periodLen := time.Duration(r.Interval) * unit
+		return int((next.Sub(at) - 1) / periodLen)
+	}
+
+	day := startOfDay(at)
+	if !e.dayMatches(day) {
+		return nil, skipTo(day.AddDate(0, 0, 1))
+	}
+	if len(r.ByHour) > 0 && !slices.Contains(r.ByHour, at.Hour()) {
+		return nil, skipTo(at.Truncate(time.Hour).Add(time.Hour))
+	}
+	if r.Freq == Hourly {
+		var times []time.Time
+		for _, m := range e.minutes {
+			for _, s := range e.seconds {
+				times = append(times, at.Add(time.Duration(m)*time.Minute+time.Duration(s)*time.Second))
+			}
+		}
+		slices.SortFunc(times, func(a, b time.Time) int { return a.Compare(b) })
+		return e.applySetPos(slices.Compact(times)), 0
+	}
+	if len(r.ByMinute) > 0 && !slices.Contains(r.ByMinute, at.Minute()) {
+		return nil, skipTo(at.Truncate(time.Minute).Add(time.Minute))
+	}
+	if r.Freq == Minutely {
+		var times []time.Time
+		for _, s := range slices.Sorted(slices.Values(e.seconds)) {
+			times = append(times, at.Add(time.Duration(s)*time.Second))
+		}
+		return e.applySetPos(slices.Compact(times)), 0
+	}
+	if len(r.BySecond) > 0 && !slices.Contains(r.BySecond, at.Second()) {
+		return []time.Time{}, 0
+	}
+	return e.applySetPos([]time.Time{at}), 0
+}
+
+// matchingDays returns the days in [from, to) that pass the day rule parts.
+func (e *expander) matchingDays(from, to time.Time) []time.Time {
+	var days []time.Time
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if e.dayMatches(day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+func (e *expander) dayMatches(day time.Time) bool {
+	r := e.rule
+	year, month, mday := day.Date()
+	if len(e.byMonth) > 0 && !slices.Contains(e.byMonth, int(month)) {
+		return false
+	}
+	if len(r.ByWeekNo) > 0 {
+		weekYear, week := e.weekNumber(day)
+		weeks := e.weeksIn(weekYear)
+		if !slices.ContainsFunc(r.ByWeekNo, func(n int) bool { return n == week || n == week-weeks-1 }) {
+			return false
+		}
+	}
+	if len(r.ByYearDay) > 0 {
+		yday, n := day.YearDay(), daysInYear(year)
+		if !slices.ContainsFunc(r.ByYearDay, func(v int) bool { return v == yday || v == yday-n-1 }) {
+			return false
+		}
+	}
+	if len(e.byMonthDay) > 0 {
+		last := duration.DaysIn(year, month)
+		if !slices.ContainsFunc(e.byMonthDay, func(v int) bool { return v == mday || v == mday-last-1 }) {
+			return false
+		}
+	}
+	if len(e.byDay) > 0 && !slices.ContainsFunc(e.byDay, func(w WeekdayNum) bool { return e.weekdayMatches(w, day) }) {
+		return false
+	}
+	return true
+}
+
+// weekdayMatches applies a BYDAY item. Ordinals count within the month for monthly
+// rules and yearly rules with BYMONTH, and within the year otherwise.
+func (e *expander) weekdayMatches(w WeekdayNum, day time.Time) bool {
+	if day.Weekday() != w.Weekday {
+		return false
+	}
+	if w.N == 0 {
+		return true
+	}
+	var pos, total int
+	if e.rule.Freq == Monthly || len(e.rule.ByMonth) > 0 {
+		pos, total = day.Day(), duration.DaysIn(day.Year(), day.Month())
+	} else {
+		pos, total = day.YearDay(), daysInYear(day.Year())
+	}
+	return w.N == (pos-1)/7+1 || w.N == -((total-pos)/7+1)
+}
+
+// applySetPos keeps the BYSETPOS positions of a period's sorted candidates.
+func (e *expander) applySetPos(times []time.Time) []time.Time {
+	if len(e.rule.BySetPos) == 0 || len(times) == 0 {
+		return times
+	}
+	var out []time.Time
+	for i, t := range times {
+		if slices.ContainsFunc(e.rule.BySetPos, func(p int) bool { return p == i+1 || p == i-len(times) }) {
+			out = append(out, t)
+		}
+	}
+	if out == nil {
+		return []time.Time{}
+	}
+	return out
+}
+
+// weekYearStart returns the first day of week 1 of year: the week starting on WKST that
+// has at least four days in the year.
+func (e *expander) weekYearStart(year int) time.Time {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan1.Weekday()) - int(e.rule.Wkst) + 7) % 7
+	start := jan1.AddDate(0, 0, -offset)
+	if offset >= 4 {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+// weekNumber returns the week-numbering year of day and its week number within it.
+func (e *expander) weekNumber(day time.Time) (int, int) {
+	year := day.Year()
+	switch {
+	case day.Before(e.weekYearStart(year)):
+		year--
+	case !day.Before(e.weekYearStart(year + 1)):
+		year++
+	}
+	return year, int(day.Sub(e.weekYearStart(year)).Hours())/(24*7) + 1
+}
+
+func (e *expander) weeksIn(year int) int {
+	return int(e.weekYearStart(year+1).Sub(e.weekYearStart(year)).Hours()) / (24 * 7)
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// naive returns t's wall-clock fields in UTC, to second precision.
+func naive(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,218 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func format(times []time.Time) []string {
+	out := make([]string, len(times))
+	for i, t := range times {
+		out[i] = t.Format("2006-01-02T15:04:05-07:00")
+	}
+	return out
+}
+
+func expand(t *testing.T, rule string, start time.Time, limit int) []string {
+	r, err := ParseRule(rule)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", rule, err)
+	}
+	occurrences, _ := (&Set{Start: start, Rules: []*Rule{r}}).Occurrences(time.Time{}, time.Time{}, limit)
+	return format(occurrences)
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Examples from RFC 5545 section 3.8.5.3, which start in 1997 in America/New_York
+func TestRuleOccurrencesRFCExamples(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	sep2 := time.Date(1997, 9, 2, 9, 0, 0, 0, ny)
+
+	tests := []struct {
+		rule  string
+		start time.Time
+		limit int
+		want  []string
+	}{
+		{"FREQ=DAILY;COUNT=3", sep2, 10, []string{"1997-09-02T09:00:00-04:00", "1997-09-03T09:00:00-04:00", "1997-09-04T09:00:00-04:00"}},
+		{"FREQ=DAILY;UNTIL=19970905T000000Z", sep2, 10, []string{"1997-09-02T09:00:00-04:00", "1997-09-03T09:00:00-04:00", "1997-09-04T09:00:00-04:00"}},
+		{"FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH", sep2, 4, []string{"1997-09-02T09:00:00-04:00", "1997-09-04T09:00:00-04:00", "1997-09-16T09:00:00-04:00", "1997-09-18T09:00:00-04:00"}},
+		// Crosses the end of DST and keeps 09:00 local
+		{"FREQ=WEEKLY;COUNT=10", sep2, 10, []string{
+			"1997-09-02T09:00:00-04:00", "1997-09-09T09:00:00-04:00", "1997-09-16T09:00:00-04:00", "1997-09-23T09:00:00-04:00", "1997-09-30T09:00:00-04:00",
+			"1997-10-07T09:00:00-04:00", "1997-10-14T09:00:00-04:00", "1997-10-21T09:00:00-04:00", "1997-10-28T09:00:00-05:00", "1997-11-04T09:00:00-05:00",
+		}},
+		{"FREQ=MONTHLY;COUNT=4;BYDAY=1FR", time.Date(1997, 9, 5, 9, 0, 0, 0, ny), 10, []string{"1997-09-05T09:00:00-04:00", "1997-10-03T09:00:00-04:00", "1997-11-07T09:00:00-05:00", "1997-12-05T09:00:00-05:00"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-3", time.Date(1997, 9, 28, 9, 0, 0, 0, ny), 3, []string{"1997-09-28T09:00:00-04:00", "1997-10-29T09:00:00-05:00", "1997-11-28T09:00:00-05:00"}},
+		{"FREQ=YEARLY;BYDAY=20MO", time.Date(1997, 5, 19, 9, 0, 0, 0, ny), 3, []string{"1997-05-19T09:00:00-04:00", "1998-05-18T09:00:00-04:00", "1999-05-17T09:00:00-04:00"}},
+		{"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO", time.Date(1997, 5, 12, 9, 0, 0, 0, ny), 3, []string{"1997-05-12T09:00:00-04:00", "1998-05-11T09:00:00-04:00", "1999-05-17T09:00:00-04:00"}},
+		{"FREQ=YEARLY;BYMONTH=3;BYDAY=TH", time.Date(1997, 3, 13, 9, 0, 0, 0, ny), 4, []string{"1997-03-13T09:00:00-05:00", "1997-03-20T09:00:00-05:00", "1997-03-27T09:00:00-05:00", "1998-03-05T09:00:00-05:00"}},
+		// Friday the 13th
+		{"FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", sep2, 4, []string{"1997-09-02T09:00:00-04:00", "1998-02-13T09:00:00-05:00", "1998-03-13T09:00:00-05:00", "1998-11-13T09:00:00-05:00"}},
+		// The second-to-last weekday of the month
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-2", time.Date(1997, 9, 29, 9, 0, 0, 0, ny), 3, []string{"1997-09-29T09:00:00-04:00", "1997-10-30T09:00:00-05:00", "1997-11-27T09:00:00-05:00"}},
+		{"FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T170000Z", sep2, 10, []string{"1997-09-02T09:00:00-04:00", "1997-09-02T12:00:00-04:00"}},
+		{"FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10", sep2, 7, []string{
+			"1997-09-02T09:00:00-04:00", "1997-09-02T09:20:00-04:00", "1997-09-02T09:40:00-04:00", "1997-09-02T10:00:00-04:00",
+			"1997-09-02T10:20:00-04:00", "1997-09-02T10:40:00-04:00", "1997-09-03T09:00:00-04:00",
+		}},
+		// Invalid dates such as February 30th are ignored
+		{"FREQ=MONTHLY;BYMONTHDAY=15,30;COUNT=5", time.Date(2007, 1, 15, 9, 0, 0, 0, ny), 10, []string{"2007-01-15T09:00:00-05:00", "2007-01-30T09:00:00-05:00", "2007-02-15T09:00:00-05:00", "2007-03-15T09:00:00-04:00", "2007-03-30T09:00:00-04:00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			if got := expand(t, tt.rule, tt.start, tt.limit); !equal(got, tt.want) {
+				t.Errorf("occurrences = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleOccurrencesDST(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+
+	// 02:30 does not exist on 2026-03-08 and moves forward by the length of the gap
+	got := expand(t, "FREQ=DAILY;COUNT=3", time.Date(2026, 3, 7, 2, 30, 0, 0, ny), 10)
+	want := []string{"2026-03-07T02:30:00-05:00", "2026-03-08T03:30:00-04:00", "2026-03-09T02:30:00-04:00"}
+	if !equal(got, want) {
+		t.Errorf("daily across spring forward = %v, want %v", got, want)
+	}
+
+	// 01:30 occurs twice on 2026-11-01; the first instant is used
+	got = expand(t, "FREQ=DAILY;COUNT=3", time.Date(2026, 10, 31, 1, 30, 0, 0, ny), 10)
+	want = []string{"2026-10-31T01:30:00-04:00", "2026-11-01T01:30:00-04:00", "2026-11-02T01:30:00-05:00"}
+	if !equal(got, want) {
+		t.Errorf("daily across fall back = %v, want %v", got, want)
+	}
+
+	// Hourly runs skip the missing hour rather than repeat 03:00
+	got = expand(t, "FREQ=HOURLY;COUNT=3", time.Date(2026, 3, 8, 1, 0, 0, 0, ny), 10)
+	want = []string{"2026-03-08T01:00:00-05:00", "2026-03-08T03:00:00-04:00", "2026-03-08T04:00:00-04:00"}
+	if !equal(got, want) {
+		t.Errorf("hourly across spring forward = %v, want %v", got, want)
+	}
+}
+
+func TestRuleOccurrencesSparseSubDaily(t *testing.T) {
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	got := expand(t, "FREQ=SECONDLY;BYMONTH=12;BYHOUR=3;BYMINUTE=7", start, 3)
+	want := []string{"2026-01-01T09:00:00+00:00", "2026-12-01T03:07:00+00:00", "2026-12-01T03:07:01+00:00"}
+	if !equal(got, want) {
+		t.Errorf("occurrences = %v, want %v", got, want)
+	}
+}
+
+func TestSetOccurrences(t *testing.T) {
+	set, err := ParseSet(`DTSTART;TZID=Europe/Paris:20260130T100000
+RRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=6
+EXDATE;TZID=Europe/Paris:20260227T100000
+RDATE;TZID=Europe/Paris:20260215T120000,20260316T120000`, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, more := set.Occurrences(time.Time{}, time.Time{}, 10)
+	want := []string{
+		"2026-01-30T10:00:00+01:00", "2026-02-15T12:00:00+01:00", "2026-03-16T12:00:00+01:00", "2026-03-27T10:00:00+01:00",
+		"2026-04-24T10:00:00+02:00", "2026-05-29T10:00:00+02:00", "2026-06-26T10:00:00+02:00",
+	}
+	if !equal(format(got), want) || more {
+		t.Errorf("occurrences = %v (more %v), want %v", format(got), more, want)
+	}
+
+	paris := mustLoadLocation(t, "Europe/Paris")
+	got, more = set.Occurrences(time.Date(2026, 3, 20, 0, 0, 0, 0, paris), time.Date(2026, 6, 1, 0, 0, 0, 0, paris), 2)
+	if !equal(format(got), want[3:5]) || !more {
+		t.Errorf("windowed occurrences = %v (more %v), want %v and more", format(got), more, want[3:5])
+	}
+}
+
+func TestSetOccurrencesUnboundedRule(t *testing.T) {
+	set, err := ParseSet("DTSTART:20260101T000000Z\nFREQ=DAILY", time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, more := set.Occurrences(time.Time{}, time.Time{}, 5)
+	if len(got) != 5 || !more {
+		t.Errorf("expected 5 occurrences and more, got %d (more %v)", len(got), more)
+	}
+	if got[0].Location() != time.UTC {
+		t.Errorf("a UTC DTSTART is evaluated in UTC, got %s", got[0].Location())
+	}
+}
+
+func TestSetAddDates(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	r, err := ParseRule("FREQ=WEEKLY;COUNT=3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	set := &Set{Start: time.Date(2026, 1, 5, 9, 0, 0, 0, paris), Rules: []*Rule{r}}
+	if err := set.AddDates([]string{"2026-01-07T15:00:00"}, []string{"20260112"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, _ := set.Occurrences(time.Time{}, time.Time{}, 10)
+	want := []string{"2026-01-05T09:00:00+01:00", "2026-01-07T15:00:00+01:00", "2026-01-19T09:00:00+01:00"}
+	if !equal(format(got), want) {
+		t.Errorf("occurrences = %v, want %v", format(got), want)
+	}
+	if err := set.AddDates([]string{"tomorrow"}, nil); err == nil {
+		t.Error("expected an error for an invalid RDATE")
+	}
+}
+
+func TestParseRuleErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"COUNT=3",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;COUNT=3;UNTIL=20260101T000000Z",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYWEEKNO=1",
+		"FREQ=MONTHLY;BYYEARDAY=1",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;X-NAME=1",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+	}
+	for _, rule := range tests {
+		if _, err := ParseRule(rule); err == nil {
+			t.Errorf("expected an error for %q", rule)
+		}
+	}
+}
+
+func TestRuleString(t *testing.T) {
+	for _, rule := range []string{"FREQ=MONTHLY;COUNT=6;BYDAY=-1FR", "FREQ=WEEKLY;INTERVAL=2;UNTIL=20261231T235959Z;BYDAY=TU,TH;WKST=SU"} {
+		r, err := ParseRule("RRULE:" + rule)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := r.String(); got != rule {
+			t.Errorf("String() = %q, want %q", got, rule)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+// Package recurrence expands RFC 5545 recurrence sets: a DTSTART with RRULE, RDATE and
+// EXDATE properties, evaluated on the wall clock of the DTSTART timezone.
+package recurrence
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a recurrence rule.
+type Frequency int
+
+// Frequencies, from the shortest period to the longest.
+const (
+	Secondly Frequency = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = []string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func (f Frequency) String() string {
+	return frequencyNames[f]
+}
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdayNum is a BYDAY item: a weekday with an optional ordinal, such as "-1FR" for
+// the last Friday of the month or year. N is 0 for every such weekday.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayCodes[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayCodes[w.Weekday]
+}
+
+// Rule is a parsed RRULE.
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	Count    int       // 0 when unbounded by COUNT
+	Until    time.Time // zero when unbounded by UNTIL
+	// UntilUTC is set when UNTIL is a UTC instant. Otherwise it is a wall-clock time in
+	// the DTSTART timezone, held in UTC.
+	UntilUTC   bool
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []int
+	BySetPos   []int
+	Wkst       time.Weekday
+}
+
+// ParseRule parses an RRULE value such as "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6", with or
+// without the "RRULE:" prefix, and checks the combinations RFC 5545 forbids.
+func ParseRule(text string) (*Rule, error) {
+	text = strings.TrimSpace(text)
+	if len(text) >= 6 && strings.EqualFold(text[:6], "RRULE:") {
+		text = text[6:]
+	}
+	if text == "" {
+		return nil, fmt.Errorf("recurrence rule is empty")
+	}
+
+	r := &Rule{Interval: 1, Wkst: time.Monday}
+	seen := make(map[string]bool)
+	hasFreq := false
+	for _, part := range strings.Split(text, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rule part %q: expected NAME=VALUE", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s is given more than once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			i := slices.Index(frequencyNames, strings.ToUpper(value))
+			if i < 0 {
+				return nil, fmt.Errorf("invalid FREQ %q: expected one of %s", value, strings.Join(frequencyNames, ", "))
+			}
+			r.Freq, hasFreq = Frequency(i), true
+		case "INTERVAL":
+			r.Interval, err = parsePositive(value)
+		case "COUNT":
+			r.Count, err = parsePositive(value)
+		case "UNTIL":
+			r.Until, r.UntilUTC, err = parseUntil(value)
+		case "BYSECOND":
+			r.BySecond, err = parseInts(value, 0, 60, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(value, 0, 59, false)
+		case "BYHOUR":
+			r.ByHour, err = parseInts(value, 0, 23, false)
+		case "BYDAY":
+			r.ByDay, err = parseWeekdayNums(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(value, 1, 31, true)
+		case "BYYEARDAY":
+			r.ByYearDay, err = parseInts(value, 1, 366, true)
+		case "BYWEEKNO":
+			r.ByWeekNo, err = parseInts(value, 1, 53, true)
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(value, 1, 12, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(value, 1, 366, true)
+		case "WKST":
+			i := slices.Index(weekdayCodes, strings.ToUpper(value))
+			if i < 0 {
+				return nil, fmt.Errorf("invalid WKST %q: expected a weekday such as MO", value)
+			}
+			r.Wkst = time.Weekday(i)
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+
+	if !hasFreq {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if seen["COUNT"] && seen["UNTIL"] {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot both be given")
+	}
+	if r.Freq != Monthly && r.Freq != Yearly && slices.ContainsFunc(r.ByDay, func(w WeekdayNum) bool { return w.N != 0 }) {
+		return nil, fmt.Errorf("BYDAY ordinals such as %q need FREQ=MONTHLY or FREQ=YEARLY", r.ByDay[0])
+	}
+	if r.Freq == Yearly && len(r.ByWeekNo) > 0 && slices.ContainsFunc(r.ByDay, func(w WeekdayNum) bool { return w.N != 0 }) {
+		return nil, fmt.Errorf("BYDAY ordinals cannot be combined with BYWEEKNO")
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	if (r.Freq == Daily || r.Freq == Weekly || r.Freq == Monthly) && len(r.ByYearDay) > 0 {
+		return nil, fmt.Errorf("BYYEARDAY cannot be used with FREQ=%s", r.Freq)
+	}
+	if r.Freq != Yearly && len(r.ByWeekNo) > 0 {
+		return nil, fmt.Errorf("BYWEEKNO needs FREQ=YEARLY")
+	}
+	if len(r.BySetPos) > 0 && !seen["BYSECOND"] && !seen["BYMINUTE"] && !seen["BYHOUR"] && !seen["BYDAY"] &&
+		!seen["BYMONTHDAY"] && !seen["BYYEARDAY"] && !seen["BYWEEKNO"] && !seen["BYMONTH"] {
+		return nil, fmt.Errorf("BYSETPOS needs another BYxxx rule part")
+	}
+	return r, nil
+}
+
+// String formats the rule as an RRULE value.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		if r.UntilUTC {
+			parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+		} else {
+			parts = append(parts, "UNTIL="+r.Until.Format("20060102T150405"))
+		}
+	}
+	for _, p := range []struct {
+		name   string
+		values []int
+	}{{"BYMONTH", r.ByMonth}, {"BYWEEKNO", r.ByWeekNo}, {"BYYEARDAY", r.ByYearDay}, {"BYMONTHDAY", r.ByMonthDay}} {
+		if len(p.values) > 0 {
+			parts = append(parts, p.name+"="+joinInts(p.values))
+		}
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	for _, p := range []struct {
+		name   string
+		values []int
+	}{{"BYHOUR", r.ByHour}, {"BYMINUTE", r.ByMinute}, {"BYSECOND", r.BySecond}, {"BYSETPOS", r.BySetPos}} {
+		if len(p.values) > 0 {
+			parts = append(parts, p.name+"="+joinInts(p.values))
+		}
+	}
+	if r.Wkst != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[r.Wkst])
+	}
+	return strings.Join(parts, ";")
+}
+
+func parsePositive(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("expected a positive number")
+	}
+	return n, nil
+}
+
+// parseInts parses a comma-separated list of integers within [lo, hi], or within
+// [-hi, -lo] as well when negative values count from the end.
+func parseInts(value string, lo, hi int, negative bool) ([]int, error) {
+	var out []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimPrefix(item, "+"))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", item)
+		}
+		if (n < lo || n > hi) && !(negative && -n >= lo && -n <= hi) {
+			if negative {
+				return nil, fmt.Errorf("%d is outside %d..%d and -%d..-%d", n, lo, hi, hi, lo)
+			}
+			return nil, fmt.Errorf("%d is outside %d..%d", n, lo, hi)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func parseWeekdayNums(value string) ([]WeekdayNum, error) {
+	var out []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		item = strings.ToUpper(strings.TrimSpace(item))
+		if len(item) < 2 {
+			return nil, fmt.Errorf("%q is not a weekday such as MO or -1FR", item)
+		}
+		day := slices.Index(weekdayCodes, item[len(item)-2:])
+		if day < 0 {
+			return nil, fmt.Errorf("%q is not a weekday such as MO or -1FR", item)
+		}
+		w := WeekdayNum{Weekday: time.Weekday(day)}
+		if ord := item[:len(item)-2]; ord != "" {
+			n, err := strconv.Atoi(strings.TrimPrefix(ord, "+"))
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("%q: the ordinal must be 1 to 53 or -53 to -1", item)
+			}
+			w.N = n
+		}
+		out = append(out, w)
+	}
+	return out, nil
+}
+
+// parseUntil parses an UNTIL value: a date, a floating date-time or a UTC date-time.
+// A date lasts until the end of the day.
+func parseUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.Add(24*time.Hour - time.Second), false, nil
+	}
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse("20060102T150405", value); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("expected YYYYMMDD, YYYYMMDDTHHMMSS or YYYYMMDDTHHMMSSZ")
+}
+
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,230 @@
+package recurrence
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// Set is a recurrence set: DTSTART, the occurrences of its rules and the RDATE times,
+// less the EXDATE times.
+type Set struct {
+	// Start is DTSTART. Its location is the timezone the rules are evaluated in.
+	Start   time.Time
+	Rules   []*Rule
+	RDates  []time.Time
+	ExDates []time.Time
+}
+
+// Occurrences returns the set's occurrences in [after, before) in order, at most limit
+// of them, and whether more follow. Zero after or before leave that side unbounded.
+func (s *Set) Occurrences(after, before time.Time, limit int) ([]time.Time, bool) {
+	inWindow := func(t time.Time) bool {
+		return (after.IsZero() || !t.Before(after)) && (before.IsZero() || t.Before(before))
+	}
+	excluded := func(t time.Time) bool {
+		return slices.ContainsFunc(s.ExDates, t.Equal)
+	}
+	// Each source contributes enough occurrences to fill the result after exclusions
+	need := limit + len(s.ExDates) + 1
+
+	var all []time.Time
+	dates := append([]time.Time{s.Start}, s.RDates...)
+	slices.SortFunc(dates, func(a, b time.Time) int { return a.Compare(b) })
+	sources := []iter.Seq[time.Time]{slices.Values(dates)}
+	for _, r := range s.Rules {
+		sources = append(sources, r.Occurrences(s.Start))
+	}
+	for _, source := range sources {
+		n := 0
+		for t := range source {
+			if !before.IsZero() && !t.Before(before) {
+				break
+			}
+			if inWindow(t) {
+				all = append(all, t)
+				if n++; n >= need {
+					break
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(all, func(a, b time.Time) int { return a.Compare(b) })
+	all = slices.CompactFunc(all, func(a, b time.Time) bool { return a.Equal(b) })
+	all = slices.DeleteFunc(all, excluded)
+	if len(all) > limit {
+		return all[:limit], true
+	}
+	return all, false
+}
+
+// ParseSet parses recurrence properties, one per line, as found in an iCalendar event:
+//
+//	DTSTART;TZID=America/New_York:20260105T090000
+//	RRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=6
+//	EXDATE;TZID=America/New_York:20260227T090000
+//
+// A line without a property name is read as an RRULE value. Floating times are in loc.
+// Start is left zero when there is no DTSTART line.
+func ParseSet(text string, loc *time.Location) (*Set, error) {
+	s := &Set{}
+	for _, line := range unfold(text) {
+		name, params, value := splitProperty(line)
+		switch name {
+		case "":
+			if value == "" {
+				continue
+			}
+			fallthrough
+		case "RRULE":
+			r, err := ParseRule(value)
+			if err != nil {
+				return nil, err
+			}
+			s.Rules = append(s.Rules, r)
+		case "DTSTART":
+			times, err := parsePropertyTimes(params, value, loc, time.Time{})
+			if err != nil {
+				return nil, fmt.Errorf("invalid DTSTART: %w", err)
+			}
+			if len(times) != 1 {
+				return nil, fmt.Errorf("invalid DTSTART %q: expected one date-time", value)
+			}
+			s.Start = times[0]
+		case "RDATE", "EXDATE":
+			// Dates without a time take the time of DTSTART, so DTSTART must come first
+			times, err := parsePropertyTimes(params, value, loc, s.Start)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", name, err)
+			}
+			if name == "RDATE" {
+				s.RDates = append(s.RDates, times...)
+			} else {
+				s.ExDates = append(s.ExDates, times...)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported property %q: expected DTSTART, RRULE, RDATE or EXDATE", name)
+		}
+	}
+	return s, nil
+}
+
+// AddDates parses RDATE and EXDATE values in the DTSTART timezone and adds them to the
+// set. Dates without a time take the time of day of DTSTART.
+func (s *Set) AddDates(rdates, exdates []string) error {
+	for _, v := range rdates {
+		times, err := parsePropertyTimes(nil, v, s.Start.Location(), s.Start)
+		if err != nil {
+			return fmt.Errorf("invalid RDATE: %w", err)
+		}
+		s.RDates = append(s.RDates, times...)
+	}
+	for _, v := range exdates {
+		times, err := parsePropertyTimes(nil, v, s.Start.Location(), s.Start)
+		if err != nil {
+			return fmt.Errorf("invalid EXDATE: %w", err)
+		}
+		s.ExDates = append(s.ExDates, times...)
+	}
+	return nil
+}
+
+// unfold splits text into content lines, joining lines folded with a leading space or
+// tab as RFC 5545 does.
+func unfold(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// splitProperty splits "NAME;PARAM=X:VALUE" into its upper-cased name, parameters and
+// value. A line that is only a rule value, such as "FREQ=DAILY", has no name.
+func splitProperty(line string) (string, map[string]string, string) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok || strings.Contains(head, "=") && !strings.Contains(head, ";") {
+		return "", nil, line
+	}
+	parts := strings.Split(head, ";")
+	params := make(map[string]string)
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, value
+}
+
+// parsePropertyTimes parses the comma-separated values of a DTSTART, RDATE or EXDATE,
+// honouring the TZID parameter. Dates take the time of day of ref.
+func parsePropertyTimes(params map[string]string, value string, loc *time.Location, ref time.Time) ([]time.Time, error) {
+	if v := params["VALUE"]; v != "" && v != "DATE" && v != "DATE-TIME" {
+		return nil, fmt.Errorf("VALUE=%s is not supported", v)
+	}
+	if tzid := params["TZID"]; tzid != "" {
+		now, err := timezone.GetNowInLocation(tzid)
+		if err != nil {
+			return nil, err
+		}
+		loc = now.Location()
+	}
+	var out []time.Time
+	for _, item := range strings.Split(value, ",") {
+		t, dateOnly, err := ParseDateTime(item, loc)
+		if err != nil {
+			return nil, err
+		}
+		if dateOnly && !ref.IsZero() {
+			t, err = atTimeOf(t, ref)
+			if err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// ParseDateTime parses an iCalendar DATE ("20260105") or DATE-TIME ("20260105T090000",
+// or "20260105T140000Z" returned in UTC), or an ISO 8601 date or datetime, in loc unless
+// it carries a UTC offset. Times in a DST gap move forward by the length of the gap.
+// dateOnly reports a date, which is returned at midnight.
+func ParseDateTime(value string, loc *time.Location) (t time.Time, dateOnly bool, err error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{"20060102", "2006-01-02"} {
+		if d, err := time.Parse(layout, value); err == nil {
+			local, err := timezone.ResolveLocalTime(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc, timezone.DisambiguateCompatible)
+			return local.Time, true, err
+		}
+	}
+	if u, err := time.Parse("20060102T150405Z", value); err == nil {
+		return u, false, nil
+	}
+	if w, err := time.Parse("20060102T150405", value); err == nil {
+		local, err := timezone.ResolveLocalTime(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, loc, timezone.DisambiguateCompatible)
+		return local.Time, false, err
+	}
+	local, err := timezone.ParseInLocation(value, loc, timezone.DisambiguateCompatible)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time %q: expected YYYYMMDD, YYYYMMDDTHHMMSS[Z] or ISO 8601", value)
+	}
+	return local.Time, false, nil
+}
+
+// atTimeOf returns date at the wall-clock time of day of ref.
+func atTimeOf(date, ref time.Time) (time.Time, error) {
+	ref = ref.In(date.Location())
+	local, err := timezone.ResolveLocalTime(date.Year(), date.Month(), date.Day(), ref.Hour(), ref.Minute(), ref.Second(), 0, date.Location(), timezone.DisambiguateCompatible)
+	return local.Time, err
+}
@@ -0,0 +1,234 @@
+// Package serialdate converts between instants and the numeric date representations
+// found in scientific and spreadsheet data: Julian Day, Julian Day Number, Modified
+// Julian Date, Rata Die, Excel (and Lotus 1-2-3) serial dates and Cocoa reference dates.
+package serialdate
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/r0mdau/mcp-time/internal/timezone"
+)
+
+// Format is a numeric date representation.
+type Format string
+
+// Supported formats. JulianDay, ModifiedJulianDate and Cocoa count time from a UTC
+// instant; JulianDayNumber, RataDie and the Excel serials count calendar days and
+// wall-clock time in a timezone.
+const (
+	// JulianDay is days since noon UT on 1 January 4713 BC (Julian calendar), with a
+	// fraction for the time of day.
+	JulianDay Format = "jd"
+	// JulianDayNumber is the Julian Day at noon of a calendar date.
+	JulianDayNumber Format = "jdn"
+	// ModifiedJulianDate is JulianDay - 2400000.5: days since 1858-11-17 00:00 UT.
+	ModifiedJulianDate Format = "mjd"
+	// RataDie counts days with 0001-01-01 of the proleptic Gregorian calendar as day 1.
+	RataDie Format = "rata_die"
+	// Excel1900 is the Excel and Lotus 1-2-3 serial: 1900-01-01 is day 1 and, as in
+	// Lotus, 1900 counts as a leap year, so serial 60 is the nonexistent 1900-02-29.
+	Excel1900 Format = "excel"
+	// Excel1904 is the serial of Excel's 1904 date system: days since 1904-01-01.
+	Excel1904 Format = "excel_1904"
+	// Cocoa is seconds since 2001-01-01 00:00:00 UTC, the reference date of Apple's
+	// NSDate and CFAbsoluteTime.
+	Cocoa Format = "cocoa"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{JulianDay, JulianDayNumber, ModifiedJulianDate, RataDie, Excel1900, Excel1904, Cocoa}
+
+var formatAliases = map[string]Format{
+	"julian_day": JulianDay, "julian_date": JulianDay,
+	"julian_day_number":    JulianDayNumber,
+	"modified_julian_date": ModifiedJulianDate, "modified_julian_day": ModifiedJulianDate,
+	"rd": RataDie, "ratadie": RataDie,
+	"excel_1900": Excel1900, "lotus": Excel1900, "spreadsheet": Excel1900,
+	"excel_mac": Excel1904,
+	"mac":       Cocoa, "nsdate": Cocoa, "cfabsolutetime": Cocoa,
+}
+
+// Day numbers of the Unix epoch, 1970-01-01.
+const (
+	unixJulianDay = 2440587.5 // at midnight UT
+	unixJDN       = 2440588
+	unixRataDie   = 719163
+	mjdOffset     = 2400000.5
+	secondsPerDay = 86400
+)
+
+var (
+	excel1900Base = civilDays(1899, time.December, 31)
+	excel1904Base = civilDays(1904, time.January, 1)
+	// lotusLeapDay is the serial of 1900-02-29, which Excel counts for Lotus compatibility
+	lotusLeapDay = 60
+	cocoaEpoch   = time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	minTime      = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxTime      = time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// ParseFormat parses a format name such as "jd", "mjd", "excel" or "lotus".
+func ParseFormat(s string) (Format, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for _, f := range Formats {
+		if name == string(f) {
+			return f, nil
+		}
+	}
+	if f, ok := formatAliases[name]; ok {
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown date format %q: expected jd, jdn, mjd, rata_die, excel, excel_1904 or cocoa", s)
+}
+
+// civilDays returns the days from 1970-01-01 to a date of the proleptic Gregorian calendar.
+func civilDays(year int, month time.Month, day int) int {
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay)
+}
+
+// localDays returns the days from 1970-01-01 to t's date in its location, and the
+// wall-clock time of day as a fraction of a day.
+func localDays(t time.Time) (int, float64) {
+	hour, min, sec := t.Clock()
+	clock := float64(hour*3600+min*60+sec) + float64(t.Nanosecond())/1e9
+	return civilDays(t.Year(), t.Month(), t.Day()), clock / secondsPerDay
+}
+
+// unixDays returns the days from the Unix epoch to the instant t.
+func unixDays(t time.Time) float64 {
+	sec := t.Unix()
+	whole := math.Floor(float64(sec) / secondsPerDay)
+	rest := float64(sec-int64(whole)*secondsPerDay) + float64(t.Nanosecond())/1e9
+	return whole + rest/secondsPerDay
+}
+
+// ToJulianDay returns the Julian Day of the instant t.
+func ToJulianDay(t time.Time) float64 {
+	return round(unixDays(t)+unixJulianDay, 8)
+}
+
+// ToModifiedJulianDate returns the Modified Julian Date of the instant t.
+func ToModifiedJulianDate(t time.Time) float64 {
+	return round(unixDays(t)+unixJulianDay-mjdOffset, 8)
+}
+
+// ToJulianDayNumber returns the Julian Day Number of t's date in its location.
+func ToJulianDayNumber(t time.Time) int {
+	days, _ := localDays(t)
+	return days + unixJDN
+}
+
+// ToRataDie returns the Rata Die of t's date in its location.
+func ToRataDie(t time.Time) int {
+	days, _ := localDays(t)
+	return days + unixRataDie
+}
+
+// ToExcel returns the Excel serial of t's wall-clock time in the 1900 or 1904 date
+// system. ok is false for dates the system cannot represent.
+func ToExcel(t time.Time, f Format) (serial float64, ok bool) {
+	days, clock := localDays(t)
+	switch f {
+	case Excel1904:
+		days -= excel1904Base
+	default:
+		days -= excel1900Base
+		if days >= lotusLeapDay {
+			days++
+		}
+	}
+	if days < 0 {
+		return 0, false
+	}
+	return round(float64(days)+clock, 8), true
+}
+
+// ToCocoa returns the seconds from the Cocoa reference date to the instant t.
+func ToCocoa(t time.Time) float64 {
+	return round(unixDays(t)*secondsPerDay-float64(cocoaEpoch.Unix()), 6)
+}
+
+// Parse reads a number in format f. Day counts and Excel serials are resolved on the
+// wall clock of loc; the result carries the DST flags of that resolution.
+func Parse(value string, f Format, loc *time.Location) (timezone.LocalTime, error) {
+	value = strings.TrimSpace(value)
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return timezone.LocalTime{}, fmt.Errorf("invalid %s value %q: expected a number", f, value)
+	}
+	if (f == JulianDayNumber || f == RataDie) && number != math.Trunc(number) {
+		return timezone.LocalTime{}, fmt.Errorf("invalid %s value %q: expected a whole number of days", f, value)
+	}
+
+	var local timezone.LocalTime
+	switch f {
+	case JulianDay, ModifiedJulianDate, Cocoa:
+		// Days since the Unix epoch
+		days := (number + float64(cocoaEpoch.Unix())) / secondsPerDay
+		switch f {
+		case JulianDay:
+			days = number - unixJulianDay
+		case ModifiedJulianDate:
+			days = number + mjdOffset - unixJulianDay
+		}
+		if math.Abs(days) > 4e6 {
+			return timezone.LocalTime{}, fmt.Errorf("%s %s is outside the years 0001 to 9999", f, value)
+		}
+		whole := math.Floor(days)
+		local.Time = time.Date(1970, time.January, 1+int(whole), 0, 0, 0, 0, time.UTC).
+			Add(fractionOfDay(days - whole)).In(loc)
+	case JulianDayNumber, RataDie:
+		offset := unixJDN
+		if f == RataDie {
+			offset = unixRataDie
+		}
+		if math.Abs(number) > 1e7 {
+			return timezone.LocalTime{}, fmt.Errorf("%s %s is outside the years 0001 to 9999", f, value)
+		}
+		date := time.Date(1970, time.January, 1+int(number)-offset, 0, 0, 0, 0, time.UTC)
+		if local, err = timezone.ResolveLocalTime(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc, timezone.DisambiguateCompatible); err != nil {
+			return timezone.LocalTime{}, err
+		}
+	case Excel1900, Excel1904:
+		if number < 0 || number > 3e6 {
+			return timezone.LocalTime{}, fmt.Errorf("invalid %s serial %s: Excel serials run from 0 to 2958465 (9999-12-31)", f, value)
+		}
+		whole := int(math.Floor(number))
+		base := excel1904Base
+		if f == Excel1900 {
+			base = excel1900Base
+			if whole == lotusLeapDay {
+				return timezone.LocalTime{}, fmt.Errorf("excel serial %s is 1900-02-29, which Excel counts for Lotus 1-2-3 compatibility but which does not exist", value)
+			}
+			if whole > lotusLeapDay {
+				whole--
+			}
+		}
+		wall := time.Date(1970, time.January, 1+base+whole, 0, 0, 0, 0, time.UTC).Add(fractionOfDay(number - math.Floor(number)))
+		if local, err = timezone.ResolveLocalTime(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc, timezone.DisambiguateCompatible); err != nil {
+			return timezone.LocalTime{}, err
+		}
+	default:
+		return timezone.LocalTime{}, fmt.Errorf("unknown date format %q", f)
+	}
+
+	if local.Time.Before(minTime) || !local.Time.Before(maxTime) {
+		return timezone.LocalTime{}, fmt.Errorf("%s %s is outside the years 0001 to 9999", f, value)
+	}
+	return local, nil
+}
+
+// fractionOfDay converts a fraction of a day to a duration, rounded to the millisecond
+// that float64 day counts resolve.
+func fractionOfDay(fraction float64) time.Duration {
+	return time.Duration(math.Round(fraction*secondsPerDay*1e3)) * time.Millisecond
+}
+
+func round(x float64, decimals int) float64 {
+	scale := math.Pow(10, float64(decimals))
+	return math.Round(x*scale) / scale
+}
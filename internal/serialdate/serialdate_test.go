@@ -0,0 +1,150 @@
+package serialdate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name  string
+		t     time.Time
+		jd    float64
+		jdn   int
+		mjd   float64
+		rd    int
+		excel float64
+		e1904 float64
+		cocoa float64
+		noXLS bool
+	}{
+		{
+			name: "J2000",
+			t:    time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC),
+			jd:   2451545, jdn: 2451545, mjd: 51544.5, rd: 730120,
+			excel: 36526.5, e1904: 35064.5, cocoa: -31579200,
+		},
+		{
+			name: "Unix epoch",
+			t:    time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+			jd:   2440587.5, jdn: 2440588, mjd: 40587, rd: 719163,
+			excel: 25569, e1904: 24107, cocoa: -978307200,
+		},
+		{
+			name: "before the Lotus leap day",
+			t:    time.Date(1900, time.February, 28, 18, 0, 0, 0, time.UTC),
+			jd:   2415079.25, jdn: 2415079, mjd: 15078.75, rd: 693654,
+			excel: 59.75, cocoa: -3182220000, noXLS: true,
+		},
+		{
+			name: "after the Lotus leap day",
+			t:    time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC),
+			jd:   2415079.5, jdn: 2415080, mjd: 15079, rd: 693655,
+			excel: 61, cocoa: -3182198400, noXLS: true,
+		},
+		{
+			name: "calendar values use the local date",
+			t:    time.Date(2024, time.June, 10, 1, 30, 0, 0, mustLoadLocation(t, "Asia/Tokyo")),
+			jd:   2460471.18750, jdn: 2460472, mjd: 60470.6875, rd: 739047,
+			excel: 45453.0625, e1904: 43991.0625, cocoa: 739643400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToJulianDay(tt.t); got != tt.jd {
+				t.Errorf("JD = %v, want %v", got, tt.jd)
+			}
+			if got := ToJulianDayNumber(tt.t); got != tt.jdn {
+				t.Errorf("JDN = %v, want %v", got, tt.jdn)
+			}
+			if got := ToModifiedJulianDate(tt.t); got != tt.mjd {
+				t.Errorf("MJD = %v, want %v", got, tt.mjd)
+			}
+			if got := ToRataDie(tt.t); got != tt.rd {
+				t.Errorf("RD = %v, want %v", got, tt.rd)
+			}
+			if got, ok := ToExcel(tt.t, Excel1900); !ok || got != tt.excel {
+				t.Errorf("Excel = %v %v, want %v", got, ok, tt.excel)
+			}
+			if got, ok := ToExcel(tt.t, Excel1904); ok == tt.noXLS || ok && got != tt.e1904 {
+				t.Errorf("Excel 1904 = %v %v, want %v", got, ok, tt.e1904)
+			}
+			if got := ToCocoa(tt.t); got != tt.cocoa {
+				t.Errorf("Cocoa = %v, want %v", got, tt.cocoa)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	tests := []struct {
+		value       string
+		format      Format
+		loc         *time.Location
+		want        time.Time
+		nonexistent bool
+	}{
+		{"2451545.0", JulianDay, time.UTC, time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), false},
+		{"60470.6875", ModifiedJulianDate, time.UTC, time.Date(2024, time.June, 9, 16, 30, 0, 0, time.UTC), false},
+		{"2460472", JulianDayNumber, paris, time.Date(2024, time.June, 10, 0, 0, 0, 0, paris), false},
+		{"1", RataDie, time.UTC, time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), false},
+		{"45453.0625", Excel1900, paris, time.Date(2024, time.June, 10, 1, 30, 0, 0, paris), false},
+		{"59", Excel1900, time.UTC, time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC), false},
+		{"61", Excel1900, time.UTC, time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC), false},
+		{"0", Excel1904, time.UTC, time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC), false},
+		{"739643400.25", Cocoa, time.UTC, time.Date(2024, time.June, 9, 16, 30, 0, 250000000, time.UTC), false},
+		// 02:30 on the spring-forward day does not exist in Paris
+		{"46110.1041666667", Excel1900, paris, time.Date(2026, time.March, 29, 3, 30, 0, 0, paris), true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format)+" "+tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value, tt.format, tt.loc)
+			if err != nil {
+				t.Fatalf("Parse returned error: %v", err)
+			}
+			if !got.Time.Equal(tt.want) || got.Nonexistent != tt.nonexistent {
+				t.Errorf("Parse(%q, %s) = %s (nonexistent %v), want %s", tt.value, tt.format, got.Time, got.Nonexistent, tt.want)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		value  string
+		format Format
+		want   string
+	}{
+		{"60", Excel1900, "1900-02-29"},
+		{"-1", Excel1900, "Excel serials"},
+		{"2451545.5", JulianDayNumber, "whole number"},
+		{"0", JulianDay, "outside the years"},
+		{"soon", Cocoa, "expected a number"},
+	} {
+		if _, err := Parse(tt.value, tt.format, time.UTC); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Parse(%q, %s) expected error containing %q, got %v", tt.value, tt.format, tt.want, err)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for input, want := range map[string]Format{"JD": JulianDay, "lotus": Excel1900, "rata_die": RataDie, "NSDate": Cocoa} {
+		if got, err := ParseFormat(input); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %s, %v; want %s", input, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("stardate"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
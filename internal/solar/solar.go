@@ -0,0 +1,191 @@
+// Package solar computes sunrise, sunset, twilight and solar noon offline with the NOAA
+// Solar Calculator algorithm, itself based on Meeus, Astronomical Algorithms. Times are
+// accurate to about a minute between the polar circles, and to a few minutes beyond them
+// where the Sun grazes the horizon.
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// Horizon is the zenith angle of the Sun's centre, in degrees, that an event marks.
+type Horizon float64
+
+// Horizons of the standard events.
+const (
+	// Sunrise and sunset: the upper limb on the horizon, with standard refraction
+	Sunrise Horizon = 90.833
+	// Civil twilight: the centre 6° below the horizon
+	Civil Horizon = 96
+	// Nautical twilight: 12° below
+	Nautical Horizon = 102
+	// Astronomical twilight: 18° below
+	Astronomical Horizon = 108
+)
+
+// State tells whether the Sun crosses a horizon on a day.
+type State int
+
+const (
+	// Crosses is a day on which the Sun rises above and sets below the horizon
+	Crosses State = iota
+	// AlwaysAbove is a day the Sun stays above the horizon, such as a polar day
+	AlwaysAbove
+	// AlwaysBelow is a day the Sun stays below the horizon, such as a polar night
+	AlwaysBelow
+)
+
+const unixEpochJD = 2440587.5 // Julian Day of 1970-01-01 00:00 UTC
+
+// Noon returns the solar noon, when the Sun crosses the meridian, on the local date of day
+// in its location, at a longitude in degrees east. It is zero when the local date has no
+// solar noon, which only happens in zones far from their longitude.
+func Noon(day time.Time, longitude float64) time.Time {
+	midnight, ok := solarDate(day, longitude)
+	if !ok {
+		return time.Time{}
+	}
+	t, _ := crossing(midnight, 0, longitude, 0, 0)
+	return t.In(day.Location())
+}
+
+// Crossing returns the times the Sun's centre reaches a horizon in the morning and in the
+// evening around the solar noon of the local date of day, in day's location, at a
+// latitude and longitude in degrees (north and east positive). Near the poles these can
+// fall on the local dates before and after. When the Sun stays above or below the horizon
+// all day, the times are zero and the state says which.
+func Crossing(day time.Time, latitude, longitude float64, h Horizon) (time.Time, time.Time, State) {
+	midnight, _ := solarDate(day, longitude)
+	rise, state := crossing(midnight, latitude, longitude, float64(h), 1)
+	set, _ := crossing(midnight, latitude, longitude, float64(h), -1)
+	if state != Crosses {
+		return time.Time{}, time.Time{}, state
+	}
+	return rise.In(day.Location()), set.In(day.Location()), Crosses
+}
+
+// solarDate returns the UTC midnight of the solar day whose noon falls on the local date
+// of day, and false when none does. It is the UTC date of the local date, or the one
+// before or after in zones far from their longitude.
+func solarDate(day time.Time, longitude float64) (time.Time, bool) {
+	y, m, d := day.Date()
+	for _, shift := range []int{0, -1, 1} {
+		midnight := time.Date(y, m, d+shift, 0, 0, 0, 0, time.UTC)
+		noon, _ := crossing(midnight, 0, longitude, 0, 0)
+		if ny, nm, nd := noon.In(day.Location()).Date(); ny == y && nm == m && nd == d {
+			return midnight, true
+		}
+	}
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC), false
+}
+
+// crossing returns an event of the solar day starting at a UTC midnight: solar noon for
+// direction 0, and the morning crossing of a zenith angle for 1 and the evening one for
+// -1. Each estimate refines the Sun's position at the time of the previous one.
+func crossing(midnight time.Time, latitude, longitude, zenith float64, direction int) (time.Time, State) {
+	jd := julianDay(midnight)
+	minutes := 720 - 4*longitude
+	for i := range 3 {
+		p := position(jd + minutes/1440)
+		var ha float64
+		if direction != 0 {
+			var state State
+			if ha, state = hourAngle(latitude, p.declination, zenith); state != Crosses {
+				if i == 0 {
+					return time.Time{}, state
+				}
+				// The Sun only just reaches the horizon: keep the last estimate
+				break
+			}
+		}
+		minutes = 720 - 4*(longitude+float64(direction)*ha) - p.equationOfTime
+	}
+	return midnight.Add(time.Duration(minutes * float64(time.Minute))).Round(time.Minute), Crosses
+}
+
+// DayLength returns the time from sunrise to sunset on the local date of day: 24 hours
+// on a polar day and 0 on a polar night.
+func DayLength(day time.Time, latitude, longitude float64) time.Duration {
+	p := position(noonJulianDay(day, longitude))
+	ha, state := hourAngle(latitude, p.declination, float64(Sunrise))
+	switch state {
+	case AlwaysAbove:
+		return 24 * time.Hour
+	case AlwaysBelow:
+		return 0
+	}
+	// The Sun turns 15° an hour
+	return time.Duration(2 * ha / 15 * float64(time.Hour)).Round(time.Second)
+}
+
+// NoonElevation returns the Sun's geometric elevation above the horizon at solar noon,
+// in degrees: negative during a polar night.
+func NoonElevation(day time.Time, latitude, longitude float64) float64 {
+	p := position(noonJulianDay(day, longitude))
+	return 90 - math.Abs(latitude-degrees(p.declination))
+}
+
+// noonJulianDay returns the approximate Julian Day of solar noon on day's local date.
+func noonJulianDay(day time.Time, longitude float64) float64 {
+	midnight, _ := solarDate(day, longitude)
+	return julianDay(midnight) + 0.5 - longitude/360
+}
+
+// hourAngle returns the hour angle in degrees at which the Sun's centre reaches a zenith
+// angle, or the state when it never does.
+func hourAngle(latitude, declination, zenith float64) (float64, State) {
+	lat := radians(latitude)
+	cos := math.Cos(radians(zenith))/(math.Cos(lat)*math.Cos(declination)) - math.Tan(lat)*math.Tan(declination)
+	switch {
+	case cos > 1:
+		return 0, AlwaysBelow
+	case cos < -1:
+		return 0, AlwaysAbove
+	}
+	return degrees(math.Acos(cos)), Crosses
+}
+
+// sun is the Sun's declination, in radians, and the equation of time, in minutes.
+type sun struct {
+	declination    float64
+	equationOfTime float64
+}
+
+// position returns the Sun's declination and the equation of time at a Julian Day.
+func position(jd float64) sun {
+	t := (jd - 2451545) / 36525
+	meanLongitude := radians(math.Mod(280.46646+t*(36000.76983+t*0.0003032), 360))
+	meanAnomaly := radians(357.52911 + t*(35999.05029-0.0001537*t))
+	eccentricity := 0.016708634 - t*(0.000042037+0.0000001267*t)
+	center := math.Sin(meanAnomaly)*(1.914602-t*(0.004817+0.000014*t)) +
+		math.Sin(2*meanAnomaly)*(0.019993-0.000101*t) +
+		math.Sin(3*meanAnomaly)*0.000289
+	omega := radians(125.04 - 1934.136*t)
+	apparentLongitude := meanLongitude + radians(center-0.00569-0.00478*math.Sin(omega))
+	meanObliquity := 23 + (26+(21.448-t*(46.815+t*(0.00059-t*0.001813)))/60)/60
+	obliquity := radians(meanObliquity + 0.00256*math.Cos(omega))
+
+	y := math.Pow(math.Tan(obliquity/2), 2)
+	equation := y*math.Sin(2*meanLongitude) -
+		2*eccentricity*math.Sin(meanAnomaly) +
+		4*eccentricity*y*math.Sin(meanAnomaly)*math.Cos(2*meanLongitude) -
+		0.5*y*y*math.Sin(4*meanLongitude) -
+		1.25*eccentricity*eccentricity*math.Sin(2*meanAnomaly)
+	return sun{
+		declination:    math.Asin(math.Sin(obliquity) * math.Sin(apparentLongitude)),
+		equationOfTime: 4 * degrees(equation),
+	}
+}
+
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/86400 + unixEpochJD
+}
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
@@ -0,0 +1,111 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestCrossing(t *testing.T) {
+	const layout = "2006-01-02 15:04"
+	tests := []struct {
+		name      string
+		tz        string
+		date      string
+		lat, lon  float64
+		h         Horizon
+		rise, set string
+		state     State
+	}{
+		// NOAA Solar Calculator values
+		{"London sunrise", "Europe/London", "2026-06-21", 51.5074, -0.1278, Sunrise, "2026-06-21 04:43", "2026-06-21 21:22", Crosses},
+		{"London nautical", "Europe/London", "2026-06-21", 51.5074, -0.1278, Nautical, "2026-06-21 02:41", "2026-06-21 23:24", Crosses},
+		{"London no astronomical night", "Europe/London", "2026-06-21", 51.5074, -0.1278, Astronomical, "", "", AlwaysAbove},
+		{"New York equinox", "America/New_York", "2026-03-20", 40.7128, -74.0060, Sunrise, "2026-03-20 06:59", "2026-03-20 19:08", Crosses},
+		{"Sydney civil", "Australia/Sydney", "2026-12-21", -33.8688, 151.2093, Civil, "2026-12-21 05:11", "2026-12-21 20:35", Crosses},
+		{"Tromsø polar day", "Europe/Oslo", "2026-06-21", 69.6492, 18.9553, Sunrise, "", "", AlwaysAbove},
+		{"Tromsø polar night", "Europe/Oslo", "2026-12-21", 69.6492, 18.9553, Sunrise, "", "", AlwaysBelow},
+		{"Tromsø polar night civil twilight", "Europe/Oslo", "2026-12-21", 69.6492, 18.9553, Civil, "2026-12-21 09:31", "2026-12-21 13:53", Crosses},
+		// Civil dusk after midnight belongs to the day before
+		{"Helsinki civil", "Europe/Helsinki", "2026-06-21", 60.17, 24.94, Civil, "2026-06-21 02:02", "2026-06-22 00:42", Crosses},
+		// A zone far east of its longitude
+		{"Kiritimati", "Pacific/Kiritimati", "2026-03-01", 1.87, -157.4, Sunrise, "2026-03-01 06:40", "2026-03-01 18:44", Crosses},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := mustLoadLocation(t, tt.tz)
+			day, _ := time.ParseInLocation(time.DateOnly, tt.date, loc)
+			rise, set, state := Crossing(day, tt.lat, tt.lon, tt.h)
+			if state != tt.state {
+				t.Errorf("state = %d, want %d", state, tt.state)
+			}
+			format := func(t time.Time) string {
+				if t.IsZero() {
+					return ""
+				}
+				return t.Format(layout)
+			}
+			if format(rise) != tt.rise || format(set) != tt.set {
+				t.Errorf("got %q to %q, want %q to %q", format(rise), format(set), tt.rise, tt.set)
+			}
+			if !rise.IsZero() && rise.Location() != loc {
+				t.Errorf("location = %v, want %v", rise.Location(), loc)
+			}
+		})
+	}
+}
+
+func TestNoonAndDayLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		tz        string
+		date      string
+		lat, lon  float64
+		noon      string
+		length    time.Duration
+		elevation float64
+	}{
+		{"London solstice", "Europe/London", "2026-06-21", 51.5074, -0.1278, "2026-06-21T13:02:00+01:00", 16*time.Hour + 38*time.Minute, 61.93},
+		{"Tromsø polar day", "Europe/Oslo", "2026-06-21", 69.6492, 18.9553, "2026-06-21T12:46:00+02:00", 24 * time.Hour, 43.79},
+		{"Tromsø polar night", "Europe/Oslo", "2026-12-21", 69.6492, 18.9553, "2026-12-21T11:42:00+01:00", 0, -3.09},
+		{"Singapore", "Asia/Singapore", "2026-01-01", 1.3521, 103.8198, "2026-01-01T13:08:00+08:00", 12*time.Hour + 3*time.Minute, 65.65},
+		// Solar noon before 08:00 in a zone far west of its longitude
+		{"Honolulu in Tokyo time", "Asia/Tokyo", "2026-03-01", 21.3, -157.85, "2026-03-01T07:44:00+09:00", 11*time.Hour + 43*time.Minute, 61.01},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, _ := time.ParseInLocation(time.DateOnly, tt.date, mustLoadLocation(t, tt.tz))
+			if got := Noon(day, tt.lon).Format(time.RFC3339); got != tt.noon {
+				t.Errorf("Noon() = %s, want %s", got, tt.noon)
+			}
+			if got := DayLength(day, tt.lat, tt.lon).Round(time.Minute); got != tt.length {
+				t.Errorf("DayLength() = %v, want %v", got, tt.length)
+			}
+			if got := NoonElevation(day, tt.lat, tt.lon); math.Abs(got-tt.elevation) > 0.01 {
+				t.Errorf("NoonElevation() = %.2f, want %.2f", got, tt.elevation)
+			}
+		})
+	}
+}
+
+func TestDayLengthMatchesCrossing(t *testing.T) {
+	loc := mustLoadLocation(t, "America/Chicago")
+	for d := 1; d <= 365; d += 7 {
+		day := time.Date(2026, time.January, d, 0, 0, 0, 0, loc)
+		rise, set, _ := Crossing(day, 41.88, -87.63, Sunrise)
+		if diff := set.Sub(rise) - DayLength(day, 41.88, -87.63); diff.Abs() > 2*time.Minute {
+			t.Errorf("%s: sunset - sunrise differs from DayLength by %v", day.Format(time.DateOnly), diff)
+		}
+	}
+}
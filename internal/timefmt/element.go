@@ -0,0 +1,289 @@
+package timefmt
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// kind is the field an element formats.
+type kind int
+
+const (
+	literal  kind = iota
+	goLayout      // a Go layout, formatted by the time package
+	year          // width 2 is the year of the century
+	isoYear
+	century
+	quarter
+	month
+	monthAbbr
+	monthFull
+	day
+	yearDay
+	weekdayAbbr
+	weekdayFull
+	weekdayShort // "Mo"
+	weekdaySun0  // 0 for Sunday to 6
+	weekdayMon1  // 1 for Monday to 7
+	isoWeek
+	weekSun // weeks starting on Sunday, 0 before the first Sunday
+	weekMon // weeks starting on Monday, 0 before the first Monday
+	hour24
+	hour12
+	hour1to24
+	hour0to11
+	minute
+	second
+	fraction // width digits
+	ampmUpper
+	ampmLower
+	zoneAbbr
+	zoneName // the IANA name
+	zoneLong // the long name from Names, or the IANA name
+	offset   // text is the Go layout of the offset: "-0700", "-07:00" or "-07"
+	unixSeconds
+	unixMillis
+	era
+)
+
+// element is a literal or a field of a pattern. Numbers are padded to width with
+// padding, unless padding is 0.
+type element struct {
+	kind    kind
+	text    string
+	width   int
+	padding byte
+	ordinal bool // "1st", "2nd"
+	zulu    bool // a zero offset is written "Z"
+	signed  bool // the sign is always written, as in "+002026"
+}
+
+// number returns the value of a numeric field, and false for other fields.
+func (e element) number(t time.Time) (int, bool) {
+	switch e.kind {
+	case year:
+		if e.width == 2 {
+			return t.Year() % 100, true
+		}
+		return t.Year(), true
+	case isoYear:
+		y, _ := t.ISOWeek()
+		if e.width == 2 {
+			return y % 100, true
+		}
+		return y, true
+	case century:
+		return t.Year() / 100, true
+	case quarter:
+		return (int(t.Month())-1)/3 + 1, true
+	case month:
+		return int(t.Month()), true
+	case day:
+		return t.Day(), true
+	case yearDay:
+		return t.YearDay(), true
+	case weekdaySun0:
+		return int(t.Weekday()), true
+	case weekdayMon1:
+		return (int(t.Weekday())+6)%7 + 1, true
+	case isoWeek:
+		_, w := t.ISOWeek()
+		return w, true
+	case weekSun:
+		return (t.YearDay() - 1 + 7 - int(t.Weekday())) / 7, true
+	case weekMon:
+		return (t.YearDay() - 1 + 7 - (int(t.Weekday())+6)%7) / 7, true
+	case hour24:
+		return t.Hour(), true
+	case hour12:
+		if h := t.Hour() % 12; h != 0 {
+			return h, true
+		}
+		return 12, true
+	case hour1to24:
+		if t.Hour() == 0 {
+			return 24, true
+		}
+		return t.Hour(), true
+	case hour0to11:
+		return t.Hour() % 12, true
+	case minute:
+		return t.Minute(), true
+	case second:
+		return t.Second(), true
+	}
+	return 0, false
+}
+
+func (e element) format(b *strings.Builder, t time.Time, names *Names) {
+	if n, ok := e.number(t); ok {
+		if e.signed {
+			sign := byte('+')
+			if n < 0 {
+				sign, n = '-', -n
+			}
+			b.WriteByte(sign)
+		}
+		b.WriteString(pad(n, e.width, e.padding))
+		if e.ordinal {
+			b.WriteString(ordinalSuffix(n))
+		}
+		return
+	}
+	switch e.kind {
+	case literal:
+		b.WriteString(e.text)
+	case goLayout:
+		b.WriteString(t.Format(e.text))
+	case monthAbbr:
+		b.WriteString(or(names.ShortMonths[t.Month()-1], t.Month().String()[:3]))
+	case monthFull:
+		b.WriteString(or(names.Months[t.Month()-1], t.Month().String()))
+	case weekdayAbbr:
+		b.WriteString(or(names.ShortDays[t.Weekday()], t.Weekday().String()[:3]))
+	case weekdayFull:
+		b.WriteString(or(names.Days[t.Weekday()], t.Weekday().String()))
+	case weekdayShort:
+		b.WriteString(or(names.ShortDays[t.Weekday()], t.Weekday().String()[:2]))
+	case fraction:
+		digits := pad(t.Nanosecond(), 9, '0') + strings.Repeat("0", max(e.width-9, 0))
+		b.WriteString(digits[:e.width])
+	case ampmUpper, ampmLower:
+		period := t.Format("PM")
+		if t.Hour() < 12 {
+			period = or(names.AM, period)
+		} else {
+			period = or(names.PM, period)
+		}
+		if e.kind == ampmLower {
+			period = strings.ToLower(period)
+		}
+		b.WriteString(period)
+	case zoneAbbr:
+		b.WriteString(t.Format("MST"))
+	case zoneLong:
+		if names.ZoneName != nil {
+			b.WriteString(names.ZoneName(t))
+			return
+		}
+		b.WriteString(t.Location().String())
+	case zoneName:
+		b.WriteString(t.Location().String())
+	case offset:
+		if _, off := t.Zone(); e.zulu && off == 0 {
+			b.WriteString("Z")
+		} else {
+			b.WriteString(t.Format(e.text))
+		}
+	case unixSeconds:
+		b.WriteString(strconv.FormatInt(t.Unix(), 10))
+	case unixMillis:
+		b.WriteString(strconv.FormatInt(t.UnixMilli(), 10))
+	case era:
+		if t.Year() > 0 {
+			b.WriteString("AD")
+		} else {
+			b.WriteString("BC")
+		}
+	}
+}
+
+// or returns localized, or english when there is no localized text.
+func or(localized, english string) string {
+	if localized != "" {
+		return localized
+	}
+	return english
+}
+
+// goToken returns the Go layout token of the element, and false when Go has none.
+func (e element) goToken() (string, bool) {
+	if e.ordinal || e.signed {
+		return "", false
+	}
+	switch e.kind {
+	case literal, goLayout:
+		return e.text, true
+	case year:
+		if e.width == 2 {
+			return "06", true
+		}
+		return "2006", true
+	case month:
+		return e.goNumber("01", "1")
+	case day:
+		switch e.padding {
+		case '0':
+			return "02", true
+		case ' ':
+			return "_2", true
+		}
+		return "2", true
+	case yearDay:
+		switch e.padding {
+		case '0':
+			return "002", true
+		case ' ':
+			return "__2", true
+		}
+	case hour24:
+		return "15", e.padding == '0'
+	case hour12:
+		return e.goNumber("03", "3")
+	case minute:
+		return e.goNumber("04", "4")
+	case second:
+		return e.goNumber("05", "5")
+	case monthAbbr:
+		return "Jan", true
+	case monthFull:
+		return "January", true
+	case weekdayAbbr:
+		return "Mon", true
+	case weekdayFull:
+		return "Monday", true
+	case fraction:
+		// Go reads the digits as a fraction only after a '.' or ','
+		return strings.Repeat("0", e.width), e.width <= 9
+	case ampmUpper:
+		return "PM", true
+	case ampmLower:
+		return "pm", true
+	case zoneAbbr:
+		return "MST", true
+	case offset:
+		if e.zulu {
+			return "Z" + strings.TrimPrefix(e.text, "-"), true
+		}
+		return e.text, true
+	}
+	return "", false
+}
+
+// goNumber picks the zero-padded or unpadded Go token of a numeric field. Go has no
+// space-padded form of most fields.
+func (e element) goNumber(padded, plain string) (string, bool) {
+	switch e.padding {
+	case '0':
+		return padded, true
+	case 0:
+		return plain, true
+	}
+	return "", false
+}
+
+func ordinalSuffix(n int) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
@@ -0,0 +1,371 @@
+package timefmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// strftimeComposites expands the strftime conversions that stand for several fields.
+var strftimeComposites = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+}
+
+// strftimeFields maps strftime conversions to fields, with their default width and
+// padding.
+var strftimeFields = map[byte]element{
+	'a': {kind: weekdayAbbr},
+	'A': {kind: weekdayFull},
+	'b': {kind: monthAbbr},
+	'h': {kind: monthAbbr},
+	'B': {kind: monthFull},
+	'C': {kind: century, width: 2, padding: '0'},
+	'd': {kind: day, width: 2, padding: '0'},
+	'e': {kind: day, width: 2, padding: ' '},
+	'g': {kind: isoYear, width: 2, padding: '0'},
+	'G': {kind: isoYear, width: 4, padding: '0'},
+	'H': {kind: hour24, width: 2, padding: '0'},
+	'I': {kind: hour12, width: 2, padding: '0'},
+	'j': {kind: yearDay, width: 3, padding: '0'},
+	'k': {kind: hour24, width: 2, padding: ' '},
+	'l': {kind: hour12, width: 2, padding: ' '},
+	'm': {kind: month, width: 2, padding: '0'},
+	'M': {kind: minute, width: 2, padding: '0'},
+	'p': {kind: ampmUpper},
+	'P': {kind: ampmLower},
+	's': {kind: unixSeconds},
+	'S': {kind: second, width: 2, padding: '0'},
+	'u': {kind: weekdayMon1, width: 1},
+	'U': {kind: weekSun, width: 2, padding: '0'},
+	'V': {kind: isoWeek, width: 2, padding: '0'},
+	'w': {kind: weekdaySun0, width: 1},
+	'W': {kind: weekMon, width: 2, padding: '0'},
+	'y': {kind: year, width: 2, padding: '0'},
+	'Y': {kind: year, width: 4, padding: '0'},
+	'z': {kind: offset, text: "-0700"},
+	'Z': {kind: zoneAbbr},
+	// Extensions: Python microseconds, Ruby milliseconds and GNU nanoseconds
+	'f': {kind: fraction, width: 6},
+	'L': {kind: fraction, width: 3},
+	'N': {kind: fraction, width: 9},
+	'n': {kind: literal, text: "\n"},
+	't': {kind: literal, text: "\t"},
+	'%': {kind: literal, text: "%"},
+}
+
+// parseStrftime reads a strftime pattern. The GNU flags '-' (no padding), '_' (space
+// padding) and '0' (zero padding), a width before N and "%:z" are understood.
+func parseStrftime(pattern string) ([]element, error) {
+	var elements []element
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c != '%' {
+			elements = appendLiteral(elements, pattern[i:i+1])
+			continue
+		}
+		i++
+		var flag byte
+		if i < len(pattern) && strings.IndexByte("-_0", pattern[i]) >= 0 {
+			flag = pattern[i]
+			i++
+		}
+		width := 0
+		for ; i < len(pattern) && pattern[i] >= '0' && pattern[i] <= '9'; i++ {
+			width = width*10 + int(pattern[i]-'0')
+		}
+		colon := i < len(pattern) && pattern[i] == ':'
+		if colon {
+			i++
+		}
+		if i >= len(pattern) {
+			return nil, fmt.Errorf("pattern ends inside a conversion")
+		}
+		conv := pattern[i]
+		if composite, ok := strftimeComposites[conv]; ok {
+			expanded, err := parseStrftime(composite)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, expanded...)
+			continue
+		}
+		e, ok := strftimeFields[conv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported conversion %%%c", conv)
+		}
+		switch {
+		case colon && conv == 'z':
+			e.text = "-07:00"
+		case colon:
+			return nil, fmt.Errorf("unsupported conversion %%:%c", conv)
+		}
+		if width > 0 && e.kind == fraction {
+			e.width = width
+		}
+		switch flag {
+		case '-':
+			e.padding = 0
+		case '_':
+			e.padding = ' '
+		case '0':
+			e.padding = '0'
+		}
+		if e.kind == literal {
+			elements = appendLiteral(elements, e.text)
+		} else {
+			elements = append(elements, e)
+		}
+	}
+	return elements, nil
+}
+
+// parseJava reads a Java SimpleDateFormat or DateTimeFormatter pattern. Letters form
+// fields whose meaning depends on how often they repeat; text in single quotes is
+// literal and two single quotes stand for one.
+func parseJava(pattern string) ([]element, error) {
+	var elements []element
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		if c == '\'' {
+			end := i + 1
+			var text strings.Builder
+			for ; end < len(pattern); end++ {
+				if pattern[end] == '\'' {
+					if end+1 < len(pattern) && pattern[end+1] == '\'' {
+						text.WriteByte('\'')
+						end++
+						continue
+					}
+					break
+				}
+				text.WriteByte(pattern[end])
+			}
+			switch {
+			case end == len(pattern):
+				return nil, fmt.Errorf("unterminated quote")
+			case end == i+1:
+				text.WriteByte('\'')
+			}
+			elements = appendLiteral(elements, text.String())
+			i = end + 1
+			continue
+		}
+		if !isLetter(c) {
+			elements = appendLiteral(elements, pattern[i:i+1])
+			i++
+			continue
+		}
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+		i += n
+		fields, err := javaField(c, n)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, fields...)
+	}
+	return elements, nil
+}
+
+func javaField(c byte, n int) ([]element, error) {
+	numeric := func(k kind) []element {
+		if n == 1 {
+			return []element{{kind: k}}
+		}
+		return []element{{kind: k, width: n, padding: '0'}}
+	}
+	text := func(short, long kind) []element {
+		if n >= 4 {
+			return []element{{kind: long}}
+		}
+		return []element{{kind: short}}
+	}
+	switch c {
+	case 'G':
+		return []element{{kind: era}}, nil
+	case 'y':
+		if n == 2 {
+			return []element{{kind: year, width: 2, padding: '0'}}, nil
+		}
+		return numeric(year), nil
+	case 'Y':
+		if n == 2 {
+			return []element{{kind: isoYear, width: 2, padding: '0'}}, nil
+		}
+		return numeric(isoYear), nil
+	case 'M', 'L':
+		if n >= 3 {
+			return text(monthAbbr, monthFull), nil
+		}
+		return numeric(month), nil
+	case 'Q', 'q':
+		return numeric(quarter), nil
+	case 'w':
+		return numeric(isoWeek), nil
+	case 'D':
+		return numeric(yearDay), nil
+	case 'd':
+		return numeric(day), nil
+	case 'E':
+		return text(weekdayAbbr, weekdayFull), nil
+	case 'u':
+		return numeric(weekdayMon1), nil
+	case 'a':
+		return []element{{kind: ampmUpper}}, nil
+	case 'H':
+		return numeric(hour24), nil
+	case 'k':
+		return numeric(hour1to24), nil
+	case 'K':
+		return numeric(hour0to11), nil
+	case 'h':
+		return numeric(hour12), nil
+	case 'm':
+		return numeric(minute), nil
+	case 's':
+		return numeric(second), nil
+	case 'S':
+		return []element{{kind: fraction, width: n}}, nil
+	case 'z':
+		if n >= 4 {
+			return []element{{kind: zoneLong}}, nil
+		}
+		return []element{{kind: zoneAbbr}}, nil
+	case 'V':
+		return []element{{kind: zoneName}}, nil
+	case 'Z':
+		switch {
+		case n == 4:
+			return []element{{kind: literal, text: "GMT"}, {kind: offset, text: "-07:00"}}, nil
+		case n >= 5:
+			return []element{{kind: offset, text: "-07:00", zulu: true}}, nil
+		}
+		return []element{{kind: offset, text: "-0700"}}, nil
+	case 'X', 'x':
+		layouts := []string{"-07", "-0700", "-07:00"}
+		return []element{{kind: offset, text: layouts[min(n, 3)-1], zulu: c == 'X'}}, nil
+	}
+	return nil, fmt.Errorf("unsupported pattern letter %q", strings.Repeat(string(c), n))
+}
+
+// momentTokens lists the moment.js tokens, longest first where they share a prefix.
+var momentTokens = []struct {
+	token string
+	elem  element
+}{
+	{"YYYYYY", element{kind: year, width: 6, padding: '0', signed: true}},
+	{"YYYYY", element{kind: year, width: 5, padding: '0'}},
+	{"YYYY", element{kind: year, width: 4, padding: '0'}},
+	{"YY", element{kind: year, width: 2, padding: '0'}},
+	{"Y", element{kind: year}},
+	{"GGGG", element{kind: isoYear, width: 4, padding: '0'}},
+	{"GG", element{kind: isoYear, width: 2, padding: '0'}},
+	{"Qo", element{kind: quarter, ordinal: true}},
+	{"Q", element{kind: quarter}},
+	{"MMMM", element{kind: monthFull}},
+	{"MMM", element{kind: monthAbbr}},
+	{"MM", element{kind: month, width: 2, padding: '0'}},
+	{"Mo", element{kind: month, ordinal: true}},
+	{"M", element{kind: month}},
+	{"DDDD", element{kind: yearDay, width: 3, padding: '0'}},
+	{"DDDo", element{kind: yearDay, ordinal: true}},
+	{"DDD", element{kind: yearDay}},
+	{"DD", element{kind: day, width: 2, padding: '0'}},
+	{"Do", element{kind: day, ordinal: true}},
+	{"D", element{kind: day}},
+	{"dddd", element{kind: weekdayFull}},
+	{"ddd", element{kind: weekdayAbbr}},
+	{"dd", element{kind: weekdayShort}},
+	{"do", element{kind: weekdaySun0, ordinal: true}},
+	{"d", element{kind: weekdaySun0}},
+	{"E", element{kind: weekdayMon1}},
+	{"e", element{kind: weekdaySun0}},
+	{"WW", element{kind: isoWeek, width: 2, padding: '0'}},
+	{"Wo", element{kind: isoWeek, ordinal: true}},
+	{"W", element{kind: isoWeek}},
+	{"A", element{kind: ampmUpper}},
+	{"a", element{kind: ampmLower}},
+	{"HH", element{kind: hour24, width: 2, padding: '0'}},
+	{"H", element{kind: hour24}},
+	{"hh", element{kind: hour12, width: 2, padding: '0'}},
+	{"h", element{kind: hour12}},
+	{"kk", element{kind: hour1to24, width: 2, padding: '0'}},
+	{"k", element{kind: hour1to24}},
+	{"mm", element{kind: minute, width: 2, padding: '0'}},
+	{"m", element{kind: minute}},
+	{"ss", element{kind: second, width: 2, padding: '0'}},
+	{"s", element{kind: second}},
+	{"ZZ", element{kind: offset, text: "-0700"}},
+	{"Z", element{kind: offset, text: "-07:00"}},
+	{"zz", element{kind: zoneAbbr}},
+	{"z", element{kind: zoneAbbr}},
+	{"X", element{kind: unixSeconds}},
+	{"x", element{kind: unixMillis}},
+}
+
+// parseMoment reads a moment.js pattern. Text in square brackets is literal, as are
+// characters other than letters and the T of ISO 8601 dates; other letters that are not
+// tokens are an error rather than text. Locale week numbers (w) are not supported.
+func parseMoment(pattern string) ([]element, error) {
+	var elements []element
+	for i := 0; i < len(pattern); {
+		rest := pattern[i:]
+		if rest[0] == '[' {
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated '['")
+			}
+			elements = appendLiteral(elements, rest[1:end])
+			i += end + 1
+			continue
+		}
+		if rest[0] == 'S' {
+			n := len(rest) - len(strings.TrimLeft(rest, "S"))
+			elements = append(elements, element{kind: fraction, width: min(n, 9)})
+			i += n
+			continue
+		}
+		if rest[0] == 'w' || strings.HasPrefix(rest, "gg") {
+			return nil, fmt.Errorf("locale week numbers are not supported: use W or GGGG for ISO weeks")
+		}
+		matched := false
+		for _, t := range momentTokens {
+			if strings.HasPrefix(rest, t.token) {
+				elements = append(elements, t.elem)
+				i += len(t.token)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			if isLetter(rest[0]) && rest[0] != 'T' {
+				n := len(rest) - len(strings.TrimLeft(rest, rest[:1]))
+				return nil, fmt.Errorf("unsupported token %q: put literal text in brackets", rest[:n])
+			}
+			elements = appendLiteral(elements, rest[:1])
+			i++
+		}
+	}
+	return elements, nil
+}
+
+// appendLiteral appends text, merging it with a preceding literal.
+func appendLiteral(elements []element, text string) []element {
+	if n := len(elements); n > 0 && elements[n-1].kind == literal {
+		elements[n-1].text += text
+		return elements
+	}
+	return append(elements, element{kind: literal, text: text})
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,339 @@
+// Package timefmt formats times with named formats such as RFC 2822 or HTTP-date, and
+// with strftime, Go layout, Java (SimpleDateFormat and DateTimeFormatter) and moment.js
+// patterns. Patterns are translated into a common sequence of fields, which formats
+// directly and converts back into a Go layout where Go has an equivalent.
+package timefmt
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Syntax is a pattern language.
+type Syntax string
+
+// Supported pattern syntaxes.
+const (
+	Auto     Syntax = "auto"
+	Named    Syntax = "named"
+	Strftime Syntax = "strftime"
+	Go       Syntax = "go"
+	Java     Syntax = "java"
+	Moment   Syntax = "moment"
+)
+
+// Syntaxes lists the syntaxes a pattern can be given in.
+var Syntaxes = []Syntax{Auto, Strftime, Go, Java, Moment}
+
+// namedFormat is a predefined format: a Go layout, applied in UTC when utc is set.
+type namedFormat struct {
+	layout string
+	utc    bool
+}
+
+// namedFormats maps format names to layouts. "unix" and "unix_ms" are handled apart.
+var namedFormats = map[string]namedFormat{
+	"iso8601":     {layout: "2006-01-02T15:04:05-07:00"},
+	"rfc3339":     {layout: time.RFC3339},
+	"rfc3339nano": {layout: time.RFC3339Nano},
+	"rfc2822":     {layout: "Mon, 02 Jan 2006 15:04:05 -0700"},
+	"rfc5322":     {layout: "Mon, 02 Jan 2006 15:04:05 -0700"},
+	"rfc1123":     {layout: time.RFC1123},
+	"rfc1123z":    {layout: time.RFC1123Z},
+	"http":        {layout: "Mon, 02 Jan 2006 15:04:05 GMT", utc: true},
+	"rfc850":      {layout: time.RFC850},
+	"rfc822":      {layout: time.RFC822},
+	"rfc822z":     {layout: time.RFC822Z},
+	"ansic":       {layout: time.ANSIC},
+	"unixdate":    {layout: time.UnixDate},
+	"rubydate":    {layout: time.RubyDate},
+	"kitchen":     {layout: time.Kitchen},
+	"stamp":       {layout: time.Stamp},
+	"datetime":    {layout: time.DateTime},
+	"date":        {layout: time.DateOnly},
+	"time":        {layout: time.TimeOnly},
+}
+
+// NamedFormats lists the format names, including "unix" and "unix_ms".
+func NamedFormats() []string {
+	names := make([]string, 0, len(namedFormats)+2)
+	for name := range namedFormats {
+		names = append(names, name)
+	}
+	names = append(names, "unix", "unix_ms")
+	slices.Sort(names)
+	return names
+}
+
+// Formatter formats times with a compiled format.
+type Formatter struct {
+	syntax   Syntax
+	elements []element
+	utc      bool
+	// layout is the Go layout of the format, or "" when Go has no equivalent
+	layout string
+}
+
+// Compile compiles a format name or a pattern in the given syntax. With Auto, names are
+// tried first, then the syntax is guessed with Detect. A pattern without a mark of any
+// syntax, or that the guessed syntax cannot read entirely, is an error rather than text
+// printed as is.
+func Compile(format string, syntax Syntax) (*Formatter, error) {
+	if format == "" {
+		return nil, fmt.Errorf("format is empty")
+	}
+	if syntax == "" || syntax == Auto || syntax == Named {
+		name := namedFormatKey.Replace(strings.ToLower(strings.TrimSpace(format)))
+		if f, ok := compileNamed(name); ok {
+			return f, nil
+		}
+		if syntax == Named {
+			return nil, fmt.Errorf("unknown format name %q: expected one of %s", format, strings.Join(NamedFormats(), ", "))
+		}
+		var err error
+		if syntax, err = Detect(format); err != nil {
+			return nil, err
+		}
+		// Go layouts print unknown letters as they are, so check a guess reads them all
+		if syntax == Go {
+			if err := readGo(format); err != nil {
+				return nil, fmt.Errorf("invalid %s pattern %q: %w; quote literal text or set the syntax", syntax, format, err)
+			}
+		}
+	}
+
+	f := &Formatter{syntax: syntax}
+	var err error
+	switch syntax {
+	case Strftime:
+		f.elements, err = parseStrftime(format)
+	case Go:
+		f.elements, f.layout = []element{{kind: goLayout, text: format}}, format
+		return f, nil
+	case Java:
+		f.elements, err = parseJava(format)
+	case Moment:
+		f.elements, err = parseMoment(format)
+	default:
+		return nil, fmt.Errorf("unknown pattern syntax %q: expected auto, strftime, go, java or moment", syntax)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s pattern %q: %w", syntax, format, err)
+	}
+	f.layout = goEquivalent(f.elements)
+	return f, nil
+}
+
+// namedFormatKey ignores separators in format names, so "RFC-3339" and "unix_ms" match.
+var namedFormatKey = strings.NewReplacer("-", "", "_", "", " ", "")
+
+func compileNamed(name string) (*Formatter, bool) {
+	switch name {
+	case "unix":
+		return &Formatter{syntax: Named, elements: []element{{kind: unixSeconds}}}, true
+	case "unixms":
+		return &Formatter{syntax: Named, elements: []element{{kind: unixMillis}}}, true
+	}
+	n, ok := namedFormats[name]
+	if !ok {
+		return nil, false
+	}
+	return &Formatter{syntax: Named, elements: []element{{kind: goLayout, text: n.layout}}, utc: n.utc, layout: n.layout}, true
+}
+
+// Detect guesses the syntax of a pattern, as Compile does for Auto, and fails when the
+// pattern has no mark of any syntax, as ordinary text such as "Week 02" does. Text in
+// single quotes or square brackets is left out. '%' means strftime. Otherwise Go's
+// reference values (2006, 15:04, Jan, Mon) suggest a Go layout, quoted text, yy, dd or
+// EEE suggest Java, brackets, YY, DD, Do or dddd suggest moment.js, and times such as
+// HH:mm suggest both: the first suggested syntax reading every letter of the pattern as a
+// field wins, with moment.js before Java when it has a mark of its own.
+func Detect(pattern string) (Syntax, error) {
+	bare := withoutLiterals(pattern)
+	if strings.Contains(bare, "%") {
+		return Strftime, nil
+	}
+	var candidates []Syntax
+	if containsAny(bare, "2006", "15:04", "3:04", "01/02", "Jan", "Mon", "MST", "Z07") {
+		candidates = append(candidates, Go)
+	}
+	moment := containsAny(bare, "[", "YY", "DD", "Do", "dddd")
+	times := containsAny(bare, "HH:mm", "hh:mm", "H:mm", "h:mm", "mm:ss")
+	if moment {
+		candidates = append(candidates, Moment)
+	}
+	if times || containsAny(bare, "yy", "dd", "EEE") || quoted(pattern) {
+		candidates = append(candidates, Java)
+	}
+	if times && !moment {
+		candidates = append(candidates, Moment)
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("cannot tell the syntax of pattern %q: set syntax to strftime, go, java or moment", pattern)
+	}
+	for _, syntax := range candidates {
+		if reads(pattern, syntax) {
+			return syntax, nil
+		}
+	}
+	return candidates[0], nil
+}
+
+// quoted reports whether a pattern has text in a pair of single quotes, as Java quotes
+// literals.
+func quoted(pattern string) bool {
+	first := strings.IndexByte(pattern, '\'')
+	return first >= 0 && strings.IndexByte(pattern[first+1:], '\'') >= 0
+}
+
+// reads reports whether every letter of a pattern is a field, or quoted, in a syntax.
+func reads(pattern string, syntax Syntax) bool {
+	var err error
+	switch syntax {
+	case Go:
+		err = readGo(pattern)
+	case Java:
+		_, err = parseJava(pattern)
+	case Moment:
+		_, err = parseMoment(pattern)
+	}
+	return err == nil
+}
+
+// goLayoutWords removes the Go layout fields spelled with letters.
+var goLayoutWords = strings.NewReplacer("January", "", "Jan", "", "Monday", "", "Mon", "", "MST", "", "PM", "", "pm", "", "Z07", "")
+
+// readGo returns an error when a Go layout has letters that are not part of a field, other
+// than the T of ISO 8601 dates.
+func readGo(layout string) error {
+	rest := goLayoutWords.Replace(layout)
+	for i := 0; i < len(rest); i++ {
+		if c := rest[i]; isLetter(c) && c != 'T' {
+			n := len(rest[i:]) - len(strings.TrimLeft(rest[i:], string(c)))
+			return fmt.Errorf("unsupported layout letters %q", rest[i:i+n])
+		}
+	}
+	return nil
+}
+
+// withoutLiterals removes the text in single quotes (Java) and in square brackets
+// (moment.js) from a pattern, keeping the brackets. An unterminated quote or bracket
+// keeps the rest of the pattern, as in the Go layout "Jan '06".
+func withoutLiterals(pattern string) string {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		closing := byte(0)
+		switch pattern[i] {
+		case '\'':
+			closing = '\''
+		case '[':
+			closing = ']'
+		}
+		end := -1
+		if closing != 0 {
+			end = strings.IndexByte(pattern[i+1:], closing)
+		}
+		if end < 0 {
+			b.WriteByte(pattern[i])
+			continue
+		}
+		if closing == ']' {
+			b.WriteString("[]")
+		}
+		i += end + 1
+	}
+	return b.String()
+}
+
+func containsAny(s string, substrings ...string) bool {
+	for _, sub := range substrings {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// Syntax returns the syntax the format was read in, or Named for a format name.
+func (f *Formatter) Syntax() Syntax {
+	return f.syntax
+}
+
+// GoLayout returns the equivalent Go layout, and false when Go layouts cannot express
+// the format, for example ISO week numbers or ordinal days.
+func (f *Formatter) GoLayout() (string, bool) {
+	return f.layout, f.layout != ""
+}
+
+// Names holds the text of month, weekday, day period and zone fields in a language.
+// Empty entries fall back to English.
+type Names struct {
+	Months      [12]string
+	ShortMonths [12]string
+	Days        [7]string // Sunday first
+	ShortDays   [7]string
+	AM, PM      string
+	// ZoneName returns the long name of the zone of a time, such as "heure normale
+	// d’Europe centrale". Without it, long zone names are IANA names.
+	ZoneName func(t time.Time) string
+}
+
+// Format formats t with English names.
+func (f *Formatter) Format(t time.Time) string {
+	return f.FormatNames(t, nil)
+}
+
+// FormatNames formats t with the given names. Go layouts and named formats are always
+// English.
+func (f *Formatter) FormatNames(t time.Time, names *Names) string {
+	if f.utc {
+		t = t.UTC()
+	}
+	if names == nil {
+		names = &Names{}
+	}
+	var b strings.Builder
+	for _, e := range f.elements {
+		e.format(&b, t, names)
+	}
+	return b.String()
+}
+
+// goEquivalent returns a Go layout producing the same text as elements, or "".
+func goEquivalent(elements []element) string {
+	var b strings.Builder
+	for _, e := range elements {
+		token, ok := e.goToken()
+		if !ok {
+			return ""
+		}
+		b.WriteString(token)
+	}
+	layout := b.String()
+	// Literal text can read as Go layout tokens, so keep the layout only when it agrees
+	f := &Formatter{elements: elements}
+	for _, t := range layoutSamples {
+		if t.Format(layout) != f.Format(t) {
+			return ""
+		}
+	}
+	return layout
+}
+
+// layoutSamples vary every field so that a wrong layout shows up.
+var layoutSamples = []time.Time{
+	time.Date(2026, time.March, 9, 14, 5, 7, 123456789, time.FixedZone("XST", -(3*3600+30*60))),
+	time.Date(1999, time.December, 31, 23, 59, 59, 0, time.UTC),
+	time.Date(2008, time.October, 25, 0, 30, 0, 50000000, time.FixedZone("", 5*3600+45*60)),
+}
+
+func pad(n, width int, padding byte) string {
+	s := strconv.Itoa(n)
+	if padding == 0 || len(s) >= width {
+		return s
+	}
+	return strings.Repeat(string(padding), width-len(s)) + s
+}
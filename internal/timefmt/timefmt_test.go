@@ -0,0 +1,207 @@
+package timefmt
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestFormat(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	// Monday 9 March 2026, ISO week 11
+	ref := time.Date(2026, time.March, 9, 14, 5, 7, 123456789, paris)
+	newYear := time.Date(2027, time.January, 1, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		format string
+		syntax Syntax
+		t      time.Time
+		want   string
+		kind   Syntax
+		layout string
+	}{
+		{name: "named rfc2822", format: "RFC2822", t: ref, want: "Mon, 09 Mar 2026 14:05:07 +0100", kind: Named, layout: "Mon, 02 Jan 2006 15:04:05 -0700"},
+		{name: "named http in UTC", format: "http", t: ref, want: "Mon, 09 Mar 2026 13:05:07 GMT", kind: Named, layout: "Mon, 02 Jan 2006 15:04:05 GMT"},
+		{name: "named with separators", format: "RFC-3339", t: ref, want: "2026-03-09T14:05:07+01:00", kind: Named, layout: time.RFC3339},
+		{name: "named unix_ms", format: "unix_ms", t: ref, want: "1773061507123", kind: Named},
+		{name: "named unix", format: "unix", syntax: Named, t: ref, want: "1773061507", kind: Named},
+		{name: "strftime", format: "%Y-%m-%d %H:%M:%S %z", t: ref, want: "2026-03-09 14:05:07 +0100", kind: Strftime, layout: "2006-01-02 15:04:05 -0700"},
+		{name: "strftime composites", format: "%c | %D | %r", t: ref, want: "Mon Mar  9 14:05:07 2026 | 03/09/26 | 02:05:07 PM", kind: Strftime, layout: "Mon Jan _2 15:04:05 2006 | 01/02/06 | 03:04:05 PM"},
+		{name: "strftime flags", format: "%-d/%-m %_H|%e %:z %Z", t: ref, want: "9/3 14| 9 +01:00 CET", kind: Strftime},
+		{name: "strftime ISO week and weekday", format: "%G-W%V-%u %j %U %W %w", t: ref, want: "2026-W11-1 068 10 10 1", kind: Strftime},
+		{name: "strftime fractions", format: "%S.%f %L %3N %N", t: ref, want: "07.123456 123 123 123456789", kind: Strftime},
+		{name: "strftime percent and century", format: "100%% %C%y", t: newYear, want: "100% 2027", kind: Strftime},
+		{name: "strftime 12-hour at midnight", format: "%l:%M %P", t: newYear, want: "12:30 am", kind: Strftime},
+		{name: "go layout", format: "Monday 2 January 2006 15:04", t: ref, want: "Monday 9 March 2026 14:05", kind: Go, layout: "Monday 2 January 2006 15:04"},
+		{name: "java", format: "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", t: ref, want: "2026-03-09T14:05:07.123+01:00", kind: Java, layout: "2006-01-02T15:04:05.000Z07:00"},
+		{name: "java zulu", format: "yyyy-MM-dd'T'HH:mm:ssX", syntax: Java, t: newYear, want: "2027-01-01T00:30:00Z", kind: Java, layout: "2006-01-02T15:04:05Z07"},
+		{name: "java text fields", format: "EEEE, d MMMM yyyy h:mm a z", t: ref, want: "Monday, 9 March 2026 2:05 PM CET", kind: Java, layout: "Monday, 2 January 2006 3:04 PM MST"},
+		{name: "java quotes", format: "h 'o''clock' a, G", t: ref, want: "2 o'clock PM, AD", kind: Java},
+		{name: "java week, zone id and Z forms", format: "YYYY-'W'ww-u VV ZZZZ", syntax: Java, t: ref, want: "2026-W11-1 Europe/Paris GMT+01:00", kind: Java},
+		{name: "java multibyte literals", format: "y年M月d日 H時mm分", syntax: Java, t: ref, want: "2026年3月9日 14時05分", kind: Java},
+		{name: "strftime multibyte literals", format: "%Y年%m月", t: ref, want: "2026年03月", kind: Strftime, layout: "2006年01月"},
+		{name: "java k and K hours", format: "kk KK", syntax: Java, t: newYear, want: "24 00", kind: Java},
+		// DD is a day of the year in Java, not the moment.js day of the month
+		{name: "java day of year", format: "yyyy-DDD", t: ref, want: "2026-068", kind: Java, layout: "2006-002"},
+		// Quoted text is not read as the Go weekday Mon
+		{name: "java quoted Mon", format: "'Month:' MM", t: ref, want: "Month: 03", kind: Java, layout: "Month: 01"},
+		{name: "moment", format: "YYYY-MM-DDTHH:mm:ssZ", t: ref, want: "2026-03-09T14:05:07+01:00", kind: Moment, layout: "2006-01-02T15:04:05-07:00"},
+		{name: "moment ordinals and brackets", format: "dddd, MMMM Do YYYY [at] h:mm A", t: ref, want: "Monday, March 9th 2026 at 2:05 PM", kind: Moment},
+		{name: "moment ISO week and quarter", format: "GGGG-[W]WW-E Qo DDDD dd", syntax: Moment, t: ref, want: "2026-W11-1 1st 068 Mo", kind: Moment},
+		{name: "moment expanded years", format: "YYYYYY YYYYY", syntax: Moment, t: ref, want: "+002026 02026", kind: Moment},
+		{name: "moment fraction and epochs", format: "ss.SSS X x", syntax: Moment, t: ref, want: "07.123 1773061507 1773061507123", kind: Moment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Compile(tt.format, tt.syntax)
+			if err != nil {
+				t.Fatalf("Compile() error = %v", err)
+			}
+			if got := f.Format(tt.t); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+			if f.Syntax() != tt.kind {
+				t.Errorf("Syntax() = %q, want %q", f.Syntax(), tt.kind)
+			}
+			layout, ok := f.GoLayout()
+			if layout != tt.layout || ok != (tt.layout != "") {
+				t.Errorf("GoLayout() = %q, %v, want %q", layout, ok, tt.layout)
+			}
+			at := tt.t
+			if f.utc {
+				at = at.UTC()
+			}
+			if ok && at.Format(layout) != tt.want {
+				t.Errorf("time.Format(%q) = %q, want %q", layout, at.Format(layout), tt.want)
+			}
+		})
+	}
+}
+
+func TestGoLayoutRejectsLiteralTokens(t *testing.T) {
+	// The literal "Mon" would be read by Go as a weekday
+	f, err := Compile("[Mon] YYYY", Moment)
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if layout, ok := f.GoLayout(); ok {
+		t.Errorf("GoLayout() = %q, want no equivalent", layout)
+	}
+	if got := f.Format(time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)); got != "Mon 2026" {
+		t.Errorf("Format() = %q, want %q", got, "Mon 2026")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    Syntax
+	}{
+		{"%Y-%m-%d", Strftime},
+		{"2006-01-02", Go},
+		{"Jan 2, 3:04PM", Go},
+		{"YYYY-MM-DD", Moment},
+		{"[Today is] dddd", Moment},
+		{"dd/MM/yyyy", Java},
+		{"yyyy-MM-dd HH:mm:ss", Java},
+		{"yyyy-DDD", Java},
+		{"'Month:' MM", Java},
+		{"DD/MM/YYYY", Moment},
+		{"[Mon] YYYY", Moment},
+		{"Jan '06", Go},
+		{"HH:mm", Java},
+		{"h 'o''clock' a", Java},
+		{"DD.MM.YY", Moment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, err := Detect(tt.pattern)
+			if err != nil {
+				t.Fatalf("Detect(%q) error = %v", tt.pattern, err)
+			}
+			if got != tt.want {
+				t.Errorf("Detect(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		syntax  Syntax
+		wantErr string
+	}{
+		{name: "empty", format: "", wantErr: "format is empty"},
+		{name: "unknown name", format: "rfc9999", syntax: Named, wantErr: "unknown format name"},
+		{name: "unknown syntax", format: "yyyy", syntax: "python", wantErr: "unknown pattern syntax"},
+		{name: "strftime conversion", format: "%Q", wantErr: "unsupported conversion %Q"},
+		{name: "strftime trailing percent", format: "%Y %", wantErr: "ends inside a conversion"},
+		{name: "java letter", format: "yyyy-MM-dd W", syntax: Java, wantErr: `unsupported pattern letter "W"`},
+		{name: "java quote", format: "yyyy 'at", syntax: Java, wantErr: "unterminated quote"},
+		{name: "moment locale week", format: "YYYY-ww", syntax: Moment, wantErr: "locale week numbers"},
+		{name: "moment bracket", format: "[at YYYY", syntax: Moment, wantErr: "unterminated '['"},
+		{name: "moment letter", format: "yyyy-MM", syntax: Moment, wantErr: `unsupported token "yyyy"`},
+		{name: "auto unread letters", format: "Mon dd", wantErr: `unsupported layout letters "dd"`},
+		{name: "auto plain text", format: "Week 02", wantErr: "set syntax to strftime, go, java or moment"},
+		{name: "auto unmarked letters", format: "kk KK", wantErr: "cannot tell the syntax"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.format, tt.syntax)
+			if err == nil {
+				t.Fatal("Compile() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Compile() error = %q, want it to contain %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNamedFormats(t *testing.T) {
+	for _, name := range NamedFormats() {
+		f, err := Compile(name, Named)
+		if err != nil {
+			t.Errorf("Compile(%q) error = %v", name, err)
+			continue
+		}
+		if f.Format(time.Now()) == "" {
+			t.Errorf("Compile(%q) formats to an empty string", name)
+		}
+	}
+}
+
+func TestFormatNames(t *testing.T) {
+	names := &Names{
+		Months:    [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		ShortDays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		PM:        "après-midi",
+		ZoneName:  func(time.Time) string { return "heure normale d’Europe centrale" },
+	}
+	f, err := Compile("EEE d MMMM y, h a, EEEE, zzzz", Java)
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	at := time.Date(2026, time.February, 9, 14, 5, 0, 0, mustLoadLocation(t, "Europe/Paris"))
+	want := "lun. 9 février 2026, 2 après-midi, Monday, heure normale d’Europe centrale"
+	if got := f.FormatNames(at, names); got != want {
+		t.Errorf("FormatNames() = %q, want %q", got, want)
+	}
+	if got, want := f.Format(at), "Mon 9 February 2026, 2 PM, Monday, Europe/Paris"; got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
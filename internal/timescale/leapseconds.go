@@ -0,0 +1,157 @@
+package timescale
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The leap second list is embedded so that conversions work offline. It can be
+// replaced at startup with a newer copy of the file.
+//
+//go:embed data/leap-seconds.list
+var leapSecondsList string
+
+// ntpEpoch is the origin of the NTP timestamps in leap-seconds.list.
+var ntpEpoch = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// LeapSecond is a change of the TAI−UTC offset.
+type LeapSecond struct {
+	// At is the UTC instant from which Offset applies.
+	At time.Time
+	// Offset is TAI−UTC in seconds.
+	Offset int
+}
+
+// Table is a leap second table, read from a leap-seconds.list file.
+type Table struct {
+	LeapSeconds []LeapSecond
+	// Expires is when the IERS may next insert a leap second. Conversions after it
+	// assume none was inserted.
+	Expires time.Time
+}
+
+var (
+	defaultMu    sync.RWMutex
+	defaultTable *Table
+)
+
+// Default returns the table in use: the embedded one unless SetDefault replaced it.
+func Default() *Table {
+	defaultMu.RLock()
+	t := defaultTable
+	defaultMu.RUnlock()
+	if t != nil {
+		return t
+	}
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if defaultTable == nil {
+		var err error
+		if defaultTable, err = ParseTable(strings.NewReader(leapSecondsList)); err != nil {
+			panic("timescale: invalid embedded leap second list: " + err.Error())
+		}
+	}
+	return defaultTable
+}
+
+// SetDefault replaces the table returned by Default.
+func SetDefault(t *Table) {
+	defaultMu.Lock()
+	defaultTable = t
+	defaultMu.Unlock()
+}
+
+// LoadFile reads a leap-seconds.list file, as published by the IERS and IETF and
+// shipped with the tz database.
+func LoadFile(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	t, err := ParseTable(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return t, nil
+}
+
+// ParseTable reads the leap-seconds.list format: data lines holding an NTP timestamp
+// and the TAI−UTC offset from then on, and a "#@" line holding the expiry timestamp.
+// Other comment lines are ignored.
+func ParseTable(r io.Reader) (*Table, error) {
+	t := &Table{}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if rest, ok := strings.CutPrefix(line, "#@"); ok {
+			at, err := ntpTime(strings.TrimSpace(rest))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid expiry: %w", n, err)
+			}
+			t.Expires = at
+			continue
+		}
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected an NTP timestamp and a TAI-UTC offset", n)
+		}
+		at, err := ntpTime(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		offset, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid offset %q", n, fields[1])
+		}
+		t.LeapSeconds = append(t.LeapSeconds, LeapSecond{At: at, Offset: offset})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(t.LeapSeconds) == 0 {
+		return nil, fmt.Errorf("no leap seconds found")
+	}
+	sort.Slice(t.LeapSeconds, func(i, j int) bool { return t.LeapSeconds[i].At.Before(t.LeapSeconds[j].At) })
+	return t, nil
+}
+
+func ntpTime(s string) (time.Time, error) {
+	seconds, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid NTP timestamp %q", s)
+	}
+	return ntpEpoch.Add(time.Duration(seconds) * time.Second), nil
+}
+
+// index returns the index of the last leap second at or before utc, or -1.
+func (t *Table) index(utc time.Time) int {
+	return sort.Search(len(t.LeapSeconds), func(i int) bool { return t.LeapSeconds[i].At.After(utc) }) - 1
+}
+
+// Offset returns TAI−UTC in seconds at a UTC instant from 1972 on.
+func (t *Table) Offset(utc time.Time) (int, error) {
+	i := t.index(utc)
+	if i < 0 {
+		return 0, fmt.Errorf("%s UTC is before UTC was tied to TAI by leap seconds in 1972", FormatLabel(utc.UTC(), false))
+	}
+	return t.LeapSeconds[i].Offset, nil
+}
+
+// Expired reports whether utc is after the table's expiry, so that a leap second the
+// table does not list may have occurred before it.
+func (t *Table) Expired(utc time.Time) bool {
+	return !t.Expires.IsZero() && utc.After(t.Expires)
+}
@@ -0,0 +1,142 @@
+// Package timescale converts instants between UTC and the uniform time scales TAI, GPS,
+// TT and Loran-C, which count leap seconds that Go's time package ignores. Times on those
+// scales are held as labels: time.Time values in UTC whose fields are the scale's reading.
+package timescale
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Scale is a time scale.
+type Scale string
+
+// Supported time scales.
+const (
+	UTC   Scale = "UTC"
+	TAI   Scale = "TAI"
+	GPS   Scale = "GPS"
+	TT    Scale = "TT"
+	LORAN Scale = "LORAN"
+)
+
+// Scales lists the supported scales.
+var Scales = []Scale{UTC, TAI, GPS, TT, LORAN}
+
+// GPSEpoch is the start of GPS week 0, when GPS time matched UTC.
+var GPSEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// secondsPerWeek is the length of a GPS week.
+const secondsPerWeek = 7 * 24 * 3600
+
+// MaxGPSWeek is the last GPS week, in 2272, that a time.Duration counts from GPSEpoch.
+const MaxGPSWeek = int(math.MaxInt64/(secondsPerWeek*time.Second)) - 1
+
+// fromTAI is the fixed offset of each uniform scale from TAI.
+var fromTAI = map[Scale]time.Duration{
+	TAI:   0,
+	GPS:   -19 * time.Second,
+	TT:    32184 * time.Millisecond,
+	LORAN: -10 * time.Second,
+}
+
+// ParseScale parses a scale name, ignoring case. An empty name is UTC.
+func ParseScale(s string) (Scale, error) {
+	scale := Scale(strings.ToUpper(strings.TrimSpace(s)))
+	if _, ok := fromTAI[scale]; ok || scale == UTC {
+		return scale, nil
+	}
+	if scale == "" {
+		return UTC, nil
+	}
+	return "", fmt.Errorf("unknown time scale %q: expected UTC, TAI, GPS, TT or LORAN", s)
+}
+
+// ToTAI converts a label on scale s into its TAI label. leap marks a UTC label in a
+// positive leap second, given as 23:59:59 and read as 23:59:60.
+func (t *Table) ToTAI(label time.Time, leap bool, s Scale) (time.Time, error) {
+	label = label.UTC()
+	if s != UTC {
+		if leap {
+			return time.Time{}, fmt.Errorf("only UTC has leap seconds")
+		}
+		return label.Add(-fromTAI[s]), nil
+	}
+	if leap {
+		next := label.Truncate(time.Second).Add(time.Second)
+		i := t.index(next)
+		if i <= 0 || !t.LeapSeconds[i].At.Equal(next) || t.LeapSeconds[i].Offset <= t.LeapSeconds[i-1].Offset {
+			return time.Time{}, fmt.Errorf("%s is not a leap second", FormatLabel(label, true))
+		}
+		return label.Add(time.Duration(t.LeapSeconds[i].Offset) * time.Second), nil
+	}
+	offset, err := t.Offset(label)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return label.Add(time.Duration(offset) * time.Second), nil
+}
+
+// FromTAI converts a TAI label into scale s. leap reports a UTC reading inside a leap
+// second, returned as 23:59:59 for FormatLabel to show as 23:59:60.
+func (t *Table) FromTAI(tai time.Time, s Scale) (label time.Time, leap bool, err error) {
+	if s != UTC {
+		return tai.Add(fromTAI[s]), false, nil
+	}
+	for i := len(t.LeapSeconds) - 1; i >= 0; i-- {
+		ls := t.LeapSeconds[i]
+		if !tai.Before(ls.At.Add(time.Duration(ls.Offset) * time.Second)) {
+			return tai.Add(-time.Duration(ls.Offset) * time.Second), false, nil
+		}
+		// Between the previous offset and this one, UTC is in the inserted second
+		if i > 0 && !tai.Before(ls.At.Add(time.Duration(t.LeapSeconds[i-1].Offset)*time.Second)) {
+			return tai.Add(-time.Duration(ls.Offset) * time.Second), true, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("%s TAI is before UTC was tied to TAI by leap seconds in 1972", FormatLabel(tai, false))
+}
+
+// GPSWeek splits a GPS label into its week number since GPSEpoch and seconds of week.
+func GPSWeek(gps time.Time) (week int, seconds float64) {
+	elapsed := gps.Sub(GPSEpoch)
+	week = int(elapsed / (secondsPerWeek * time.Second))
+	if elapsed < 0 && elapsed%(secondsPerWeek*time.Second) != 0 {
+		week--
+	}
+	tow := elapsed - time.Duration(week)*secondsPerWeek*time.Second
+	return week, tow.Seconds()
+}
+
+// FromGPSWeek returns the GPS label of a week number and seconds of week. The week must
+// be between 0 and MaxGPSWeek and the seconds between 0 and a week.
+func FromGPSWeek(week int, seconds float64) time.Time {
+	return GPSEpoch.Add(time.Duration(week)*secondsPerWeek*time.Second + time.Duration(seconds*float64(time.Second)))
+}
+
+// ParseLabel parses an ISO 8601 reading such as "2016-12-31T23:59:60.5". A seconds
+// field of 60 is returned as 59 with leap set.
+func ParseLabel(s string) (time.Time, bool, error) {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "Z")
+	leap := false
+	if len(s) >= 19 && s[16:19] == ":60" {
+		s, leap = s[:17]+"59"+s[19:], true
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02 15:04:05.999999999", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, leap, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("invalid time scale reading %q: expected YYYY-MM-DDTHH:MM:SS[.fff] without offset", s)
+}
+
+// FormatLabel formats a reading as ISO 8601 without offset, keeping fractional seconds.
+// A leap reading shows its seconds as 60.
+func FormatLabel(label time.Time, leap bool) string {
+	s := label.Format("2006-01-02T15:04:05.999999999")
+	if leap {
+		s = s[:17] + "60" + s[19:]
+	}
+	return s
+}
@@ -0,0 +1,123 @@
+package timescale
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConversions(t *testing.T) {
+	table := Default()
+	tests := []struct {
+		name  string
+		utc   string
+		scale Scale
+		want  string
+	}{
+		{"TAI 2024", "2024-06-10T16:00:00", TAI, "2024-06-10T16:00:37"},
+		{"GPS 2024", "2024-06-10T16:00:00", GPS, "2024-06-10T16:00:18"},
+		{"TT 2024", "2024-06-10T16:00:00.5", TT, "2024-06-10T16:01:09.684"},
+		{"LORAN 2024", "2024-06-10T16:00:00", LORAN, "2024-06-10T16:00:27"},
+		{"GPS epoch", "1980-01-06T00:00:00", GPS, "1980-01-06T00:00:00"},
+		{"TAI 1972", "1972-01-01T00:00:00", TAI, "1972-01-01T00:00:10"},
+		{"before 2017 leap", "2016-12-31T23:59:59", TAI, "2017-01-01T00:00:35"},
+		{"leap second", "2016-12-31T23:59:60.25", TAI, "2017-01-01T00:00:36.25"},
+		{"after 2017 leap", "2017-01-01T00:00:00", TAI, "2017-01-01T00:00:37"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, leap, err := ParseLabel(tt.utc)
+			if err != nil {
+				t.Fatalf("ParseLabel returned error: %v", err)
+			}
+			tai, err := table.ToTAI(label, leap, UTC)
+			if err != nil {
+				t.Fatalf("ToTAI returned error: %v", err)
+			}
+			got, _, err := table.FromTAI(tai, tt.scale)
+			if err != nil {
+				t.Fatalf("FromTAI returned error: %v", err)
+			}
+			if s := FormatLabel(got, false); s != tt.want {
+				t.Errorf("%s UTC in %s = %s, want %s", tt.utc, tt.scale, s, tt.want)
+			}
+
+			// And back to UTC, keeping the leap second
+			back, backLeap, err := table.FromTAI(tai, UTC)
+			if err != nil {
+				t.Fatalf("FromTAI returned error: %v", err)
+			}
+			if s := FormatLabel(back, backLeap); s != tt.utc {
+				t.Errorf("round trip of %s = %s", tt.utc, s)
+			}
+		})
+	}
+}
+
+func TestConversionErrors(t *testing.T) {
+	table := Default()
+	if _, err := table.ToTAI(time.Date(2016, time.June, 30, 23, 59, 59, 0, time.UTC), true, UTC); err == nil || !strings.Contains(err.Error(), "not a leap second") {
+		t.Errorf("expected a not-a-leap-second error, got %v", err)
+	}
+	if _, err := table.ToTAI(time.Date(1971, time.December, 31, 0, 0, 0, 0, time.UTC), false, UTC); err == nil {
+		t.Error("expected an error before 1972")
+	}
+	if _, _, err := table.FromTAI(time.Date(1972, time.January, 1, 0, 0, 9, 0, time.UTC), UTC); err == nil {
+		t.Error("expected an error before 1972")
+	}
+	if _, err := ParseScale("GLONASS"); err == nil {
+		t.Error("expected an error for an unknown scale")
+	}
+	if _, _, err := ParseLabel("June 10"); err == nil {
+		t.Error("expected an error for an invalid reading")
+	}
+}
+
+func TestGPSWeek(t *testing.T) {
+	gps := time.Date(2024, time.June, 10, 16, 0, 18, 500000000, time.UTC)
+	week, tow := GPSWeek(gps)
+	if week != 2318 || tow != 144018.5 {
+		t.Errorf("GPSWeek = %d, %v; want 2318, 144018.5", week, tow)
+	}
+	if back := FromGPSWeek(week, tow); !back.Equal(gps) {
+		t.Errorf("FromGPSWeek = %s, want %s", back, gps)
+	}
+	if week, tow := GPSWeek(GPSEpoch.Add(-time.Second)); week != -1 || tow != secondsPerWeek-1 {
+		t.Errorf("GPSWeek before the epoch = %d, %v", week, tow)
+	}
+}
+
+func TestParseTable(t *testing.T) {
+	table, err := ParseTable(strings.NewReader("#@\t3991593600\n2272060800\t10\t# 1 Jan 1972\n3692217600 37\n"))
+	if err != nil {
+		t.Fatalf("ParseTable returned error: %v", err)
+	}
+	if len(table.LeapSeconds) != 2 || table.LeapSeconds[1].Offset != 37 {
+		t.Errorf("unexpected table: %+v", table)
+	}
+	if want := time.Date(2026, time.June, 28, 0, 0, 0, 0, time.UTC); !table.Expires.Equal(want) {
+		t.Errorf("expires = %s, want %s", table.Expires, want)
+	}
+	if !table.Expired(time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected the table to be expired after its expiry")
+	}
+
+	for _, text := range []string{"", "# only comments\n", "2272060800\n", "x 10\n", "2272060800 ten\n"} {
+		if _, err := ParseTable(strings.NewReader(text)); err == nil {
+			t.Errorf("ParseTable(%q) expected error", text)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	table := Default()
+	if n := len(table.LeapSeconds); n != 28 || table.LeapSeconds[n-1].Offset != 37 {
+		t.Errorf("embedded table has %d leap seconds", n)
+	}
+	replacement := &Table{LeapSeconds: []LeapSecond{{At: GPSEpoch, Offset: 19}}}
+	SetDefault(replacement)
+	defer SetDefault(table)
+	if Default() != replacement {
+		t.Error("SetDefault did not replace the table")
+	}
+}
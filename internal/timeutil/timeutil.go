@@ -3,6 +3,7 @@ package timeutil
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,6 +27,49 @@ func FormatTimeDifference(offsetSource, offsetTarget int) string {
 	return s + "h"
 }
 
+// FormatUTCOffset formats an offset in seconds east of UTC as "+05:30" or "-08:00"
+func FormatUTCOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+// ParseUTCOffset parses offsets like "+05:30", "-0800", "+2", "UTC+2" or "GMT-3:30"
+// into seconds east of UTC. A bare "UTC", "GMT" or "Z" is a zero offset.
+func ParseUTCOffset(s string) (int, error) {
+	str := strings.ToUpper(strings.TrimSpace(s))
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "UTC"), "GMT")
+	if str == "" || str == "Z" {
+		return 0, nil
+	}
+
+	sign := 1
+	switch str[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, fmt.Errorf("invalid UTC offset %q. Expected a format like +05:30 or -8", s)
+	}
+	str = str[1:]
+
+	hoursStr, minutesStr := str, "0"
+	if h, m, ok := strings.Cut(str, ":"); ok {
+		hoursStr, minutesStr = h, m
+	} else if len(str) == 4 {
+		hoursStr, minutesStr = str[:2], str[2:]
+	}
+	hours, herr := strconv.Atoi(hoursStr)
+	minutes, merr := strconv.Atoi(minutesStr)
+	if herr != nil || merr != nil || hours > 14 || minutes < 0 || minutes > 59 || hours < 0 {
+		return 0, fmt.Errorf("invalid UTC offset %q. Expected a format like +05:30 or -8", s)
+	}
+	return sign * (hours*3600 + minutes*60), nil
+}
+
 // BuildTimeResult creates a TimeResult from a time.Time
 func BuildTimeResult(t time.Time, tz string) types.TimeResult {
 	return types.TimeResult{
@@ -45,6 +89,27 @@ func ParseTimeInput(timeStr string) (hour, minute int, err error) {
 	return parsed.Hour(), parsed.Minute(), nil
 }
 
+// ParseDateInput parses YYYY-MM-DD format date string
+func ParseDateInput(dateStr string) (year int, month time.Month, day int, err error) {
+	parsed, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid date format. Expected YYYY-MM-DD")
+	}
+	return parsed.Year(), parsed.Month(), parsed.Day(), nil
+}
+
+// ParseWeekday parses an English weekday name or its three-letter abbreviation
+func ParseWeekday(s string) (time.Weekday, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q. Expected a name like Monday or Mon", s)
+}
+
 // ValidateConvertTimeInput validates the ConvertTimeInput fields
 func ValidateConvertTimeInput(input types.ConvertTimeInput) error {
 	if input.SourceTimezone == "" {
@@ -58,3 +123,25 @@ func ValidateConvertTimeInput(input types.ConvertTimeInput) error {
 	}
 	return nil
 }
+
+// ValidateAddDurationInput validates the AddDurationInput fields
+func ValidateAddDurationInput(input types.AddDurationInput) error {
+	if input.Duration == "" {
+		return fmt.Errorf("duration is required")
+	}
+	return nil
+}
+
+// ValidateConvertTimeMultiInput validates the ConvertTimeMultiInput fields
+func ValidateConvertTimeMultiInput(input types.ConvertTimeMultiInput) error {
+	if input.SourceTimezone == "" {
+		return fmt.Errorf("source_timezone is required")
+	}
+	if len(input.TargetTimezones) == 0 {
+		return fmt.Errorf("target_timezones is required")
+	}
+	if input.Time == "" {
+		return fmt.Errorf("time is required")
+	}
+	return nil
+}
@@ -70,6 +70,61 @@ func TestFormatTimeDifference(t *testing.T) {
 	}
 }
 
+func TestFormatUTCOffset(t *testing.T) {
+	tests := []struct {
+		offset int
+		want   string
+	}{
+		{0, "+00:00"},
+		{19800, "+05:30"},
+		{-28800, "-08:00"},
+		{-9000, "-02:30"},
+		{45900, "+12:45"},
+	}
+	for _, tt := range tests {
+		if got := FormatUTCOffset(tt.offset); got != tt.want {
+			t.Errorf("FormatUTCOffset(%d) = %s, want %s", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestParseUTCOffset(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      int
+		wantError bool
+	}{
+		{"+05:30", 19800, false},
+		{"-0800", -28800, false},
+		{"+2", 7200, false},
+		{"UTC+2", 7200, false},
+		{"gmt-3:30", -12600, false},
+		{"UTC", 0, false},
+		{"Z", 0, false},
+		{"5", 0, true},
+		{"+25", 0, true},
+		{"+05:75", 0, true},
+		{"+ab", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseUTCOffset(tt.input)
+			if tt.wantError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseUTCOffset(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestBuildTimeResult(t *testing.T) {
 	loc, err := time.LoadLocation("America/New_York")
 	if err != nil {
@@ -135,6 +190,73 @@ func TestParseTimeInput(t *testing.T) {
 	}
 }
 
+func TestParseDateInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantYear  int
+		wantMonth time.Month
+		wantDay   int
+		wantError bool
+	}{
+		{"valid date", "2026-03-29", 2026, time.March, 29, false},
+		{"leap day", "2028-02-29", 2028, time.February, 29, false},
+		{"invalid leap day", "2027-02-29", 0, 0, 0, true},
+		{"wrong order", "29-03-2026", 0, 0, 0, true},
+		{"empty string", "", 0, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, month, day, err := ParseDateInput(tt.input)
+			if tt.wantError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if year != tt.wantYear || month != tt.wantMonth || day != tt.wantDay {
+				t.Errorf("got %d-%d-%d, want %d-%d-%d", year, month, day, tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      time.Weekday
+		wantError bool
+	}{
+		{"Monday", time.Monday, false},
+		{"sat", time.Saturday, false},
+		{" SUNDAY ", time.Sunday, false},
+		{"Mo", 0, true},
+		{"Funday", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseWeekday(tt.input)
+			if tt.wantError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseWeekday(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestValidateConvertTimeInput(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -215,3 +337,31 @@ func BenchmarkBuildTimeResult(b *testing.B) {
 		BuildTimeResult(t, "UTC")
 	}
 }
+
+func TestValidateConvertTimeMultiInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  types.ConvertTimeMultiInput
+		errMsg string
+	}{
+		{"valid input", types.ConvertTimeMultiInput{SourceTimezone: "UTC", Time: "12:00", TargetTimezones: []string{"Asia/Tokyo"}}, ""},
+		{"missing source timezone", types.ConvertTimeMultiInput{Time: "12:00", TargetTimezones: []string{"Asia/Tokyo"}}, "source_timezone is required"},
+		{"missing target timezones", types.ConvertTimeMultiInput{SourceTimezone: "UTC", Time: "12:00"}, "target_timezones is required"},
+		{"missing time", types.ConvertTimeMultiInput{SourceTimezone: "UTC", TargetTimezones: []string{"Asia/Tokyo"}}, "time is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConvertTimeMultiInput(tt.input)
+			if tt.errMsg == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error = %v, want to contain %v", err, tt.errMsg)
+			}
+		})
+	}
+}
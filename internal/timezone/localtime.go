@@ -0,0 +1,162 @@
+package timezone
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Disambiguation policies for wall-clock times that fall in a DST gap or overlap.
+const (
+	// DisambiguateCompatible picks the earlier instant of an overlap and the later instant
+	// of a gap, matching Python's fold=0 behaviour. It is the default policy.
+	DisambiguateCompatible = "compatible"
+	// DisambiguateEarlier picks the earlier of the two candidate instants.
+	DisambiguateEarlier = "earlier"
+	// DisambiguateLater picks the later of the two candidate instants.
+	DisambiguateLater = "later"
+	// DisambiguateReject returns an error for nonexistent and ambiguous times.
+	DisambiguateReject = "reject"
+	// DisambiguateShiftForward moves a nonexistent time to the first valid instant after
+	// the gap (the transition itself). Ambiguous times resolve to the earlier instant.
+	DisambiguateShiftForward = "shift_forward"
+)
+
+// DisambiguationPolicies lists the accepted disambiguation policy names.
+var DisambiguationPolicies = []string{
+	DisambiguateCompatible,
+	DisambiguateEarlier,
+	DisambiguateLater,
+	DisambiguateReject,
+	DisambiguateShiftForward,
+}
+
+// LocalTime describes how a wall-clock time maps onto instants in a location.
+type LocalTime struct {
+	// Time is the instant chosen by the disambiguation policy.
+	Time time.Time
+	// Nonexistent is set when the wall-clock time is skipped by a transition (spring-forward gap).
+	Nonexistent bool
+	// Ambiguous is set when the wall-clock time occurs twice (fall-back overlap).
+	Ambiguous bool
+	// Candidates holds both instants of an ambiguous time, earliest first.
+	Candidates []time.Time
+}
+
+// ResolveLocalTime maps a wall-clock time in loc onto an instant, detecting DST gaps and
+// overlaps instead of letting time.Date normalize them silently. An empty policy is
+// treated as DisambiguateCompatible.
+func ResolveLocalTime(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location, policy string) (LocalTime, error) {
+	if policy == "" {
+		policy = DisambiguateCompatible
+	}
+	if !slices.Contains(DisambiguationPolicies, policy) {
+		return LocalTime{}, fmt.Errorf("unknown disambiguation policy %q", policy)
+	}
+
+	// The wall-clock fields read as UTC; subtracting an offset yields a candidate instant
+	wall := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	if wall.Year() != year || wall.Month() != month || wall.Day() != day ||
+		wall.Hour() != hour || wall.Minute() != min || wall.Second() != sec {
+		return LocalTime{}, fmt.Errorf("invalid date or time %04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, min, sec)
+	}
+
+	candidates, offsets := wallClockCandidates(wall, loc)
+	switch len(candidates) {
+	case 1:
+		return LocalTime{Time: candidates[0]}, nil
+	case 0:
+		return resolveGap(wall, loc, offsets, policy)
+	default:
+		res := LocalTime{Ambiguous: true, Candidates: candidates}
+		switch policy {
+		case DisambiguateReject:
+			return LocalTime{}, fmt.Errorf("local time %s is ambiguous in %s (occurs at %s and %s)",
+				wall.Format("2006-01-02 15:04:05"), loc, FormatISOSeconds(candidates[0]), FormatISOSeconds(candidates[1]))
+		case DisambiguateLater:
+			res.Time = candidates[len(candidates)-1]
+		default:
+			res.Time = candidates[0]
+		}
+		return res, nil
+	}
+}
+
+// ParseInLocation parses tstr with ParseDateTime and resolves it in loc. Inputs carrying
+// an explicit offset denote a single instant; naive inputs are read as wall-clock times
+// in loc and disambiguated with policy.
+func ParseInLocation(tstr string, loc *time.Location, policy string) (LocalTime, error) {
+	parsed, hasOffset, err := ParseDateTime(tstr)
+	if err != nil {
+		return LocalTime{}, err
+	}
+	if hasOffset {
+		// An explicit offset pins the instant, so there is nothing to disambiguate
+		return LocalTime{Time: parsed.In(loc)}, nil
+	}
+	return ResolveLocalTime(parsed.Year(), parsed.Month(), parsed.Day(),
+		parsed.Hour(), parsed.Minute(), parsed.Second(), parsed.Nanosecond(), loc, policy)
+}
+
+// wallClockCandidates returns the sorted instants whose local time in loc equals wall,
+// together with the distinct offsets in force around it.
+func wallClockCandidates(wall time.Time, loc *time.Location) ([]time.Time, []int) {
+	var offsets []int
+	// Offsets a day either side cover any single transition near the wall-clock time
+	for _, probe := range []time.Duration{-26 * time.Hour, 0, 26 * time.Hour} {
+		_, off := wall.Add(probe).In(loc).Zone()
+		if !slices.Contains(offsets, off) {
+			offsets = append(offsets, off)
+		}
+	}
+
+	var candidates []time.Time
+	for _, off := range offsets {
+		instant := wall.Add(-time.Duration(off) * time.Second).In(loc)
+		if sameWallClock(instant, wall) && !slices.ContainsFunc(candidates, instant.Equal) {
+			candidates = append(candidates, instant)
+		}
+	}
+	slices.SortFunc(candidates, time.Time.Compare)
+	return candidates, offsets
+}
+
+// resolveGap applies policy to a wall-clock time that falls in a transition gap.
+func resolveGap(wall time.Time, loc *time.Location, offsets []int, policy string) (LocalTime, error) {
+	if policy == DisambiguateReject {
+		return LocalTime{}, fmt.Errorf("local time %s does not exist in %s (skipped by a DST transition)",
+			wall.Format("2006-01-02 15:04:05"), loc)
+	}
+
+	// Interpreting the wall clock with each surrounding offset gives the two possible readings
+	var earliest, latest time.Time
+	for i, off := range offsets {
+		instant := wall.Add(-time.Duration(off) * time.Second).In(loc)
+		if i == 0 || instant.Before(earliest) {
+			earliest = instant
+		}
+		if i == 0 || instant.After(latest) {
+			latest = instant
+		}
+	}
+
+	res := LocalTime{Nonexistent: true}
+	switch policy {
+	case DisambiguateEarlier:
+		res.Time = earliest
+	case DisambiguateShiftForward:
+		// The later reading lies after the transition, so its zone starts at the gap's end
+		start, _ := latest.ZoneBounds()
+		res.Time = start.In(loc)
+	default:
+		res.Time = latest
+	}
+	return res, nil
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	return y == wall.Year() && mo == wall.Month() && d == wall.Day() &&
+		h == wall.Hour() && mi == wall.Minute() && s == wall.Second()
+}
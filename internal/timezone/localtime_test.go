@@ -0,0 +1,139 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveLocalTime(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name            string
+		day, hour, min  int
+		month           time.Month
+		policy          string
+		want            string
+		wantNonexistent bool
+		wantAmbiguous   bool
+	}{
+		{"normal time", 15, 12, 0, time.July, "", "2025-07-15T12:00:00-04:00", false, false},
+		{"gap compatible", 9, 2, 30, time.March, "", "2025-03-09T03:30:00-04:00", true, false},
+		{"gap earlier", 9, 2, 30, time.March, DisambiguateEarlier, "2025-03-09T01:30:00-05:00", true, false},
+		{"gap later", 9, 2, 30, time.March, DisambiguateLater, "2025-03-09T03:30:00-04:00", true, false},
+		{"gap shift forward", 9, 2, 30, time.March, DisambiguateShiftForward, "2025-03-09T03:00:00-04:00", true, false},
+		{"overlap compatible", 2, 1, 30, time.November, "", "2025-11-02T01:30:00-04:00", false, true},
+		{"overlap earlier", 2, 1, 30, time.November, DisambiguateEarlier, "2025-11-02T01:30:00-04:00", false, true},
+		{"overlap later", 2, 1, 30, time.November, DisambiguateLater, "2025-11-02T01:30:00-05:00", false, true},
+		{"overlap shift forward", 2, 1, 30, time.November, DisambiguateShiftForward, "2025-11-02T01:30:00-04:00", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ResolveLocalTime(2025, tt.month, tt.day, tt.hour, tt.min, 0, 0, ny, tt.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := FormatISOSeconds(res.Time); got != tt.want {
+				t.Errorf("time = %s, want %s", got, tt.want)
+			}
+			if res.Nonexistent != tt.wantNonexistent {
+				t.Errorf("nonexistent = %v, want %v", res.Nonexistent, tt.wantNonexistent)
+			}
+			if res.Ambiguous != tt.wantAmbiguous {
+				t.Errorf("ambiguous = %v, want %v", res.Ambiguous, tt.wantAmbiguous)
+			}
+			if tt.wantAmbiguous && len(res.Candidates) != 2 {
+				t.Errorf("expected 2 candidates, got %d", len(res.Candidates))
+			}
+		})
+	}
+}
+
+func TestResolveLocalTimeAmbiguousCandidates(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	res, err := ResolveLocalTime(2025, time.October, 26, 1, 30, 0, 0, london, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.Candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %d", len(res.Candidates))
+	}
+	if got := FormatISOSeconds(res.Candidates[0]); got != "2025-10-26T01:30:00+01:00" {
+		t.Errorf("first candidate = %s", got)
+	}
+	if got := FormatISOSeconds(res.Candidates[1]); got != "2025-10-26T01:30:00+00:00" {
+		t.Errorf("second candidate = %s", got)
+	}
+}
+
+func TestResolveLocalTimeReject(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	if _, err := ResolveLocalTime(2025, time.March, 9, 2, 30, 0, 0, ny, DisambiguateReject); err == nil {
+		t.Error("expected error for nonexistent time")
+	}
+	if _, err := ResolveLocalTime(2025, time.November, 2, 1, 30, 0, 0, ny, DisambiguateReject); err == nil {
+		t.Error("expected error for ambiguous time")
+	}
+	if _, err := ResolveLocalTime(2025, time.July, 1, 12, 0, 0, 0, ny, DisambiguateReject); err != nil {
+		t.Errorf("unexpected error for normal time: %v", err)
+	}
+}
+
+func TestResolveLocalTimeInvalidInput(t *testing.T) {
+	if _, err := ResolveLocalTime(2025, time.July, 1, 12, 0, 0, 0, time.UTC, "sideways"); err == nil {
+		t.Error("expected error for unknown policy")
+	}
+	if _, err := ResolveLocalTime(2025, time.February, 30, 12, 0, 0, 0, time.UTC, ""); err == nil {
+		t.Error("expected error for out of range date")
+	}
+}
+
+func TestResolveLocalTimeSkippedDay(t *testing.T) {
+	// Samoa skipped 2011-12-30 entirely when it moved across the date line
+	apia := mustLoadLocation(t, "Pacific/Apia")
+	res, err := ResolveLocalTime(2011, time.December, 30, 12, 0, 0, 0, apia, DisambiguateShiftForward)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !res.Nonexistent {
+		t.Error("expected 2011-12-30 to be nonexistent in Pacific/Apia")
+	}
+	if got := res.Time.Format("2006-01-02 15:04"); got != "2011-12-31 00:00" {
+		t.Errorf("shift forward = %s, want 2011-12-31 00:00", got)
+	}
+}
+
+func TestParseInLocation(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+
+	tests := []struct {
+		name          string
+		input         string
+		want          string
+		wantAmbiguous bool
+	}{
+		{"naive", "2025-07-01T09:00:00", "2025-07-01T09:00:00+02:00", false},
+		{"with offset", "2025-07-01T09:00:00Z", "2025-07-01T11:00:00+02:00", false},
+		{"ambiguous naive", "2025-10-26 02:30", "2025-10-26T02:30:00+02:00", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ParseInLocation(tt.input, paris, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := FormatISOSeconds(res.Time); got != tt.want {
+				t.Errorf("time = %s, want %s", got, tt.want)
+			}
+			if res.Ambiguous != tt.wantAmbiguous {
+				t.Errorf("ambiguous = %v, want %v", res.Ambiguous, tt.wantAmbiguous)
+			}
+		})
+	}
+
+	if _, err := ParseInLocation("yesterday", paris, ""); err == nil {
+		t.Error("expected error for unparseable input")
+	}
+}
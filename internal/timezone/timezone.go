@@ -15,17 +15,8 @@ func FormatISOSeconds(t time.Time) string {
 
 // IsDST determines if time t is in DST for its location
 func IsDST(t time.Time) bool {
-	// Compare offsets in Jan and Jul to determine the standard offset
-	year := t.Year()
-	loc := t.Location()
-	jan := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
-	jul := time.Date(year, time.July, 1, 0, 0, 0, 0, loc)
-	_, offJan := jan.Zone()
-	_, offJul := jul.Zone()
-	standard := offJan
-	if offJul < standard {
-		standard = offJul
-	}
+	// The standard offset comes from the clock changes around t, see StandardOffsets
+	standard, _, _ := StandardOffsets(t)
 	_, offNow := t.Zone()
 	return offNow != standard
 }
@@ -42,32 +33,57 @@ func GetNowInLocation(tz string) (time.Time, error) {
 	return time.Now().In(loc), nil
 }
 
-// ConvertTimeString converts a time given as a string from a source timezone to a destination timezone.
-// The function prefers RFC3339 input. If that fails, it will try the layout "2006-01-02 15:04:05" and
-// interpret that naive timestamp in the provided fromTZ (or UTC if empty).
-func ConvertTimeString(tstr, fromTZ, toTZ string) (time.Time, error) {
+// naiveLayouts lists the accepted datetime layouts that carry no UTC offset.
+var naiveLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+}
+
+// ParseDateTime parses an RFC3339 datetime or one of the naive layouts "2006-01-02 15:04:05",
+// "2006-01-02T15:04:05" (and their minute-precision variants). hasOffset reports whether the
+// input carried its own UTC offset; naive results are returned as wall-clock fields in UTC.
+func ParseDateTime(tstr string) (t time.Time, hasOffset bool, err error) {
 	if tstr == "" {
-		return time.Time{}, fmt.Errorf("time string is empty")
+		return time.Time{}, false, fmt.Errorf("time string is empty")
 	}
 
 	// First try RFC3339 which includes an offset or Z
-	parsed, err := time.Parse(time.RFC3339, tstr)
+	if parsed, err := time.Parse(time.RFC3339, tstr); err == nil {
+		return parsed, true, nil
+	}
+
+	// Try the common naive layouts
+	var firstErr error
+	for _, layout := range naiveLayouts {
+		parsed, err := time.Parse(layout, tstr)
+		if err == nil {
+			return parsed, false, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("unable to parse time %q: %w", tstr, firstErr)
+}
+
+// ConvertTimeString converts a time given as a string from a source timezone to a destination timezone.
+// The input is parsed with ParseDateTime; naive timestamps are interpreted in the provided fromTZ
+// (or UTC if empty).
+func ConvertTimeString(tstr, fromTZ, toTZ string) (time.Time, error) {
+	parsed, hasOffset, err := ParseDateTime(tstr)
 	if err != nil {
-		// Try a common naive layout
-		parsed, err = time.Parse("2006-01-02 15:04:05", tstr)
+		return time.Time{}, err
+	}
+
+	// Assign location based on fromTZ or default to UTC
+	if !hasOffset && fromTZ != "" {
+		locFrom, err := time.LoadLocation(fromTZ)
 		if err != nil {
-			return time.Time{}, fmt.Errorf("unable to parse time %q: %w", tstr, err)
-		}
-		// Assign location based on fromTZ or default to UTC
-		if fromTZ == "" {
-			parsed = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), parsed.Hour(), parsed.Minute(), parsed.Second(), parsed.Nanosecond(), time.UTC)
-		} else {
-			locFrom, err := time.LoadLocation(fromTZ)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("invalid from timezone %q: %w", fromTZ, err)
-			}
-			parsed = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), parsed.Hour(), parsed.Minute(), parsed.Second(), parsed.Nanosecond(), locFrom)
+			return time.Time{}, fmt.Errorf("invalid from timezone %q: %w", fromTZ, err)
 		}
+		parsed = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), parsed.Hour(), parsed.Minute(), parsed.Second(), parsed.Nanosecond(), locFrom)
 	}
 
 	// Load destination timezone
@@ -227,6 +227,11 @@ func TestIsDSTEdgeCases(t *testing.T) {
 		{"Sydney summer", "Australia/Sydney", time.January, true},
 		{"Sydney winter", "Australia/Sydney", time.July, false},
 		{"Tokyo no DST", "Asia/Tokyo", time.July, false},
+		{"Dublin summer", "Europe/Dublin", time.July, true},
+		{"Dublin winter", "Europe/Dublin", time.January, false},
+		// +01 most of the year, +00 during Ramadan (23 February to 6 April 2025)
+		{"Casablanca Ramadan", "Africa/Casablanca", time.March, false},
+		{"Casablanca summer", "Africa/Casablanca", time.July, true},
 	}
 
 	for _, tt := range tests {
@@ -302,3 +307,24 @@ func BenchmarkConvertTimeString(b *testing.B) {
 		ConvertTimeString(input, "", "Europe/Paris")
 	}
 }
+
+func TestConvertTimeStringNaiveLayouts(t *testing.T) {
+	inputs := []string{
+		"2025-11-09 12:00:00",
+		"2025-11-09T12:00:00",
+		"2025-11-09 12:00",
+		"2025-11-09T12:00",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			out, err := ConvertTimeString(input, "America/New_York", "UTC")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := out.Format(time.RFC3339); got != "2025-11-09T17:00:00Z" {
+				t.Errorf("expected 2025-11-09T17:00:00Z, got %s", got)
+			}
+		})
+	}
+}
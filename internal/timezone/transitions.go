@@ -0,0 +1,102 @@
+package timezone
+
+import "time"
+
+// Transition is a change of UTC offset or abbreviation in a location.
+type Transition struct {
+	// At is the instant of the change, expressed in the location (after the change).
+	At                 time.Time
+	OffsetBefore       int
+	OffsetAfter        int
+	AbbreviationBefore string
+	AbbreviationAfter  string
+}
+
+// NextTransitions returns up to n transitions strictly after t in t's location, earliest first.
+func NextTransitions(t time.Time, n int) []Transition {
+	var transitions []Transition
+	cur := t
+	for len(transitions) < n {
+		_, end := cur.ZoneBounds()
+		if end.IsZero() {
+			break // no further changes, e.g. UTC or a zone that abolished DST
+		}
+		if tr, ok := transitionAt(end, t.Location()); ok {
+			transitions = append(transitions, tr)
+		}
+		cur = end
+	}
+	return transitions
+}
+
+// PreviousTransitions returns up to n transitions at or before t in t's location, latest first.
+func PreviousTransitions(t time.Time, n int) []Transition {
+	var transitions []Transition
+	cur := t
+	for len(transitions) < n {
+		start, _ := cur.ZoneBounds()
+		if start.IsZero() {
+			break // reached the beginning of the zone's history
+		}
+		if tr, ok := transitionAt(start, t.Location()); ok {
+			transitions = append(transitions, tr)
+		}
+		cur = start.Add(-time.Nanosecond)
+	}
+	return transitions
+}
+
+// transitionAt describes the zone change at instant at. ok is false when neither the
+// offset nor the abbreviation actually change there.
+func transitionAt(at time.Time, loc *time.Location) (Transition, bool) {
+	after := at.In(loc)
+	before := at.Add(-time.Nanosecond).In(loc)
+	abbrBefore, offBefore := before.Zone()
+	abbrAfter, offAfter := after.Zone()
+	if offBefore == offAfter && abbrBefore == abbrAfter {
+		return Transition{}, false
+	}
+	return Transition{
+		At:                 after,
+		OffsetBefore:       offBefore,
+		OffsetAfter:        offAfter,
+		AbbreviationBefore: abbrBefore,
+		AbbreviationAfter:  abbrAfter,
+	}, true
+}
+
+// StandardOffsets returns the standard and daylight offsets of t's location around t,
+// read from its clock changes from a year before t to a year after. The location observes
+// DST when its offset changes within the year after t and returns to an offset it had
+// before. The standard offset is then the lowest and the daylight offset the highest, as
+// in CLDR, so zones that tzdata gives a negative DST, such as Europe/Dublin in winter or
+// Africa/Casablanca during Ramadan, still have their higher offset as daylight time.
+// Otherwise, as in zones without clock changes or after a permanent one, both are t's
+// offset and observesDST is false.
+func StandardOffsets(t time.Time) (standard, daylight int, observesDST bool) {
+	_, offset := t.Zone()
+	from, to := t.AddDate(-1, 0, 0), t.AddDate(1, 0, 0)
+	_, last := from.Zone()
+	seen := map[int]bool{last: true}
+	returns, changesAhead := false, false
+	for cur := from; ; {
+		_, end := cur.ZoneBounds()
+		if end.IsZero() || end.After(to) {
+			break
+		}
+		if _, next := end.Zone(); next != last {
+			returns = returns || seen[next]
+			changesAhead = changesAhead || end.After(t)
+			seen[next], last = true, next
+		}
+		cur = end
+	}
+	if !returns || !changesAhead {
+		return offset, offset, false
+	}
+	standard, daylight = offset, offset
+	for off := range seen {
+		standard, daylight = min(standard, off), max(daylight, off)
+	}
+	return standard, daylight, true
+}
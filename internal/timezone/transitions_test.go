@@ -0,0 +1,103 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextTransitions(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	start := time.Date(2025, time.January, 15, 12, 0, 0, 0, paris)
+
+	got := NextTransitions(start, 3)
+	want := []struct {
+		at         string
+		offBefore  int
+		offAfter   int
+		abbrBefore string
+		abbrAfter  string
+	}{
+		{"2025-03-30T03:00:00+02:00", 3600, 7200, "CET", "CEST"},
+		{"2025-10-26T02:00:00+01:00", 7200, 3600, "CEST", "CET"},
+		{"2026-03-29T03:00:00+02:00", 3600, 7200, "CET", "CEST"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d transitions, got %d", len(want), len(got))
+	}
+	for i, w := range want {
+		tr := got[i]
+		if FormatISOSeconds(tr.At) != w.at {
+			t.Errorf("transition %d at %s, want %s", i, FormatISOSeconds(tr.At), w.at)
+		}
+		if tr.OffsetBefore != w.offBefore || tr.OffsetAfter != w.offAfter {
+			t.Errorf("transition %d offsets %d -> %d, want %d -> %d", i, tr.OffsetBefore, tr.OffsetAfter, w.offBefore, w.offAfter)
+		}
+		if tr.AbbreviationBefore != w.abbrBefore || tr.AbbreviationAfter != w.abbrAfter {
+			t.Errorf("transition %d abbreviations %s -> %s, want %s -> %s", i, tr.AbbreviationBefore, tr.AbbreviationAfter, w.abbrBefore, w.abbrAfter)
+		}
+	}
+}
+
+func TestPreviousTransitions(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	start := time.Date(2025, time.July, 1, 12, 0, 0, 0, ny)
+
+	got := PreviousTransitions(start, 2)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 transitions, got %d", len(got))
+	}
+	if s := FormatISOSeconds(got[0].At); s != "2025-03-09T03:00:00-04:00" {
+		t.Errorf("latest previous transition at %s", s)
+	}
+	if s := FormatISOSeconds(got[1].At); s != "2024-11-03T01:00:00-05:00" {
+		t.Errorf("second previous transition at %s", s)
+	}
+}
+
+func TestTransitionsWithoutDST(t *testing.T) {
+	if got := NextTransitions(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 2); len(got) != 0 {
+		t.Errorf("expected no transitions for UTC, got %+v", got)
+	}
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	if got := NextTransitions(time.Date(2025, 1, 1, 0, 0, 0, 0, tokyo), 2); len(got) != 0 {
+		t.Errorf("expected no future transitions for Asia/Tokyo, got %+v", got)
+	}
+	// Japan last changed clocks in 1951
+	prev := PreviousTransitions(time.Date(2025, 1, 1, 0, 0, 0, 0, tokyo), 1)
+	if len(prev) != 1 || prev[0].At.Year() != 1951 {
+		t.Errorf("expected last Tokyo transition in 1951, got %+v", prev)
+	}
+}
+
+func TestStandardOffsets(t *testing.T) {
+	tests := []struct {
+		tz           string
+		wantStandard int
+		wantDaylight int
+		wantObserves bool
+	}{
+		{"Europe/Paris", 3600, 7200, true},
+		{"Australia/Sydney", 36000, 39600, true},
+		{"Asia/Kolkata", 19800, 19800, false},
+		// tzdata has negative DST in both: GMT in the Irish winter, +00 during Ramadan
+		{"Europe/Dublin", 0, 3600, true},
+		{"Africa/Casablanca", 0, 3600, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tz, func(t *testing.T) {
+			loc := mustLoadLocation(t, tt.tz)
+			std, dst, observes := StandardOffsets(time.Date(2025, 5, 1, 0, 0, 0, 0, loc))
+			if std != tt.wantStandard || dst != tt.wantDaylight || observes != tt.wantObserves {
+				t.Errorf("StandardOffsets = %d, %d, %v, want %d, %d, %v", std, dst, observes, tt.wantStandard, tt.wantDaylight, tt.wantObserves)
+			}
+		})
+	}
+	// Mexico abolished DST in October 2022: a change in the year before is not enough
+	mexico := mustLoadLocation(t, "America/Mexico_City")
+	if std, dst, observes := StandardOffsets(time.Date(2023, 3, 1, 0, 0, 0, 0, mexico)); std != -21600 || dst != -21600 || observes {
+		t.Errorf("StandardOffsets(Mexico City 2023) = %d, %d, %v, want -21600, -21600, false", std, dst, observes)
+	}
+	if _, dst, observes := StandardOffsets(time.Date(2022, 3, 1, 0, 0, 0, 0, mexico)); dst != -18000 || !observes {
+		t.Errorf("StandardOffsets(Mexico City 2022) = %d, %v, want daylight -18000", dst, observes)
+	}
+}
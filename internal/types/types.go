@@ -7,24 +7,670 @@ type TimeResult struct {
 	Datetime  string `json:"datetime"`
 	DayOfWeek string `json:"day_of_week"`
 	IsDst     bool   `json:"is_dst"`
+	Formatted string `json:"formatted,omitempty"` // the time in the requested format, if any
+	// Localized is set when a locale is requested.
+	Localized *LocalizedTime `json:"localized,omitempty"`
+}
+
+// LocalizedTime is a time in a locale's language and conventions, from CLDR data.
+type LocalizedTime struct {
+	Locale    string `json:"locale"`
+	DayOfWeek string `json:"day_of_week"`
+	Month     string `json:"month"`
+	Long      string `json:"long"`      // e.g. "9 février 2026 à 14:05:07 CET"
+	Short     string `json:"short"`     // e.g. "09/02/2026 14:05"
+	ZoneName  string `json:"zone_name"` // e.g. "heure normale d’Europe centrale"
 }
 
 // TimeConversionResult represents a time conversion between two timezones.
+// Nonexistent and Ambiguous flag source wall-clock times that fall in a DST gap or overlap;
+// Candidates then lists both possible source instants of an ambiguous time.
 type TimeConversionResult struct {
-	Source         TimeResult `json:"source"`
-	Target         TimeResult `json:"target"`
-	TimeDifference string     `json:"time_difference"`
+	Source         TimeResult   `json:"source"`
+	Target         TimeResult   `json:"target"`
+	TimeDifference string       `json:"time_difference"`
+	Nonexistent    bool         `json:"nonexistent,omitempty"`
+	Ambiguous      bool         `json:"ambiguous,omitempty"`
+	Candidates     []TimeResult `json:"candidates,omitempty"`
 }
 
 // GetCurrentTimeInput represents the input parameters for the get_current_time tool.
 type GetCurrentTimeInput struct {
 	Timezone string `json:"timezone"`
+	Format   string `json:"format,omitempty"` // optional format name or pattern, see FormatDatetimeInput
+	Locale   string `json:"locale,omitempty"` // optional BCP 47 tag, e.g. "fr" or "pt-BR"
 }
 
 // ConvertTimeInput represents the input parameters for the convert_time tool.
-// Time is expected in HH:MM (24-hour) format.
+// Time is expected in HH:MM (24-hour) format, optionally paired with a YYYY-MM-DD Date,
+// or as a full ISO 8601 / RFC 3339 datetime.
 type ConvertTimeInput struct {
 	SourceTimezone string `json:"source_timezone"`
-	Time           string `json:"time"` // expected HH:MM
+	Time           string `json:"time"`           // expected HH:MM or ISO 8601 datetime
+	Date           string `json:"date,omitempty"` // optional YYYY-MM-DD, defaults to today in the source timezone
 	TargetTimezone string `json:"target_timezone"`
+	Disambiguation string `json:"disambiguation,omitempty"` // compatible (default), earlier, later, reject or shift_forward
+	Format         string `json:"format,omitempty"`         // optional format name or pattern, see FormatDatetimeInput
+	Locale         string `json:"locale,omitempty"`         // optional BCP 47 tag, e.g. "fr" or "pt-BR"
+}
+
+// AddDurationInput represents the input parameters for the add_duration tool.
+// Duration accepts ISO 8601 (e.g. P1M2DT3H) or Go (e.g. 1h30m) durations.
+type AddDurationInput struct {
+	Timezone string `json:"timezone"`
+	Duration string `json:"duration"`
+	Start    string `json:"start,omitempty"` // optional ISO 8601 datetime, defaults to now
+	Mode     string `json:"mode,omitempty"`  // calendar (default) or absolute
+}
+
+// TimeDifferenceInput represents the input parameters for the time_difference tool.
+// Start and End are ISO 8601 datetimes; an empty value means now.
+type TimeDifferenceInput struct {
+	Start         string `json:"start,omitempty"`
+	StartTimezone string `json:"start_timezone,omitempty"`
+	End           string `json:"end,omitempty"`
+	EndTimezone   string `json:"end_timezone,omitempty"`
+}
+
+// TimeDifferenceResult represents the elapsed time between two instants.
+// The breakdown is measured on the start timezone's calendar and is negative
+// (see IsNegative) when End is before Start.
+type TimeDifferenceResult struct {
+	Start        TimeResult `json:"start"`
+	End          TimeResult `json:"end"`
+	IsNegative   bool       `json:"is_negative"`
+	Years        int        `json:"years"`
+	Months       int        `json:"months"`
+	Days         int        `json:"days"`
+	Hours        int        `json:"hours"`
+	Minutes      int        `json:"minutes"`
+	Seconds      int        `json:"seconds"`
+	TotalSeconds int64      `json:"total_seconds"`
+	ISODuration  string     `json:"iso_duration"`
+	Human        string     `json:"human"`
+}
+
+// SearchTimezonesInput represents the input parameters for the search_timezones tool.
+type SearchTimezonesInput struct {
+	Query string `json:"query"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+// TimezoneCandidate is an IANA timezone proposed for a search query.
+type TimezoneCandidate struct {
+	Timezone  string   `json:"timezone"`
+	Score     float64  `json:"score"`
+	Match     string   `json:"match"`   // zone, city, abbreviation, country, prefix or fuzzy
+	Matched   string   `json:"matched"` // the name the query matched, e.g. "San Francisco"
+	Countries []string `json:"countries,omitempty"`
+}
+
+// SearchTimezonesResult represents the ranked candidates for a search_timezones query.
+type SearchTimezonesResult struct {
+	Query      string              `json:"query"`
+	Candidates []TimezoneCandidate `json:"candidates"`
+}
+
+// ListTimezonesInput represents the input parameters for the list_timezones tool.
+// All filters are optional and combine with AND.
+type ListTimezonesInput struct {
+	Country string `json:"country,omitempty"` // ISO 3166 alpha-2 code, e.g. "US"
+	Region  string `json:"region,omitempty"`  // zone name prefix, e.g. "Europe" or "America/Argentina"
+	Offset  string `json:"offset,omitempty"`  // current UTC offset, e.g. "+05:30" or "-8"
+}
+
+// TimezoneListEntry describes a timezone and its current state.
+type TimezoneListEntry struct {
+	Timezone     string   `json:"timezone"`
+	UTCOffset    string   `json:"utc_offset"`
+	Abbreviation string   `json:"abbreviation"`
+	IsDst        bool     `json:"is_dst"`
+	Countries    []string `json:"countries"`
+	Comment      string   `json:"comment,omitempty"`
+}
+
+// ListTimezonesResult represents the timezones matching a list_timezones query.
+type ListTimezonesResult struct {
+	Count     int                 `json:"count"`
+	Timezones []TimezoneListEntry `json:"timezones"`
+}
+
+// TimezoneInfoInput represents the input parameters for the get_timezone_info tool.
+type TimezoneInfoInput struct {
+	Timezone    string `json:"timezone"`
+	Transitions int    `json:"transitions,omitempty"` // number of next and previous transitions, default 2
+}
+
+// TimezoneTransition describes a change of UTC offset in a timezone.
+type TimezoneTransition struct {
+	Datetime           string `json:"datetime"` // instant of the change in the zone, after the change
+	UTC                string `json:"utc"`
+	OffsetBefore       string `json:"offset_before"`
+	OffsetAfter        string `json:"offset_after"`
+	AbbreviationBefore string `json:"abbreviation_before"`
+	AbbreviationAfter  string `json:"abbreviation_after"`
+	Delta              string `json:"delta"` // clock change, e.g. "+1.0h"
+}
+
+// TimezoneInfoResult represents the details of a timezone and its clock changes.
+type TimezoneInfoResult struct {
+	Timezone            string               `json:"timezone"`
+	Current             TimeResult           `json:"current"`
+	Abbreviation        string               `json:"abbreviation"`
+	UTCOffset           string               `json:"utc_offset"`
+	StandardOffset      string               `json:"standard_offset"`
+	DaylightOffset      string               `json:"daylight_offset,omitempty"`
+	ObservesDst         bool                 `json:"observes_dst"`
+	NextTransitions     []TimezoneTransition `json:"next_transitions"`
+	PreviousTransitions []TimezoneTransition `json:"previous_transitions"`
+}
+
+// MeetingParticipant is an attendee of a find_meeting_times request.
+// Working hours are HH:MM in the participant's timezone and default to 09:00-17:00, Monday to Friday.
+type MeetingParticipant struct {
+	Name      string   `json:"name,omitempty"`
+	Timezone  string   `json:"timezone"`
+	WorkStart string   `json:"work_start,omitempty"`
+	WorkEnd   string   `json:"work_end,omitempty"`
+	WorkDays  []string `json:"work_days,omitempty"`
+}
+
+// FindMeetingTimesInput represents the input parameters for the find_meeting_times tool.
+// StartDate and EndDate (inclusive, YYYY-MM-DD) are read in Timezone.
+type FindMeetingTimesInput struct {
+	Participants    []MeetingParticipant `json:"participants"`
+	DurationMinutes int                  `json:"duration_minutes"`
+	StartDate       string               `json:"start_date,omitempty"`
+	EndDate         string               `json:"end_date,omitempty"`
+	Timezone        string               `json:"timezone,omitempty"`
+	StepMinutes     int                  `json:"step_minutes,omitempty"`
+	Limit           int                  `json:"limit,omitempty"`
+}
+
+// MeetingSlotParticipant renders a meeting slot in one participant's timezone.
+type MeetingSlotParticipant struct {
+	Name  string     `json:"name,omitempty"`
+	Start TimeResult `json:"start"`
+	End   TimeResult `json:"end"`
+}
+
+// MeetingSlot is a candidate meeting time that suits every participant.
+type MeetingSlot struct {
+	StartUTC     string                   `json:"start_utc"`
+	EndUTC       string                   `json:"end_utc"`
+	Score        float64                  `json:"score"`
+	Participants []MeetingSlotParticipant `json:"participants"`
+}
+
+// FindMeetingTimesResult represents the ranked meeting slots, best first.
+type FindMeetingTimesResult struct {
+	Slots []MeetingSlot `json:"slots"`
+}
+
+// ConvertTimeMultiInput represents the input parameters for the convert_time_multi tool.
+// Time, Date and Disambiguation behave as in ConvertTimeInput.
+type ConvertTimeMultiInput struct {
+	SourceTimezone  string   `json:"source_timezone"`
+	Time            string   `json:"time"`
+	Date            string   `json:"date,omitempty"`
+	TargetTimezones []string `json:"target_timezones"`
+	Disambiguation  string   `json:"disambiguation,omitempty"`
+}
+
+// ZoneTimeResult is a TimeResult with the zone's offset relative to a reference time.
+type ZoneTimeResult struct {
+	TimeResult
+	TimeDifference string `json:"time_difference"`
+}
+
+// MultiConversionResult represents a time converted into several timezones at once.
+type MultiConversionResult struct {
+	Source      TimeResult       `json:"source"`
+	Targets     []ZoneTimeResult `json:"targets"`
+	Nonexistent bool             `json:"nonexistent,omitempty"`
+	Ambiguous   bool             `json:"ambiguous,omitempty"`
+	Candidates  []TimeResult     `json:"candidates,omitempty"`
+}
+
+// WorldClockInput represents the input parameters for the get_world_clock tool.
+type WorldClockInput struct {
+	Timezones         []string `json:"timezones"`
+	ReferenceTimezone string   `json:"reference_timezone,omitempty"`
+}
+
+// WorldClockResult represents the current time in several timezones.
+type WorldClockResult struct {
+	Reference TimeResult       `json:"reference"`
+	Clocks    []ZoneTimeResult `json:"clocks"`
+}
+
+// ParseDatetimeInput represents the input parameters for the parse_datetime tool.
+type ParseDatetimeInput struct {
+	Text     string `json:"text"`
+	Timezone string `json:"timezone"`           // reference timezone for relative phrases
+	Language string `json:"language,omitempty"` // ISO 639-1 code, default "en"
+}
+
+// ParseDatetimeResult represents a natural-language phrase resolved to a point in time.
+// Nonexistent and Ambiguous flag a wall-clock time that falls in a DST gap or overlap;
+// Candidates then lists both possible instants of an ambiguous time.
+type ParseDatetimeResult struct {
+	Text     string     `json:"text"`
+	Language string     `json:"language"`
+	Result   TimeResult `json:"result"` // in the timezone named by the phrase, or the reference timezone
+	// Reference is the same instant in the reference timezone.
+	Reference      TimeResult   `json:"reference"`
+	Granularity    string       `json:"granularity"` // e.g. "day" for "tomorrow", "minute" for "3pm"
+	Interpretation string       `json:"interpretation"`
+	Nonexistent    bool         `json:"nonexistent,omitempty"`
+	Ambiguous      bool         `json:"ambiguous,omitempty"`
+	Candidates     []TimeResult `json:"candidates,omitempty"`
+}
+
+// CronNextRunsInput represents the input parameters for the cron_next_runs tool.
+type CronNextRunsInput struct {
+	Expression string `json:"expression"`
+	Timezone   string `json:"timezone"`
+	Count      int    `json:"count,omitempty"`   // number of runs, default 5
+	Start      string `json:"start,omitempty"`   // ISO 8601 datetime, default now
+	Dialect    string `json:"dialect,omitempty"` // "auto", "standard" or "quartz"
+}
+
+// CronRun is a fire time of a cron expression.
+type CronRun struct {
+	TimeResult
+	// Repeated is set when the wall-clock time occurs twice because clocks were set back.
+	Repeated bool `json:"repeated,omitempty"`
+}
+
+// CronDSTEvent describes a fire time affected by a DST transition.
+type CronDSTEvent struct {
+	Type     string `json:"type"`      // "skipped" or "repeated"
+	WallTime string `json:"wall_time"` // local time without offset, e.g. "2026-03-08T02:30:00"
+	Detail   string `json:"detail"`
+}
+
+// CronNextRunsResult represents the upcoming fire times of a cron expression.
+type CronNextRunsResult struct {
+	Expression string         `json:"expression"`
+	Dialect    string         `json:"dialect"`
+	Timezone   string         `json:"timezone"`
+	Runs       []CronRun      `json:"runs"`
+	DSTEvents  []CronDSTEvent `json:"dst_events"`
+}
+
+// ExplainScheduleInput represents the input parameters for the explain_schedule tool.
+type ExplainScheduleInput struct {
+	Schedule string `json:"schedule"`
+	Format   string `json:"format,omitempty"`  // "auto", "cron" or "systemd"
+	Dialect  string `json:"dialect,omitempty"` // cron dialect: "auto", "standard" or "quartz"
+}
+
+// ScheduleField is one field of a schedule and its plain-English meaning.
+type ScheduleField struct {
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+}
+
+// ScheduleError points at the part of a schedule that failed validation. Field and
+// Position are omitted when the error is not specific to one field.
+type ScheduleError struct {
+	Field    string `json:"field,omitempty"`
+	Position int    `json:"position,omitempty"` // 1-based position of the field, or of its OnCalendar component
+	Value    string `json:"value,omitempty"`
+	Message  string `json:"message"`
+}
+
+// ExplainScheduleResult represents a cron expression or systemd OnCalendar
+// specification described in plain English, or the errors that make it invalid.
+type ExplainScheduleResult struct {
+	Schedule    string          `json:"schedule"`
+	Format      string          `json:"format"`            // "cron" or "systemd"
+	Dialect     string          `json:"dialect,omitempty"` // cron dialect of a valid expression
+	Valid       bool            `json:"valid"`
+	Description string          `json:"description,omitempty"`
+	Timezone    string          `json:"timezone,omitempty"` // timezone named by an OnCalendar specification
+	Fields      []ScheduleField `json:"fields"`
+	Errors      []ScheduleError `json:"errors"`
+}
+
+// ExpandRecurrenceInput represents the input parameters for the expand_recurrence tool.
+// RRule is an RRULE value or a block of DTSTART, RRULE, RDATE and EXDATE lines.
+type ExpandRecurrenceInput struct {
+	RRule    string   `json:"rrule"`
+	Dtstart  string   `json:"dtstart,omitempty"`  // required unless RRule has a DTSTART line
+	Timezone string   `json:"timezone,omitempty"` // TZID of dtstart and floating times, default UTC
+	RDate    []string `json:"rdate,omitempty"`
+	ExDate   []string `json:"exdate,omitempty"`
+	After    string   `json:"after,omitempty"`  // only occurrences at or after this datetime
+	Before   string   `json:"before,omitempty"` // only occurrences before this datetime
+	Limit    int      `json:"limit,omitempty"`  // maximum number of occurrences, default 10
+}
+
+// ExpandRecurrenceResult represents the occurrences of a recurrence set. Truncated is set
+// when more occurrences follow the last one returned.
+type ExpandRecurrenceResult struct {
+	Timezone    string       `json:"timezone"`
+	Start       TimeResult   `json:"start"`
+	Rules       []string     `json:"rules"`
+	Occurrences []TimeResult `json:"occurrences"`
+	Truncated   bool         `json:"truncated"`
+}
+
+// ParseICSInput represents the input parameters for the parse_ics tool.
+type ParseICSInput struct {
+	ICS              string `json:"ics"`
+	Timezone         string `json:"timezone"`                    // timezone to convert event times into
+	FloatingTimezone string `json:"floating_timezone,omitempty"` // timezone of times without a TZID, default timezone
+}
+
+// ICSEvent represents a VEVENT with its start and end converted from the timezone of
+// the event into the requested timezone.
+type ICSEvent struct {
+	UID        string               `json:"uid,omitempty"`
+	Summary    string               `json:"summary,omitempty"`
+	Location   string               `json:"location,omitempty"`
+	AllDay     bool                 `json:"all_day"`
+	Recurrence []string             `json:"recurrence,omitempty"` // RRULE values of a recurring event
+	Start      TimeConversionResult `json:"start"`
+	End        TimeConversionResult `json:"end"`
+}
+
+// ParseICSResult represents the events of an iCalendar document. CustomTimezones lists
+// the TZIDs defined by the document's own VTIMEZONE components.
+type ParseICSResult struct {
+	Timezone        string     `json:"timezone"`
+	Events          []ICSEvent `json:"events"`
+	CustomTimezones []string   `json:"custom_timezones"`
+}
+
+// CreateICSInput represents the input parameters for the create_ics tool.
+type CreateICSInput struct {
+	Title      string `json:"title"`
+	Start      string `json:"start"`    // wall-clock start in Timezone
+	Duration   string `json:"duration"` // ISO 8601 or Go duration
+	Timezone   string `json:"timezone"`
+	Recurrence string `json:"recurrence,omitempty"` // RRULE value
+	Location   string `json:"location,omitempty"`
+	UID        string `json:"uid,omitempty"` // generated from the event when empty
+}
+
+// CreateICSResult represents a generated iCalendar document and the event it holds.
+// Nonexistent and Ambiguous flag a start that falls in a DST gap or overlap.
+type CreateICSResult struct {
+	UID         string     `json:"uid"`
+	Start       TimeResult `json:"start"`
+	End         TimeResult `json:"end"`
+	Recurrence  string     `json:"recurrence,omitempty"`
+	Nonexistent bool       `json:"nonexistent,omitempty"`
+	Ambiguous   bool       `json:"ambiguous,omitempty"`
+	ICS         string     `json:"ics"`
+}
+
+// EpochConvertInput represents the input parameters for the epoch_convert tool.
+// Timestamp and Datetime are exclusive; when both are empty the current time is used.
+type EpochConvertInput struct {
+	Timestamp string `json:"timestamp,omitempty"` // Unix timestamp, optionally with a fraction
+	Unit      string `json:"unit,omitempty"`      // "auto" (default), "s", "ms", "us" or "ns"
+	Datetime  string `json:"datetime,omitempty"`  // ISO 8601 datetime to convert into epoch units
+	Timezone  string `json:"timezone,omitempty"`
+}
+
+// EpochConvertResult represents an instant as a time in a timezone and as Unix
+// timestamps in every unit, rounded down. Timestamps are strings so that nanoseconds
+// keep their precision in JSON.
+type EpochConvertResult struct {
+	Time         TimeResult `json:"time"`
+	Unit         string     `json:"unit,omitempty"` // unit the timestamp was read in
+	AutoDetected bool       `json:"auto_detected,omitempty"`
+	Seconds      string     `json:"seconds"`
+	Milliseconds string     `json:"milliseconds"`
+	Microseconds string     `json:"microseconds"`
+	Nanoseconds  string     `json:"nanoseconds"`
+}
+
+// ConvertTimeScaleInput represents the input parameters for the convert_time_scale tool.
+// The instant is a reading on Scale, a GPS week and seconds of week, or now.
+type ConvertTimeScaleInput struct {
+	Time             string   `json:"time,omitempty"`  // ISO 8601 reading; UTC readings may carry an offset
+	Scale            string   `json:"scale,omitempty"` // "UTC" (default), "TAI", "GPS", "TT" or "LORAN"
+	GPSWeek          *int     `json:"gps_week,omitempty"`
+	GPSSecondsOfWeek *float64 `json:"gps_seconds_of_week,omitempty"`
+	Timezone         string   `json:"timezone,omitempty"` // timezone of naive UTC readings and of the result time
+}
+
+// TimeScaleResult represents an instant on every supported time scale. Scales holds a
+// reading per scale, named by the scale in place of a timezone. LeapSecond is set for
+// an instant inside a leap second, shown as 23:59:60 on the UTC scale.
+type TimeScaleResult struct {
+	Time             TimeResult   `json:"time"`
+	Scales           []TimeResult `json:"scales"`
+	GPSWeek          int          `json:"gps_week"`
+	GPSSecondsOfWeek float64      `json:"gps_seconds_of_week"`
+	TAIMinusUTC      int          `json:"tai_minus_utc"` // seconds
+	LeapSecond       bool         `json:"leap_second,omitempty"`
+	LeapTableExpires string       `json:"leap_table_expires"`
+	// LeapTableExpired is set when the instant is past the leap second table's expiry,
+	// so that a leap second it does not list may have occurred.
+	LeapTableExpired bool `json:"leap_table_expired,omitempty"`
+}
+
+// ConvertDateFormatInput represents the input parameters for the convert_date_format tool.
+// Value (with its Format) and Datetime are exclusive; when both are empty the current
+// time is used.
+type ConvertDateFormatInput struct {
+	Value    string `json:"value,omitempty"`  // number in Format
+	Format   string `json:"format,omitempty"` // "jd", "jdn", "mjd", "rata_die", "excel", "excel_1904" or "cocoa"
+	Datetime string `json:"datetime,omitempty"`
+	Timezone string `json:"timezone,omitempty"` // wall clock of calendar-day formats
+}
+
+// DateFormatsResult represents an instant in every numeric date format. JDN, Rata Die
+// and the Excel serials count the date and wall-clock time in the timezone; JD, MJD and
+// Cocoa count from UTC. Excel serials are omitted before the start of their system.
+type DateFormatsResult struct {
+	Time               TimeResult `json:"time"`
+	Nonexistent        bool       `json:"nonexistent,omitempty"`
+	Ambiguous          bool       `json:"ambiguous,omitempty"`
+	JulianDay          float64    `json:"jd"`
+	JulianDayNumber    int        `json:"jdn"`
+	ModifiedJulianDate float64    `json:"mjd"`
+	RataDie            int        `json:"rata_die"`
+	Excel              *float64   `json:"excel,omitempty"`
+	Excel1904          *float64   `json:"excel_1904,omitempty"`
+	Cocoa              float64    `json:"cocoa"` // seconds since 2001-01-01 00:00:00 UTC
+}
+
+// FormatDatetimeInput represents the input parameters for the format_datetime tool.
+// Format is a name such as "rfc2822" or "http", or a strftime, Go layout, Java or
+// moment.js pattern; Syntax names the pattern language when detection would guess wrong
+// or fail.
+type FormatDatetimeInput struct {
+	Datetime string `json:"datetime,omitempty"` // ISO 8601, defaults to now
+	Timezone string `json:"timezone,omitempty"`
+	Format   string `json:"format"`
+	Syntax   string `json:"syntax,omitempty"` // auto (default), named, strftime, go, java or moment
+}
+
+// FormatDatetimeResult represents a formatted time. GoLayout is the equivalent Go layout
+// when Go layouts can express the format.
+type FormatDatetimeResult struct {
+	Time      TimeResult `json:"time"`
+	Formatted string     `json:"formatted"`
+	Syntax    string     `json:"syntax"` // the syntax the format was read in
+	GoLayout  string     `json:"go_layout,omitempty"`
+}
+
+// BusinessCalendarInput holds the calendar parameters shared by the business day tools.
+// Country selects public holidays and the default weekend; Weekend replaces the weekend
+// days and Holidays adds custom days off.
+type BusinessCalendarInput struct {
+	Country  string   `json:"country,omitempty"`  // ISO 3166-1 alpha-2 or ISO 3166-2 subdivision code
+	Weekend  []string `json:"weekend,omitempty"`  // weekday names, e.g. ["Friday", "Saturday"]
+	Holidays []string `json:"holidays,omitempty"` // "YYYY-MM-DD", optionally followed by a name
+}
+
+// AddBusinessDaysInput represents the input parameters for the add_business_days tool.
+type AddBusinessDaysInput struct {
+	Date     string `json:"date,omitempty"` // YYYY-MM-DD, defaults to today in Timezone
+	Days     int    `json:"days"`           // negative to go back
+	Timezone string `json:"timezone,omitempty"`
+	BusinessCalendarInput
+}
+
+// HolidayResult represents a public or custom holiday.
+type HolidayResult struct {
+	Date      string `json:"date"` // YYYY-MM-DD
+	DayOfWeek string `json:"day_of_week"`
+	Name      string `json:"name"`
+	LocalName string `json:"local_name,omitempty"`
+	Observed  bool   `json:"observed,omitempty"` // a substitute day for a holiday on a weekend or another holiday
+	Regional  bool   `json:"regional,omitempty"` // kept only in some subdivisions of the country
+}
+
+// AddBusinessDaysResult represents the date a number of business days away. Holidays
+// lists the holidays skipped on the way, besides weekend days. Notes tell when the
+// country's public holidays are not built in.
+type AddBusinessDaysResult struct {
+	Start        string          `json:"start"` // YYYY-MM-DD
+	Date         string          `json:"date"`  // YYYY-MM-DD
+	DayOfWeek    string          `json:"day_of_week"`
+	BusinessDays int             `json:"business_days"`
+	CalendarDays int             `json:"calendar_days"`
+	Weekend      []string        `json:"weekend"`
+	Holidays     []HolidayResult `json:"holidays"`
+	Notes        []string        `json:"notes,omitempty"`
+}
+
+// CountBusinessDaysInput represents the input parameters for the count_business_days
+// tool. Start is excluded and End included unless Inclusive is set.
+type CountBusinessDaysInput struct {
+	Start     string `json:"start,omitempty"` // YYYY-MM-DD, defaults to today in Timezone
+	End       string `json:"end,omitempty"`   // YYYY-MM-DD, defaults to today in Timezone
+	Inclusive bool   `json:"inclusive,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+	BusinessCalendarInput
+}
+
+// CountBusinessDaysResult represents the business days between two dates, negative when
+// End is before Start. Holidays lists the holidays not counted, besides weekend days.
+// Notes tell when the country's public holidays are not built in.
+type CountBusinessDaysResult struct {
+	Start        string          `json:"start"`
+	End          string          `json:"end"`
+	BusinessDays int             `json:"business_days"`
+	CalendarDays int             `json:"calendar_days"`
+	WeekendDays  int             `json:"weekend_days"`
+	Weekend      []string        `json:"weekend"`
+	Holidays     []HolidayResult `json:"holidays"`
+	Notes        []string        `json:"notes,omitempty"`
+}
+
+// CheckBusinessHoursInput represents the input parameters for the check_business_hours
+// tool. Working hours default to 09:00-17:00 in Timezone.
+type CheckBusinessHoursInput struct {
+	Datetime  string `json:"datetime,omitempty"` // ISO 8601, defaults to now
+	Timezone  string `json:"timezone,omitempty"`
+	WorkStart string `json:"work_start,omitempty"` // HH:MM
+	WorkEnd   string `json:"work_end,omitempty"`   // HH:MM
+	BusinessCalendarInput
+}
+
+// BusinessHoursResult represents a moment checked against working hours. Opens is the
+// start of the next working window when closed, Closes the end of the current window
+// when open. Notes tell when the country's public holidays are not built in.
+type BusinessHoursResult struct {
+	Time    TimeResult     `json:"time"`
+	Open    bool           `json:"open"`
+	Reason  string         `json:"reason"` // open, weekend, holiday, before_hours or after_hours
+	Holiday *HolidayResult `json:"holiday,omitempty"`
+	Opens   *TimeResult    `json:"opens,omitempty"`
+	Closes  *TimeResult    `json:"closes,omitempty"`
+	Notes   []string       `json:"notes,omitempty"`
+}
+
+// ListHolidaysInput represents the input parameters for the list_holidays tool. Year and
+// the Start to End range are exclusive; without either, the current year is listed.
+type ListHolidaysInput struct {
+	Country     string `json:"country"`               // ISO 3166-1 alpha-2 code
+	Subdivision string `json:"subdivision,omitempty"` // ISO 3166-2 code such as "DE-BY", or "BY"
+	Year        int    `json:"year,omitempty"`
+	Start       string `json:"start,omitempty"` // YYYY-MM-DD
+	End         string `json:"end,omitempty"`   // YYYY-MM-DD, defaults to Start
+}
+
+// ListHolidaysResult represents the public holidays of a country or subdivision between
+// two dates, inclusive.
+type ListHolidaysResult struct {
+	Country         string          `json:"country"`
+	CountryName     string          `json:"country_name"`
+	Subdivision     string          `json:"subdivision,omitempty"`
+	SubdivisionName string          `json:"subdivision_name,omitempty"`
+	Start           string          `json:"start"`
+	End             string          `json:"end"`
+	Holidays        []HolidayResult `json:"holidays"`
+}
+
+// AddBusinessHoursInput represents the input parameters for the add_business_hours tool.
+// Working hours in Timezone default to 09:00-17:00, and the deadline is also shown in
+// TargetTimezone, which defaults to Timezone.
+type AddBusinessHoursInput struct {
+	Start          string  `json:"start,omitempty"` // ISO 8601, defaults to now
+	Hours          float64 `json:"hours"`
+	Timezone       string  `json:"timezone"`
+	TargetTimezone string  `json:"target_timezone,omitempty"`
+	WorkStart      string  `json:"work_start,omitempty"` // HH:MM
+	WorkEnd        string  `json:"work_end,omitempty"`   // HH:MM
+	BusinessCalendarInput
+}
+
+// BusinessDeadlineResult represents a deadline a number of working hours after a start.
+// The clock starts at ClockStart, the start or the next opening when the start is out of
+// hours. Deadline holds the deadline in the schedule's timezone as its source and in the
+// target timezone. Holidays lists the holidays skipped on the way, besides weekend days.
+// Notes tell when the country's public holidays are not built in.
+type BusinessDeadlineResult struct {
+	Start         TimeResult           `json:"start"`
+	ClockStart    TimeResult           `json:"clock_start"`
+	Deadline      TimeConversionResult `json:"deadline"`
+	BusinessHours float64              `json:"business_hours"`
+	Elapsed       string               `json:"elapsed"` // calendar time from start to deadline
+	Holidays      []HolidayResult      `json:"holidays"`
+	Notes         []string             `json:"notes,omitempty"`
+}
+
+// SunTimesInput represents the input parameters for the get_sun_times tool. Latitude and
+// Longitude are in decimal degrees, north and east positive.
+type SunTimesInput struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Date      string  `json:"date,omitempty"` // YYYY-MM-DD, defaults to today in Timezone
+	Timezone  string  `json:"timezone"`
+}
+
+// SunTimesResult represents the solar events of a date at a place, in the requested
+// timezone. Dawn and dusk mark the start and end of each twilight, with the Sun 6°
+// (civil), 12° (nautical) or 18° (astronomical) below the horizon. An event is omitted
+// when the Sun does not cross its horizon that day: Polar is then "polar_day" or
+// "polar_night" for sunrise and sunset, and Notes tell which twilights are missing.
+type SunTimesResult struct {
+	Date             string      `json:"date"`
+	Latitude         float64     `json:"latitude"`
+	Longitude        float64     `json:"longitude"`
+	Timezone         string      `json:"timezone"`
+	SolarNoon        *TimeResult `json:"solar_noon,omitempty"`
+	NoonElevation    float64     `json:"noon_elevation"` // degrees above the horizon at solar noon
+	Sunrise          *TimeResult `json:"sunrise,omitempty"`
+	Sunset           *TimeResult `json:"sunset,omitempty"`
+	CivilDawn        *TimeResult `json:"civil_dawn,omitempty"`
+	CivilDusk        *TimeResult `json:"civil_dusk,omitempty"`
+	NauticalDawn     *TimeResult `json:"nautical_dawn,omitempty"`
+	NauticalDusk     *TimeResult `json:"nautical_dusk,omitempty"`
+	AstronomicalDawn *TimeResult `json:"astronomical_dawn,omitempty"`
+	AstronomicalDusk *TimeResult `json:"astronomical_dusk,omitempty"`
+	DayLength        string      `json:"day_length"` // sunrise to sunset, e.g. "16 hours and 38 minutes"
+	DayLengthSeconds int64       `json:"day_length_seconds"`
+	Polar            string      `json:"polar,omitempty"`
+	Notes            []string    `json:"notes,omitempty"`
 }
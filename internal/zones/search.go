@@ -0,0 +1,181 @@
+package zones
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Match kinds reported by Search, from most to least specific.
+const (
+	MatchZone         = "zone"
+	MatchCity         = "city"
+	MatchAbbreviation = "abbreviation"
+	MatchCountry      = "country"
+	MatchPrefix       = "prefix"
+	MatchFuzzy        = "fuzzy"
+)
+
+// minSimilarity is the lowest edit-distance similarity accepted as a fuzzy match.
+const minSimilarity = 0.7
+
+// Candidate is a ranked IANA timezone proposed for a free-form query.
+type Candidate struct {
+	Zone  string
+	Score float64 // 0..1, higher is better
+	Match string  // one of the Match* kinds
+	// Matched is the name the query was matched against, e.g. "San Francisco" or "Japan".
+	Matched string
+}
+
+// Search maps a free-form query such as "New York", "Tokyo time", "PST", "Germany" or
+// "Europe/Londn" onto at most limit candidate timezones, best first. Matching is
+// case-insensitive and tolerates small misspellings.
+func Search(query string, limit int) []Candidate {
+	load()
+	q := normalize(query)
+	if q == "" || limit <= 0 {
+		return nil
+	}
+
+	best := make(map[string]Candidate)
+	consider := func(zone string, score float64, match, matched string) {
+		score = math.Round(score*100) / 100
+		if cur, ok := best[zone]; !ok || score > cur.Score {
+			best[zone] = Candidate{Zone: zone, Score: score, Match: match, Matched: matched}
+		}
+	}
+	// fuzzy scores a name against the query by exact, prefix and edit-distance similarity
+	fuzzy := func(zone, name string, exact float64, match string) {
+		n := normalize(name)
+		switch {
+		case n == q:
+			consider(zone, exact, match, name)
+		case len(q) >= 3 && strings.HasPrefix(n, q):
+			consider(zone, 0.75*exact, MatchPrefix, name)
+		default:
+			if sim := similarity(q, n); sim >= minSimilarity {
+				consider(zone, 0.85*sim*exact, MatchFuzzy, name)
+			}
+		}
+	}
+
+	for _, z := range zones {
+		fuzzy(z.Name, z.Name, 1.0, MatchZone)
+		fuzzy(z.Name, City(z.Name), 0.95, MatchCity)
+	}
+	for _, l := range links {
+		if l.Zone != "" && l.Country == "" {
+			// A former name such as "Asia/Calcutta" stands for the zone it was renamed
+			// to, below abbreviations such as "EST" that are link names too
+			fuzzy(l.Zone, l.Name, 0.9, MatchZone)
+			continue
+		}
+		fuzzy(l.Name, l.Name, 1.0, MatchZone)
+		fuzzy(l.Name, City(l.Name), 0.95, MatchCity)
+	}
+	for _, a := range aliases {
+		for i, zone := range a.Zones {
+			// Earlier zones of an ambiguous alias rank higher
+			score := 0.95 - 0.05*float64(i)
+			if a.Kind == AliasAbbreviation {
+				// Abbreviations are only trusted verbatim, "ESTT" is not "EST"
+				if normalize(a.Name) == q {
+					consider(zone, score, MatchAbbreviation, a.Name)
+				}
+				continue
+			}
+			fuzzy(zone, a.Name, score, MatchCity)
+		}
+	}
+	for code, name := range countries {
+		for rank, zone := range searchZones[code] {
+			score := 0.9 - 0.01*float64(rank)
+			if strings.EqualFold(q, code) {
+				consider(zone, score, MatchCountry, code)
+				continue
+			}
+			for _, n := range append([]string{name}, countryAliases[code]...) {
+				fuzzy(zone, n, score, MatchCountry)
+			}
+		}
+	}
+
+	candidates := make([]Candidate, 0, len(best))
+	for _, c := range best {
+		candidates = append(candidates, c)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Zone < candidates[j].Zone
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
+
+// Suggest returns up to n zone names resembling query, for "did you mean" hints.
+func Suggest(query string, n int) []string {
+	var names []string
+	for _, c := range Search(query, n) {
+		names = append(names, c.Zone)
+	}
+	return names
+}
+
+// normalize lowercases s, treats '_' as a space and drops filler words such as
+// "time" or "timezone" so that "Tokyo time" and "tokyo" compare equal.
+func normalize(s string) string {
+	s = strings.ToLower(strings.ReplaceAll(s, "_", " "))
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '.' || r == '?' || r == '!'
+	})
+	kept := words[:0]
+	for _, w := range words {
+		switch w {
+		case "time", "timezone", "tz", "zone", "in", "the":
+			continue
+		}
+		kept = append(kept, w)
+	}
+	return strings.Join(kept, " ")
+}
+
+// similarity returns 1 - distance/length using the optimal string alignment distance,
+// so a single typo or transposition in a long name still scores highly.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(osaDistance(ra, rb))/float64(longest)
+}
+
+func osaDistance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
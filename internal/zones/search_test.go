@@ -0,0 +1,103 @@
+package zones
+
+import "testing"
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query     string
+		wantZone  string
+		wantMatch string
+	}{
+		{"America/New_York", "America/New_York", MatchZone},
+		{"america/new_york", "America/New_York", MatchZone},
+		{"New York", "America/New_York", MatchCity},
+		{"Tokyo time", "Asia/Tokyo", MatchCity},
+		{"San Francisco", "America/Los_Angeles", MatchCity},
+		{"Bangalore", "Asia/Kolkata", MatchCity},
+		{"PST", "America/Los_Angeles", MatchAbbreviation},
+		{"IST", "Asia/Kolkata", MatchAbbreviation},
+		{"Germany", "Europe/Berlin", MatchCountry},
+		{"JP", "Asia/Tokyo", MatchCountry},
+		{"Europe/Londn", "Europe/London", MatchFuzzy},
+		{"Sidney", "Australia/Sydney", MatchFuzzy},
+		{"america/new_yrok", "America/New_York", MatchFuzzy},
+		{"san fran", "America/Los_Angeles", MatchPrefix},
+		// Zones zone1970.tab merges into another country's, and former names
+		{"Europe/Oslo", "Europe/Oslo", MatchZone},
+		{"Oslo", "Europe/Oslo", MatchCity},
+		{"Reykjavik", "Atlantic/Reykjavik", MatchCity},
+		{"Norway", "Europe/Oslo", MatchCountry},
+		{"Asia/Calcutta", "Asia/Kolkata", MatchZone},
+		{"US/Eastern", "America/New_York", MatchZone},
+		{"EST", "America/New_York", MatchAbbreviation},
+		{"UK", "Europe/London", MatchCountry},
+		{"United Kingdom", "Europe/London", MatchCountry},
+		{"USA", "America/New_York", MatchCountry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got := Search(tt.query, 5)
+			if len(got) == 0 {
+				t.Fatalf("Search(%q) returned no candidates", tt.query)
+			}
+			if got[0].Zone != tt.wantZone {
+				t.Errorf("Search(%q) best = %s, want %s (all: %+v)", tt.query, got[0].Zone, tt.wantZone, got)
+			}
+			if got[0].Match != tt.wantMatch {
+				t.Errorf("Search(%q) match = %s, want %s", tt.query, got[0].Match, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestSearchAmbiguousAbbreviation(t *testing.T) {
+	got := Search("IST", 5)
+	want := []string{"Asia/Kolkata", "Europe/Dublin", "Asia/Jerusalem"}
+	if len(got) < len(want) {
+		t.Fatalf("expected at least %d candidates, got %+v", len(want), got)
+	}
+	for i, zone := range want {
+		if got[i].Zone != zone {
+			t.Errorf("candidate %d = %s, want %s", i, got[i].Zone, zone)
+		}
+	}
+}
+
+func TestSearchNoMatch(t *testing.T) {
+	if got := Search("xyzzy", 5); len(got) != 0 {
+		t.Errorf("expected no candidates, got %+v", got)
+	}
+	if got := Search("   ", 5); len(got) != 0 {
+		t.Errorf("expected no candidates for blank query, got %+v", got)
+	}
+	if got := Search("Paris", 0); len(got) != 0 {
+		t.Errorf("expected no candidates for zero limit, got %+v", got)
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	if got := Search("US", 3); len(got) != 3 {
+		t.Errorf("expected 3 candidates, got %d", len(got))
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	got := Suggest("Europe/Pari", 3)
+	if len(got) == 0 || got[0] != "Europe/Paris" {
+		t.Errorf("Suggest(Europe/Pari) = %v", got)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if got := similarity("london", "london"); got != 1 {
+		t.Errorf("identical strings similarity = %v", got)
+	}
+	// a transposition counts as a single edit
+	if got := osaDistance([]rune("yrok"), []rune("york")); got != 1 {
+		t.Errorf("transposition distance = %d, want 1", got)
+	}
+	if got := osaDistance([]rune(""), []rune("abc")); got != 3 {
+		t.Errorf("empty distance = %d, want 3", got)
+	}
+}
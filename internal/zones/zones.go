@@ -0,0 +1,228 @@
+package zones
+
+import (
+	"bufio"
+	_ "embed"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The tzdb tables and the curated alias table are embedded so that lookups work offline.
+//
+//go:embed data/zone1970.tab
+var zone1970Tab string
+
+//go:embed data/iso3166.tab
+var iso3166Tab string
+
+//go:embed data/aliases.tab
+var aliasesTab string
+
+//go:embed data/links.tab
+var linksTab string
+
+// Zone is a row of zone1970.tab: a timezone whose clocks have agreed since 1970.
+type Zone struct {
+	Name string
+	// Countries lists the ISO 3166 codes of the countries overlapping the zone,
+	// most populous first.
+	Countries []string
+	// Coordinates is the ISO 6709 position of the zone's principal location.
+	Coordinates string
+	// Comment distinguishes zones of countries with several timezones.
+	Comment string
+}
+
+// Link is a row of links.tab: a name time.LoadLocation accepts that zone1970.tab does
+// not list.
+type Link struct {
+	Name string
+	// Zone is the zone1970.tab zone whose data the name shares, or "" when it has data
+	// of its own.
+	Zone string
+	// Country is the ISO 3166 code of the country whose zone it is in zone.tab, or "".
+	Country string
+}
+
+// Alias maps a name that is not an IANA identifier onto candidate zones.
+type Alias struct {
+	Kind  string // "city" or "abbr"
+	Name  string
+	Zones []string
+}
+
+// Alias kinds. Rows of kind "country" in aliases.tab name a country rather than zones.
+const (
+	AliasCity         = "city"
+	AliasAbbreviation = "abbr"
+	aliasCountry      = "country"
+)
+
+var (
+	loadOnce  sync.Once
+	zones     []Zone
+	countries map[string]string
+	// countryZones lists each country's zones in zone1970.tab order, most populous first
+	countryZones map[string][]string
+	aliases      []Alias
+	// countryAliases lists other common names of a country, such as "UK" for GB
+	countryAliases map[string][]string
+	links          []Link
+	// searchZones ranks a country's zones for Search: its zone1970.tab zones located in
+	// the country, then its own zones in zone.tab that merged into another country's,
+	// such as Europe/Oslo for Norway, then the shared ones
+	searchZones map[string][]string
+)
+
+func load() {
+	loadOnce.Do(func() {
+		countryZones = make(map[string][]string)
+		primary := make(map[string]string)
+		primaryCountry := func(name string) string { return primary[name] }
+		for _, fields := range readTab(zone1970Tab) {
+			if len(fields) < 3 {
+				continue
+			}
+			z := Zone{Countries: strings.Split(fields[0], ","), Coordinates: fields[1], Name: fields[2]}
+			if len(fields) > 3 {
+				z.Comment = fields[3]
+			}
+			zones = append(zones, z)
+			primary[z.Name] = z.Countries[0]
+			for _, code := range z.Countries {
+				countryZones[code] = append(countryZones[code], z.Name)
+			}
+		}
+		// A zone shared with another country (e.g. Europe/Zurich for Büsingen, Germany)
+		// ranks after the zones whose principal location is in the country itself
+		for code, names := range countryZones {
+			sort.SliceStable(names, func(i, j int) bool {
+				return primaryCountry(names[i]) == code && primaryCountry(names[j]) != code
+			})
+		}
+		sort.SliceStable(zones, func(i, j int) bool { return zones[i].Name < zones[j].Name })
+
+		countries = make(map[string]string)
+		for _, fields := range readTab(iso3166Tab) {
+			if len(fields) >= 2 {
+				countries[fields[0]] = fields[1]
+			}
+		}
+
+		countryAliases = make(map[string][]string)
+		for _, fields := range readTab(aliasesTab) {
+			switch {
+			case len(fields) < 3:
+			case fields[0] == aliasCountry:
+				countryAliases[fields[2]] = append(countryAliases[fields[2]], fields[1])
+			default:
+				aliases = append(aliases, Alias{Kind: fields[0], Name: fields[1], Zones: strings.Split(fields[2], ",")})
+			}
+		}
+
+		own := make(map[string][]string)
+		for _, fields := range readTab(linksTab) {
+			if len(fields) >= 3 {
+				l := Link{Name: fields[0], Zone: strings.Trim(fields[1], "-"), Country: strings.Trim(fields[2], "-")}
+				links = append(links, l)
+				if l.Country != "" {
+					own[l.Country] = append(own[l.Country], l.Name)
+				}
+			}
+		}
+		searchZones = make(map[string][]string)
+		for code := range countries {
+			names := countryZones[code]
+			located := 0
+			for located < len(names) && primaryCountry(names[located]) == code {
+				located++
+			}
+			searchZones[code] = slices.Concat(names[:located], own[code], names[located:])
+		}
+	})
+}
+
+// readTab splits a tzdb-style table into tab-separated fields, skipping comments and blank lines.
+func readTab(data string) [][]string {
+	var rows [][]string
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rows = append(rows, strings.Split(line, "\t"))
+	}
+	return rows
+}
+
+// All returns every zone of zone1970.tab sorted by name. The slice must not be modified.
+func All() []Zone {
+	load()
+	return zones
+}
+
+// Lookup returns the zone1970.tab entry for name.
+func Lookup(name string) (Zone, bool) {
+	load()
+	i := sort.Search(len(zones), func(i int) bool { return zones[i].Name >= name })
+	if i < len(zones) && zones[i].Name == name {
+		return zones[i], true
+	}
+	return Zone{}, false
+}
+
+// Links returns the names outside zone1970.tab that time.LoadLocation accepts, sorted by
+// name. The slice must not be modified.
+func Links() []Link {
+	load()
+	return links
+}
+
+// Countries returns the ISO 3166 codes of the countries a zone or link name serves, most
+// populous first.
+func Countries(name string) []string {
+	if z, ok := Lookup(name); ok {
+		return z.Countries
+	}
+	i := sort.Search(len(links), func(i int) bool { return links[i].Name >= name })
+	if i == len(links) || links[i].Name != name {
+		return nil
+	}
+	if l := links[i]; l.Country != "" {
+		return []string{l.Country}
+	} else if l.Zone != "" {
+		return Countries(l.Zone)
+	}
+	return nil
+}
+
+// CountryName returns the iso3166.tab name for an ISO 3166 alpha-2 code.
+func CountryName(code string) (string, bool) {
+	load()
+	name, ok := countries[strings.ToUpper(code)]
+	return name, ok
+}
+
+// CountryZones returns the zones overlapping a country, most populous first.
+func CountryZones(code string) []string {
+	load()
+	return countryZones[strings.ToUpper(code)]
+}
+
+// Aliases returns the curated city and abbreviation aliases. The slice must not be modified.
+func Aliases() []Alias {
+	load()
+	return aliases
+}
+
+// City returns the human-readable city part of a zone name, e.g. "New York" for
+// "America/New_York" or "Buenos Aires" for "America/Argentina/Buenos_Aires".
+func City(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ReplaceAll(name, "_", " ")
+}
@@ -0,0 +1,118 @@
+package zones
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestAllZonesLoad(t *testing.T) {
+	all := All()
+	if len(all) < 300 {
+		t.Fatalf("expected at least 300 zones, got %d", len(all))
+	}
+	for _, z := range all {
+		if _, err := time.LoadLocation(z.Name); err != nil {
+			t.Errorf("zone %s does not load: %v", z.Name, err)
+		}
+		if len(z.Countries) == 0 {
+			t.Errorf("zone %s has no countries", z.Name)
+		}
+	}
+}
+
+func TestAliasesLoad(t *testing.T) {
+	for _, a := range Aliases() {
+		if a.Kind != AliasCity && a.Kind != AliasAbbreviation {
+			t.Errorf("alias %s has unknown kind %q", a.Name, a.Kind)
+		}
+		for _, zone := range a.Zones {
+			if _, err := time.LoadLocation(zone); err != nil {
+				t.Errorf("alias %s points to invalid zone %s: %v", a.Name, zone, err)
+			}
+		}
+	}
+}
+
+func TestLinksLoad(t *testing.T) {
+	if len(Links()) < 250 {
+		t.Fatalf("expected at least 250 links, got %d", len(Links()))
+	}
+	for _, l := range Links() {
+		if _, err := time.LoadLocation(l.Name); err != nil {
+			t.Errorf("link %s does not load: %v", l.Name, err)
+		}
+		if _, ok := Lookup(l.Zone); l.Zone != "" && !ok {
+			t.Errorf("link %s shares data with %s, which zone1970.tab lacks", l.Name, l.Zone)
+		}
+		if _, ok := CountryName(l.Country); l.Country != "" && !ok {
+			t.Errorf("link %s has unknown country %s", l.Name, l.Country)
+		}
+	}
+	for code := range countryAliases {
+		if _, ok := CountryName(code); !ok {
+			t.Errorf("country alias for unknown code %s", code)
+		}
+	}
+}
+
+func TestCountries(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Europe/Oslo", "NO"},
+		{"Asia/Calcutta", "IN"},
+		{"Europe/Paris", "FR,MC"},
+		{"Etc/GMT+5", ""},
+		{"Mars/Olympus", ""},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(Countries(tt.name), ","); got != tt.want {
+			t.Errorf("Countries(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	z, ok := Lookup("Europe/Paris")
+	if !ok {
+		t.Fatal("expected Europe/Paris to be found")
+	}
+	if z.Countries[0] != "FR" {
+		t.Errorf("expected FR, got %v", z.Countries)
+	}
+	if _, ok := Lookup("Mars/Olympus_Mons"); ok {
+		t.Error("expected unknown zone not to be found")
+	}
+}
+
+func TestCountryName(t *testing.T) {
+	if name, ok := CountryName("jp"); !ok || name != "Japan" {
+		t.Errorf("CountryName(jp) = %q, %v", name, ok)
+	}
+	if _, ok := CountryName("XX"); ok {
+		t.Error("expected unknown country code not to be found")
+	}
+}
+
+func TestCountryZonesPrimaryFirst(t *testing.T) {
+	de := CountryZones("DE")
+	if len(de) == 0 || de[0] != "Europe/Berlin" {
+		t.Errorf("expected Europe/Berlin first for DE, got %v", de)
+	}
+}
+
+func TestCity(t *testing.T) {
+	tests := map[string]string{
+		"America/New_York":               "New York",
+		"America/Argentina/Buenos_Aires": "Buenos Aires",
+		"UTC":                            "UTC",
+	}
+	for in, want := range tests {
+		if got := City(in); got != want {
+			t.Errorf("City(%q) = %q, want %q", in, got, want)
+		}
+	}
+}